@@ -20,9 +20,13 @@ package agents
 
 import (
 	"crypto/tls"
+	"encoding/base64"
 	"fmt"
+	"io"
+	"net/http"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/cgrates/birpc/context"
 	"github.com/cgrates/cgrates/config"
@@ -47,34 +51,49 @@ func NewDNSAgent(cgrCfg *config.CGRConfig, fltrS *engine.FilterS,
 // DNSAgent translates DNS requests towards CGRateS infrastructure
 type DNSAgent struct {
 	sync.RWMutex
-	cgrCfg  *config.CGRConfig // loaded CGRateS configuration
-	connMgr *engine.ConnManager
-	caps    *engine.Caps
-	fltrS   *engine.FilterS // connection towards FilterS
-	servers []*dns.Server
+	cgrCfg     *config.CGRConfig // loaded CGRateS configuration
+	connMgr    *engine.ConnManager
+	caps       *engine.Caps
+	fltrS      *engine.FilterS // connection towards FilterS
+	servers    []*dns.Server
+	dohServers []*http.Server // DNS-over-HTTPS listeners
 }
 
 // initDNSServer instantiates the DNS server
-func (da *DNSAgent) initDNSServer() (_ error) {
+func (da *DNSAgent) initDNSServer() (err error) {
 	da.servers = make([]*dns.Server, 0, len(da.cgrCfg.DNSAgentCfg().Listeners))
-	for i := range da.cgrCfg.DNSAgentCfg().Listeners {
-		da.servers = append(da.servers, &dns.Server{
-			Addr: da.cgrCfg.DNSAgentCfg().Listeners[i].Address,
-			Net:  da.cgrCfg.DNSAgentCfg().Listeners[i].Network,
+	da.dohServers = nil
+	var tlsCfg *tls.Config // shared by all secured listeners
+	for _, lstn := range da.cgrCfg.DNSAgentCfg().Listeners {
+		secured := lstn.Network == utils.HTTPS || strings.HasSuffix(lstn.Network, utils.TLSNoCaps)
+		if secured && tlsCfg == nil {
+			if tlsCfg, err = newTLSServerConfig(da.cgrCfg.TLSCfg()); err != nil {
+				return
+			}
+		}
+		if lstn.Network == utils.HTTPS {
+			mux := http.NewServeMux()
+			mux.HandleFunc(dohPath, da.handleDoH)
+			da.dohServers = append(da.dohServers, &http.Server{
+				Addr:      lstn.Address,
+				Handler:   mux,
+				TLSConfig: tlsCfg.Clone(),
+			})
+			continue
+		}
+		srv := &dns.Server{
+			Addr: lstn.Address,
+			Net:  lstn.Network,
 			Handler: dns.HandlerFunc(func(w dns.ResponseWriter, m *dns.Msg) {
 				go da.handleMessage(w, m)
 			}),
-		})
-		if strings.HasSuffix(da.cgrCfg.DNSAgentCfg().Listeners[i].Network, utils.TLSNoCaps) {
-			cert, err := tls.LoadX509KeyPair(da.cgrCfg.TLSCfg().ServerCerificate, da.cgrCfg.TLSCfg().ServerKey)
-			if err != nil {
-				return fmt.Errorf("load certificate error <%v>", err)
-			}
-			da.servers[i].Net = "tcp-tls"
-			da.servers[i].TLSConfig = &tls.Config{
-				Certificates: []tls.Certificate{cert},
-			}
 		}
+		if secured { // DNS-over-TLS
+			srv.Net = utils.TCPTLS
+			srv.TLSConfig = tlsCfg.Clone()
+			srv.TLSConfig.NextProtos = []string{dotALPN}
+		}
+		da.servers = append(da.servers, srv)
 	}
 	return
 }
@@ -97,6 +116,18 @@ func (da *DNSAgent) ListenAndServe(stopChan chan struct{}) error {
 			}
 		}(server)
 	}
+	for _, server := range da.dohServers {
+		utils.Logger.Info(fmt.Sprintf("<%s> start listening on <%s:%s>",
+			utils.DNSAgent, utils.HTTPS, server.Addr))
+		go func(srv *http.Server) {
+			err := srv.ListenAndServeTLS(utils.EmptyString, utils.EmptyString) // certificates are part of TLSConfig
+			if err != nil && err != http.ErrServerClosed {
+				utils.Logger.Warning(fmt.Sprintf("<%s> error <%v>, on ListenAndServe <%s:%s>",
+					utils.DNSAgent, err, utils.HTTPS, srv.Addr))
+				errChan <- err
+			}
+		}(server)
+	}
 
 	select {
 	case <-stopChan:
@@ -163,12 +194,65 @@ func (da *DNSAgent) Shutdown() error {
 			err = shtdErr
 		}
 	}
+	for _, server := range da.dohServers {
+		if shtdErr := server.Close(); shtdErr != nil {
+			utils.Logger.Warning(fmt.Sprintf("<%s> error <%v>, on Shutdown <%s:%s>",
+				utils.DNSAgent, shtdErr, utils.HTTPS, server.Addr))
+			err = shtdErr
+		}
+	}
 	return err
 }
 
+// handleDoH answers the DNS-over-HTTPS queries (RFC 8484) through the same
+// processing as the ones received over the other transports
+func (da *DNSAgent) handleDoH(w http.ResponseWriter, r *http.Request) {
+	var wire []byte
+	var err error
+	switch r.Method {
+	case http.MethodGet:
+		wire, err = base64.RawURLEncoding.DecodeString(r.URL.Query().Get(dohQueryParam))
+	case http.MethodPost:
+		if r.Header.Get(utils.ContentType) != dohContentType {
+			http.Error(w, http.StatusText(http.StatusUnsupportedMediaType), http.StatusUnsupportedMediaType)
+			return
+		}
+		wire, err = io.ReadAll(io.LimitReader(r.Body, dns.MaxMsgSize))
+	default:
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	req := new(dns.Msg)
+	if err == nil {
+		err = req.Unpack(wire)
+	}
+	if err != nil {
+		utils.Logger.Warning(
+			fmt.Sprintf("<%s> error: %s decoding DNS-over-HTTPS query from %s",
+				utils.DNSAgent, err.Error(), r.RemoteAddr))
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+	dw := newDoHResponseWriter(r)
+	da.handleMessage(dw, req)
+	if dw.rply == nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set(utils.ContentType, dohContentType)
+	w.Header().Set(dohCacheControl, fmt.Sprintf("max-age=%d", dw.maxAge))
+	w.Write(dw.rply)
+}
+
 // handleMessage is the entry point of all DNS requests
 // requests are reaching here asynchronously
 func (da *DNSAgent) handleQuestion(dnsDP utils.DataProvider, rply *dns.Msg, q *dns.Question, rmtAddr string) (processed bool, err error) {
+	if len(da.cgrCfg.DNSAgentCfg().RouteSConns) != 0 &&
+		(q.Qtype == dns.TypeNAPTR || q.Qtype == dns.TypeSRV) {
+		if processed, err = da.handleENUMQuestion(rply, q, rmtAddr); err != nil || processed {
+			return
+		}
+	}
 	reqVars := &utils.DataNode{
 		Type: utils.NMMapType,
 		Map: map[string]*utils.DataNode{
@@ -223,3 +307,35 @@ func (da *DNSAgent) handleQuestion(dnsDP utils.DataProvider, rply *dns.Msg, q *d
 	}
 	return
 }
+
+// handleENUMQuestion answers the NAPTR/SRV queries within the ENUM domains out of the routes
+// returned by RouteS for the queried number, leaving the other queries to the request processors
+func (da *DNSAgent) handleENUMQuestion(rply *dns.Msg, q *dns.Question, rmtAddr string) (processed bool, err error) {
+	number, isENUM := dnsENUMNumber(q.Name, da.cgrCfg.DNSAgentCfg().EnumDomains)
+	if !isENUM {
+		return
+	}
+	var sRoutes engine.SortedRoutesList
+	if err = da.connMgr.Call(context.TODO(), da.cgrCfg.DNSAgentCfg().RouteSConns,
+		utils.RouteSv1GetRoutes, &utils.CGREvent{
+			Tenant: da.cgrCfg.GeneralCfg().DefaultTenant,
+			ID:     utils.GenUUID(),
+			Event: map[string]any{
+				utils.Destination: number,
+				utils.SetupTime:   time.Now(),
+				utils.RemoteHost:  rmtAddr,
+			},
+		}, &sRoutes); err != nil {
+		if err.Error() != utils.ErrNotFound.Error() {
+			utils.Logger.Warning(
+				fmt.Sprintf("<%s> error: %s querying routes for number: %s from %s",
+					utils.DNSAgent, err.Error(), number, rmtAddr))
+			return
+		}
+		rply.Rcode = dns.RcodeNameError // no routes for the number
+		return true, nil
+	}
+	rply.Answer = append(rply.Answer,
+		dnsRoutesAnswer(q, number, da.cgrCfg.DNSAgentCfg().EnumService, sRoutes)...)
+	return true, nil
+}
@@ -0,0 +1,172 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package agents
+
+import (
+	"bytes"
+	"crypto/tls"
+	"encoding/base64"
+	"io"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/engine"
+	"github.com/cgrates/cgrates/utils"
+	"github.com/miekg/dns"
+)
+
+func TestDNSAgentSecuredListeners(t *testing.T) {
+	freeAddr := func() string {
+		l, err := net.Listen(utils.TCP, "127.0.0.1:0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer l.Close()
+		return l.Addr().String()
+	}
+	dotAddr, dohAddr := freeAddr(), freeAddr()
+	cfg := config.NewDefaultCGRConfig()
+	cfg.TLSCfg().ServerCerificate = "../data/tls/server.crt"
+	cfg.TLSCfg().ServerKey = "../data/tls/server.key"
+	cfg.DNSAgentCfg().Listeners = []config.DnsListener{
+		{Address: dotAddr, Network: utils.TCPTLS},
+		{Address: dohAddr, Network: utils.HTTPS},
+	}
+	aFld := &config.FCTemplate{
+		Tag:   "Aip",
+		Path:  utils.MetaRep + utils.NestingSep + utils.DNSAnswer + utils.NestingSep + utils.DNSA,
+		Type:  utils.MetaConstant,
+		Value: config.NewRSRParsersMustCompile("10.0.0.1", utils.InfieldSep),
+	}
+	aFld.ComputePath()
+	cfg.DNSAgentCfg().RequestProcessors = []*config.RequestProcessor{{
+		ID:          "StaticA",
+		Flags:       utils.FlagsWithParamsFromSlice([]string{utils.MetaNone}),
+		ReplyFields: []*config.FCTemplate{aFld},
+	}}
+	da, err := NewDNSAgent(cfg, engine.NewFilterS(cfg, nil, nil),
+		engine.NewConnManager(cfg, nil), engine.NewCaps(0, utils.MetaBusy))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(da.servers) != 1 || len(da.dohServers) != 1 {
+		t.Fatalf("expected one DoT and one DoH server, received: %d, %d", len(da.servers), len(da.dohServers))
+	}
+	if nextProtos := da.servers[0].TLSConfig.NextProtos; len(nextProtos) != 1 || nextProtos[0] != dotALPN {
+		t.Errorf("unexpected ALPN: %v", nextProtos)
+	}
+	stopChan := make(chan struct{})
+	defer close(stopChan)
+	go da.ListenAndServe(stopChan)
+
+	checkA := func(rply *dns.Msg) {
+		t.Helper()
+		if len(rply.Answer) != 1 {
+			t.Fatalf("unexpected reply: %v", rply)
+		}
+		if a, canCast := rply.Answer[0].(*dns.A); !canCast || a.A.String() != "10.0.0.1" {
+			t.Errorf("unexpected answer: %v", rply.Answer[0])
+		}
+	}
+	query := new(dns.Msg)
+	query.SetQuestion("cgrates.org.", dns.TypeA)
+
+	// DNS-over-TLS
+	dotClnt := &dns.Client{
+		Net:       utils.TCPTLS,
+		TLSConfig: &tls.Config{InsecureSkipVerify: true},
+		Timeout:   time.Second,
+	}
+	var rply *dns.Msg
+	for range 20 { // wait for the listener
+		if rply, _, err = dotClnt.Exchange(query, dotAddr); err == nil {
+			break
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkA(rply)
+
+	// DNS-over-HTTPS
+	httpClnt := &http.Client{
+		Transport: &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}},
+		Timeout:   time.Second,
+	}
+	wire, err := query.Pack()
+	if err != nil {
+		t.Fatal(err)
+	}
+	dohURL := "https://" + dohAddr + dohPath
+	readDoH := func(rsp *http.Response) *dns.Msg {
+		t.Helper()
+		defer rsp.Body.Close()
+		if rsp.StatusCode != http.StatusOK || rsp.Header.Get(utils.ContentType) != dohContentType {
+			t.Fatalf("unexpected response: %d %s", rsp.StatusCode, rsp.Header.Get(utils.ContentType))
+		}
+		body, err := io.ReadAll(rsp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		m := new(dns.Msg)
+		if err = m.Unpack(body); err != nil {
+			t.Fatal(err)
+		}
+		return m
+	}
+	var rsp *http.Response
+	for range 20 { // wait for the listener
+		if rsp, err = httpClnt.Get(dohURL + "?" + dohQueryParam + "=" +
+			base64.RawURLEncoding.EncodeToString(wire)); err == nil {
+			break
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cc := rsp.Header.Get(dohCacheControl); cc != "max-age=60" {
+		t.Errorf("unexpected Cache-Control: %q", cc)
+	}
+	checkA(readDoH(rsp))
+
+	if rsp, err = httpClnt.Post(dohURL, dohContentType, bytes.NewReader(wire)); err != nil {
+		t.Fatal(err)
+	}
+	checkA(readDoH(rsp))
+
+	if rsp, err = httpClnt.Post(dohURL, "text/plain", bytes.NewReader(wire)); err != nil {
+		t.Fatal(err)
+	}
+	rsp.Body.Close()
+	if rsp.StatusCode != http.StatusUnsupportedMediaType {
+		t.Errorf("expected %d, received %d", http.StatusUnsupportedMediaType, rsp.StatusCode)
+	}
+	if rsp, err = httpClnt.Get(dohURL + "?" + dohQueryParam + "=invalid"); err != nil {
+		t.Fatal(err)
+	}
+	rsp.Body.Close()
+	if rsp.StatusCode != http.StatusBadRequest {
+		t.Errorf("expected %d, received %d", http.StatusBadRequest, rsp.StatusCode)
+	}
+}
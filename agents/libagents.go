@@ -19,6 +19,7 @@ along with this program.  If not, see <https://www.gnu.org/licenses/>
 package agents
 
 import (
+	"crypto/tls"
 	"fmt"
 	"strings"
 	"time"
@@ -305,3 +306,15 @@ func processRequest(ctx *context.Context, reqProcessor *config.RequestProcessor,
 	}
 	return true, nil
 }
+
+// newTLSServerConfig builds the TLS configuration of the agent listeners out of the engine certificates
+func newTLSServerConfig(tlsCfg *config.TLSCfg) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(tlsCfg.ServerCerificate, tlsCfg.ServerKey)
+	if err != nil {
+		return nil, fmt.Errorf("load certificate error <%v>", err)
+	}
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
@@ -20,15 +20,28 @@ package agents
 
 import (
 	"fmt"
+	"math"
 	"net"
+	"net/http"
 	"strconv"
 	"strings"
 
 	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/engine"
 	"github.com/cgrates/cgrates/utils"
 	"github.com/miekg/dns"
 )
 
+const (
+	dnsSIPPort = 5060 // port of the SRV records if not specified within route parameters
+
+	dotALPN         = "dot" // RFC 7858
+	dohPath         = "/dns-query"
+	dohQueryParam   = "dns"
+	dohContentType  = "application/dns-message"
+	dohCacheControl = "Cache-Control"
+)
+
 func newDnsReply(req *dns.Msg) (rply *dns.Msg) {
 	rply = new(dns.Msg)
 	rply.SetReply(req)
@@ -52,6 +65,45 @@ func dnsWriteMsg(w dns.ResponseWriter, msg *dns.Msg) (err error) {
 	return
 }
 
+// dohResponseWriter collects the reply of a DNS-over-HTTPS query
+type dohResponseWriter struct {
+	lclAddr net.Addr
+	rmtAddr net.Addr
+	rply    []byte
+	maxAge  uint32 // lowest TTL within the answer, used as HTTP freshness lifetime
+}
+
+func newDoHResponseWriter(r *http.Request) (dw *dohResponseWriter) {
+	dw = new(dohResponseWriter)
+	dw.rmtAddr, _ = net.ResolveTCPAddr(utils.TCP, r.RemoteAddr)
+	dw.lclAddr, _ = r.Context().Value(http.LocalAddrContextKey).(net.Addr)
+	return
+}
+
+func (dw *dohResponseWriter) LocalAddr() net.Addr  { return dw.lclAddr }
+func (dw *dohResponseWriter) RemoteAddr() net.Addr { return dw.rmtAddr }
+func (dw *dohResponseWriter) WriteMsg(m *dns.Msg) (err error) {
+	var rply []byte
+	if rply, err = m.Pack(); err != nil {
+		return
+	}
+	dw.rply, dw.maxAge = rply, 0
+	for i, rr := range m.Answer {
+		if ttl := rr.Header().Ttl; i == 0 || ttl < dw.maxAge {
+			dw.maxAge = ttl
+		}
+	}
+	return
+}
+func (dw *dohResponseWriter) Write(b []byte) (int, error) {
+	dw.rply = append([]byte(nil), b...)
+	return len(b), nil
+}
+func (dw *dohResponseWriter) Close() error        { return nil }
+func (dw *dohResponseWriter) TsigStatus() error   { return nil }
+func (dw *dohResponseWriter) TsigTimersOnly(bool) {}
+func (dw *dohResponseWriter) Hijack()             {}
+
 func newDnsDP(req *dns.Msg) utils.DataProvider {
 	return &dnsDP{
 		req:  config.NewObjectDP(req),
@@ -753,3 +805,93 @@ func updateDnsRRHeader(v *dns.RR_Header, path []string, value any) (err error) {
 	}
 	return
 }
+
+// dnsENUMNumber extracts the E.164 number out of the name of an ENUM query,
+// ignoring the leading _service._proto labels of SRV queries
+func dnsENUMNumber(qName string, domains []string) (number string, isENUM bool) {
+	qName = strings.ToLower(dns.Fqdn(qName))
+	for _, domain := range domains {
+		prefix, has := strings.CutSuffix(qName, utils.NestingSep+strings.ToLower(dns.Fqdn(domain)))
+		if !has {
+			continue
+		}
+		labels := strings.Split(prefix, utils.NestingSep)
+		for len(labels) != 0 && strings.HasPrefix(labels[0], utils.Underline) {
+			labels = labels[1:]
+		}
+		if len(labels) == 0 {
+			return
+		}
+		digits := make([]byte, len(labels))
+		for i, label := range labels { // digits are in reverse order
+			if len(label) != 1 || label[0] < '0' || label[0] > '9' {
+				return
+			}
+			digits[len(labels)-1-i] = label[0]
+		}
+		return string(digits), true
+	}
+	return
+}
+
+// dnsRouteTarget returns the SIP target of the route out of its parameters
+// in the host[:port] format, defaulting to the route ID
+func dnsRouteTarget(sRoute *engine.SortedRoute) (target, host string, port uint16) {
+	target = utils.FirstNonEmpty(sRoute.RouteParameters, sRoute.RouteID)
+	host, port = target, dnsSIPPort
+	if h, p, err := net.SplitHostPort(target); err == nil {
+		if prt, err := strconv.ParseUint(p, 10, 16); err == nil {
+			host, port = h, uint16(prt)
+		}
+	}
+	return
+}
+
+// dnsRoutesAnswer builds the NAPTR or SRV records out of the sorted routes:
+// NAPTR order follows the routes profile and preference the route rank within it,
+// SRV priority follows the overall route rank with the weight of the route,
+// routes pointing to IP addresses are left out of SRV since its target must be a domain name
+func dnsRoutesAnswer(q *dns.Question, number, service string,
+	sRoutes engine.SortedRoutesList) (answer []dns.RR) {
+	var rank uint16
+	for i, sRts := range sRoutes {
+		for j, sRoute := range sRts.Routes {
+			rank++
+			hdr := dns.RR_Header{
+				Name:   q.Name,
+				Rrtype: q.Qtype,
+				Class:  dns.ClassINET,
+				Ttl:    60,
+			}
+			target, host, port := dnsRouteTarget(sRoute)
+			switch q.Qtype {
+			case dns.TypeNAPTR:
+				answer = append(answer, &dns.NAPTR{
+					Hdr:         hdr,
+					Order:       uint16(10 * (i + 1)),
+					Preference:  uint16(10 * (j + 1)),
+					Flags:       "u",
+					Service:     service,
+					Regexp:      fmt.Sprintf("!^.*$!sip:+%s@%s!", number, target),
+					Replacement: utils.NestingSep,
+				})
+			case dns.TypeSRV:
+				if net.ParseIP(host) != nil {
+					continue
+				}
+				var weight uint16
+				if w, err := utils.IfaceAsFloat64(sRoute.SortingData[utils.Weight]); err == nil && w > 0 {
+					weight = uint16(min(w, math.MaxUint16))
+				}
+				answer = append(answer, &dns.SRV{
+					Hdr:      hdr,
+					Priority: rank,
+					Weight:   weight,
+					Port:     port,
+					Target:   dns.Fqdn(host),
+				})
+			}
+		}
+	}
+	return
+}
@@ -25,6 +25,9 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/cgrates/birpc"
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/engine"
 	"github.com/cgrates/cgrates/utils"
 	"github.com/miekg/dns"
 )
@@ -1901,3 +1904,96 @@ func TestNewDnsDP(t *testing.T) {
 	}
 
 }
+
+func TestDNSENUMNumber(t *testing.T) {
+	domains := []string{"e164.arpa", "e164.example.com."}
+	for qName, exp := range map[string]string{
+		"3.2.1.0.0.1.9.4.e164.arpa.":             "49100123",
+		"3.2.1.0.0.1.9.4.E164.ARPA.":             "49100123",
+		"_sip._udp.3.2.1.0.0.1.9.4.e164.arpa.":   "49100123",
+		"3.2.1.0.0.1.9.4.e164.example.com.":      "49100123",
+		"3.2.1.0.0.1.9.4.e164.example.org.":      "",
+		"3.2.a.0.0.1.9.4.e164.arpa.":             "",
+		"32.1.0.0.1.9.4.e164.arpa.":              "",
+		"_sip._udp.e164.arpa.":                   "",
+		"3.2.1.0.0.1.9.4.notenum.e164.arpa.test": "",
+	} {
+		if number, isENUM := dnsENUMNumber(qName, domains); number != exp || isENUM != (exp != "") {
+			t.Errorf("for %q expected %q, received %q", qName, exp, number)
+		}
+	}
+}
+
+func TestDNSRoutesAnswer(t *testing.T) {
+	sRoutes := engine.SortedRoutesList{{
+		ProfileID: "ROUTE_LCR",
+		Sorting:   utils.MetaLC,
+		Routes: []*engine.SortedRoute{
+			{RouteID: "gw1.example.com", SortingData: map[string]any{utils.Weight: 20.}},
+			{RouteID: "route2", RouteParameters: "10.0.0.2:5080", SortingData: map[string]any{utils.Weight: 10.}},
+		},
+	}}
+	q := &dns.Question{Name: "3.2.1.0.0.1.9.4.e164.arpa.", Qtype: dns.TypeNAPTR, Qclass: dns.ClassINET}
+	hdr := dns.RR_Header{Name: q.Name, Rrtype: dns.TypeNAPTR, Class: dns.ClassINET, Ttl: 60}
+	exp := []dns.RR{
+		&dns.NAPTR{Hdr: hdr, Order: 10, Preference: 10, Flags: "u", Service: "E2U+sip",
+			Regexp: "!^.*$!sip:+49100123@gw1.example.com!", Replacement: "."},
+		&dns.NAPTR{Hdr: hdr, Order: 10, Preference: 20, Flags: "u", Service: "E2U+sip",
+			Regexp: "!^.*$!sip:+49100123@10.0.0.2:5080!", Replacement: "."},
+	}
+	if rcv := dnsRoutesAnswer(q, "49100123", "E2U+sip", sRoutes); !reflect.DeepEqual(exp, rcv) {
+		t.Errorf("expected %v, received %v", exp, rcv)
+	}
+
+	q = &dns.Question{Name: "_sip._udp.3.2.1.0.0.1.9.4.e164.arpa.", Qtype: dns.TypeSRV, Qclass: dns.ClassINET}
+	hdr = dns.RR_Header{Name: q.Name, Rrtype: dns.TypeSRV, Class: dns.ClassINET, Ttl: 60}
+	exp = []dns.RR{
+		&dns.SRV{Hdr: hdr, Priority: 1, Weight: 20, Port: 5060, Target: "gw1.example.com."},
+	}
+	if rcv := dnsRoutesAnswer(q, "49100123", "E2U+sip", sRoutes); !reflect.DeepEqual(exp, rcv) {
+		t.Errorf("expected %v, received %v", exp, rcv)
+	}
+}
+
+func TestDNSAgentHandleENUMQuestion(t *testing.T) {
+	cfg := config.NewDefaultCGRConfig()
+	cfg.DNSAgentCfg().RouteSConns = []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaRoutes)}
+	routesChan := make(chan birpc.ClientConnector, 1)
+	routesChan <- &testMockSessionConn{calls: map[string]func(arg any, rply any) error{
+		utils.RouteSv1GetRoutes: func(arg any, rply any) error {
+			if dst := arg.(*utils.CGREvent).Event[utils.Destination]; dst != "49100123" {
+				return utils.ErrNotFound
+			}
+			*rply.(*engine.SortedRoutesList) = engine.SortedRoutesList{{
+				ProfileID: "ROUTE_LCR",
+				Routes:    []*engine.SortedRoute{{RouteID: "route1", RouteParameters: "gw1.example.com"}},
+			}}
+			return nil
+		},
+	}}
+	da := &DNSAgent{
+		cgrCfg: cfg,
+		connMgr: engine.NewConnManager(cfg, map[string]chan birpc.ClientConnector{
+			utils.ConcatenatedKey(utils.MetaInternal, utils.MetaRoutes): routesChan,
+		}),
+	}
+
+	rply := new(dns.Msg)
+	q := &dns.Question{Name: "3.2.1.0.0.1.9.4.e164.arpa.", Qtype: dns.TypeNAPTR, Qclass: dns.ClassINET}
+	if processed, err := da.handleQuestion(nil, rply, q, "127.0.0.1:5300"); err != nil || !processed {
+		t.Fatalf("processed: %v, err: %v", processed, err)
+	}
+	if len(rply.Answer) != 1 ||
+		rply.Answer[0].(*dns.NAPTR).Regexp != "!^.*$!sip:+49100123@gw1.example.com!" {
+		t.Errorf("unexpected answer: %v", rply.Answer)
+	}
+
+	rply = new(dns.Msg)
+	q.Name = "4.3.2.1.e164.arpa."
+	if processed, err := da.handleQuestion(nil, rply, q, "127.0.0.1:5300"); err != nil || !processed {
+		t.Fatalf("processed: %v, err: %v", processed, err)
+	}
+	if rply.Rcode != dns.RcodeNameError || len(rply.Answer) != 0 {
+		t.Errorf("expected NXDOMAIN, received: %v", rply)
+	}
+}
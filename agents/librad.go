@@ -108,6 +108,9 @@ func (pk *radiusDP) FieldAsString(fldPath []string) (string, error) {
 
 // radauthReq is used to authorize a request based on flags
 func radauthReq(flags utils.FlagsWithParams, req *radigo.Packet, aReq *AgentRequest, rpl *radigo.Packet) (bool, error) {
+	if flags.Has(utils.MetaEAPMD5) { // the password is needed only after the challenge
+		return radEAPMD5Auth(req, aReq, rpl)
+	}
 	nmItems, has := aReq.Vars.Map[utils.UserPassword]
 	if !has {
 		return false, utils.ErrNotFound
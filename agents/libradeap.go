@@ -0,0 +1,359 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package agents
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/cgrates/cgrates/engine"
+	"github.com/cgrates/cgrates/utils"
+	"github.com/cgrates/radigo"
+)
+
+const (
+	EAPMessageAVP           = "EAP-Message"
+	MessageAuthenticatorAVP = "Message-Authenticator"
+	StateAVP                = "State"
+
+	// attribute numbers handled raw since they are missing from the RFC 2865 dictionary
+	radAttrState                = 24
+	radAttrProxyState           = 33
+	radAttrEAPMessage           = 79
+	radAttrMessageAuthenticator = 80
+
+	// EAP codes as defined by RFC 3748
+	eapCodeRequest  = 1
+	eapCodeResponse = 2
+	eapCodeSuccess  = 3
+	eapCodeFailure  = 4
+
+	// EAP method types
+	eapTypeIdentity = 1
+	eapTypeMD5      = 4
+
+	// Microsoft VSAs carrying the keying material for the NAS (RFC 2548)
+	msVendorCode  = 311
+	msMPPESendKey = 16
+	msMPPERecvKey = 17
+
+	radMaxAttrValueLen = 253
+)
+
+// eapPacket is the decoded EAP-Message of a RADIUS packet
+type eapPacket struct {
+	Code       uint8
+	Identifier uint8
+	Type       uint8 // only present for Request/Response codes
+	Data       []byte
+}
+
+// decodeEAPPacket decodes an EAP packet out of its network representation
+func decodeEAPPacket(b []byte) (*eapPacket, error) {
+	if len(b) < 4 {
+		return nil, errors.New("EAP packet too short")
+	}
+	length := int(binary.BigEndian.Uint16(b[2:4]))
+	if length < 4 || length > len(b) {
+		return nil, fmt.Errorf("invalid EAP packet length: %d", length)
+	}
+	eap := &eapPacket{
+		Code:       b[0],
+		Identifier: b[1],
+	}
+	if eap.Code == eapCodeRequest || eap.Code == eapCodeResponse {
+		if length < 5 {
+			return nil, errors.New("missing EAP type")
+		}
+		eap.Type = b[4]
+		eap.Data = b[5:length]
+	}
+	return eap, nil
+}
+
+// Encode returns the network representation of the EAP packet
+func (eap *eapPacket) Encode() []byte {
+	length := 4
+	if eap.Code == eapCodeRequest || eap.Code == eapCodeResponse {
+		length += 1 + len(eap.Data)
+	}
+	b := make([]byte, length)
+	b[0] = eap.Code
+	b[1] = eap.Identifier
+	binary.BigEndian.PutUint16(b[2:4], uint16(length))
+	if length > 4 {
+		b[4] = eap.Type
+		copy(b[5:], eap.Data)
+	}
+	return b
+}
+
+// radRawAttribute returns the concatenated raw value of the attributes with attrNr
+func radRawAttribute(pkt *radigo.Packet, attrNr uint8) (val []byte) {
+	for _, avp := range pkt.AVPs {
+		if avp.Number == attrNr {
+			val = append(val, avp.RawValue...)
+		}
+	}
+	return
+}
+
+// radEAPMessage decodes the EAP packet out of the (possibly fragmented) EAP-Message attributes
+func radEAPMessage(pkt *radigo.Packet) (*eapPacket, error) {
+	raw := radRawAttribute(pkt, radAttrEAPMessage)
+	if len(raw) == 0 {
+		return nil, utils.NewErrMandatoryIeMissing(EAPMessageAVP)
+	}
+	return decodeEAPPacket(raw)
+}
+
+// radAddEAPMessage appends the EAP packet to the RADIUS one, split over multiple
+// EAP-Message attributes if it does not fit in one
+func radAddEAPMessage(pkt *radigo.Packet, eap []byte) {
+	for len(eap) > 0 {
+		n := min(len(eap), radMaxAttrValueLen)
+		pkt.AVPs = append(pkt.AVPs, &radigo.AVP{
+			Number:   radAttrEAPMessage,
+			RawValue: bytes.Clone(eap[:n]),
+		})
+		eap = eap[n:]
+	}
+}
+
+// radMessageAuthenticator computes the HMAC-MD5 Message-Authenticator over the packet (RFC 3579, 3.2)
+// the Message-Authenticator attribute, if present, needs to be zeroed by the caller
+func radMessageAuthenticator(pkt *radigo.Packet, secret string) ([]byte, error) {
+	acator := pkt.Authenticator
+	var buf [radigo.MaxPacketLen]byte
+	n, err := pkt.Encode(buf[:])
+	pkt.Authenticator = acator // Encode computes the Response Authenticator for replies
+	if err != nil {
+		return nil, err
+	}
+	copy(buf[4:20], acator[:])
+	mac := hmac.New(md5.New, []byte(secret))
+	mac.Write(buf[:n])
+	return mac.Sum(nil), nil
+}
+
+// radVerifyMessageAuthenticator checks the Message-Authenticator of a received request
+func radVerifyMessageAuthenticator(pkt *radigo.Packet, secret string) (bool, error) {
+	var msgAuth *radigo.AVP
+	for _, avp := range pkt.AVPs {
+		if avp.Number == radAttrMessageAuthenticator {
+			msgAuth = avp
+			break
+		}
+	}
+	if msgAuth == nil {
+		return false, utils.NewErrMandatoryIeMissing(MessageAuthenticatorAVP)
+	}
+	if len(msgAuth.RawValue) != md5.Size {
+		return false, nil
+	}
+	rcvMAC := msgAuth.RawValue
+	msgAuth.RawValue = make([]byte, md5.Size)
+	expMAC, err := radMessageAuthenticator(pkt, secret)
+	msgAuth.RawValue = rcvMAC
+	if err != nil {
+		return false, err
+	}
+	return hmac.Equal(rcvMAC, expMAC), nil
+}
+
+// radVerifyReplyAuthenticators checks the Response Authenticator (RFC 2865, 3) and the
+// Message-Authenticator (RFC 3579, 3.2) of a reply against the authenticator of its request
+func radVerifyReplyAuthenticators(rpl *radigo.Packet, reqAuth [16]byte, secret string) error {
+	rplAuth := rpl.Authenticator
+	defer func() { rpl.Authenticator = rplAuth }()
+	rpl.Authenticator = reqAuth
+	var buf [radigo.MaxPacketLen]byte
+	n, err := rpl.Encode(buf[:])
+	rpl.Authenticator = reqAuth // Encode computes the Response Authenticator with its own secret
+	if err != nil {
+		return err
+	}
+	copy(buf[4:20], reqAuth[:])
+	if expAuth := md5.Sum(append(buf[:n], secret...)); !hmac.Equal(expAuth[:], rplAuth[:]) {
+		return errors.New("invalid Response Authenticator")
+	}
+	valid, err := radVerifyMessageAuthenticator(rpl, secret)
+	if err != nil {
+		return err
+	}
+	if !valid {
+		return fmt.Errorf("invalid %s", MessageAuthenticatorAVP)
+	}
+	return nil
+}
+
+// radSignMessageAuthenticator appends the Message-Authenticator to the packet,
+// hence it should be called only after all the other attributes were added
+func radSignMessageAuthenticator(pkt *radigo.Packet, secret string) error {
+	msgAuth := &radigo.AVP{
+		Number:   radAttrMessageAuthenticator,
+		RawValue: make([]byte, md5.Size),
+	}
+	pkt.AVPs = append(pkt.AVPs, msgAuth)
+	mac, err := radMessageAuthenticator(pkt, secret)
+	if err != nil {
+		return err
+	}
+	msgAuth.RawValue = mac
+	return nil
+}
+
+// radMPPERecrypt converts a salt-encrypted MS-MPPE key (RFC 2548, 2.4.2) received from
+// the upstream server into one readable by the NAS, keeping the original salt
+func radMPPERecrypt(val []byte, fromSecret string, fromAuth [16]byte,
+	toSecret string, toAuth [16]byte) ([]byte, error) {
+	if len(val) < 2+md5.Size || (len(val)-2)%md5.Size != 0 {
+		return nil, errors.New("invalid MS-MPPE key length")
+	}
+	salt := val[:2]
+	out := make([]byte, len(val))
+	copy(out, salt)
+	prevIn := append(bytes.Clone(fromAuth[:]), salt...)
+	prevOut := append(bytes.Clone(toAuth[:]), salt...)
+	for i := 2; i < len(val); i += md5.Size {
+		bIn := md5.Sum(append([]byte(fromSecret), prevIn...))
+		bOut := md5.Sum(append([]byte(toSecret), prevOut...))
+		for j := range md5.Size {
+			out[i+j] = val[i+j] ^ bIn[j] ^ bOut[j]
+		}
+		prevIn = val[i : i+md5.Size]
+		prevOut = out[i : i+md5.Size]
+	}
+	return out, nil
+}
+
+// radEAPSession keeps the state of an EAP conversation between the Access-Challenge round trips
+type radEAPSession struct {
+	Identity      string
+	Identifier    uint8  // identifier of the last EAP-Request sent
+	Challenge     []byte // EAP-MD5 challenge
+	UpstreamState []byte // State received from the upstream EAP server
+}
+
+// popRadEAPSession returns the EAP session matching the State of the request, removing it out of cache
+func popRadEAPSession(req *radigo.Packet) (*radEAPSession, error) {
+	state := string(radRawAttribute(req, radAttrState))
+	if state == utils.EmptyString {
+		return nil, utils.NewErrMandatoryIeMissing(StateAVP)
+	}
+	sess, has := engine.Cache.Get(utils.CacheRadiusEAPSessions, state)
+	if !has {
+		return nil, fmt.Errorf("%w: EAP session for State <%s>", utils.ErrNotFound, state)
+	}
+	if err := engine.Cache.Remove(utils.CacheRadiusEAPSessions, state,
+		true, utils.NonTransactional); err != nil {
+		return nil, err
+	}
+	return sess.(*radEAPSession), nil
+}
+
+// radEAPChallenge turns the reply into an Access-Challenge carrying the EAP-Request
+// and the State identifying the cached EAP session
+func radEAPChallenge(rpl *radigo.Packet, eapReq []byte, sess *radEAPSession) error {
+	state := utils.GenUUID()
+	if err := engine.Cache.Set(utils.CacheRadiusEAPSessions, state, sess,
+		nil, true, utils.NonTransactional); err != nil {
+		return err
+	}
+	rpl.Code = radigo.AccessChallenge
+	rpl.AVPs = append(rpl.AVPs, &radigo.AVP{
+		Number:   radAttrState,
+		RawValue: []byte(state),
+	})
+	radAddEAPMessage(rpl, eapReq)
+	return nil
+}
+
+// radEAPFinish closes the EAP conversation with either EAP-Success or EAP-Failure
+func radEAPFinish(rpl *radigo.Packet, identifier uint8, success bool) {
+	eapRpl := &eapPacket{
+		Code:       eapCodeSuccess,
+		Identifier: identifier,
+	}
+	if !success {
+		eapRpl.Code = eapCodeFailure
+		rpl.Code = radigo.AccessReject
+	}
+	radAddEAPMessage(rpl, eapRpl.Encode())
+}
+
+// radEAPMD5Auth authenticates the request using the EAP-MD5 method (RFC 3748, 5.4),
+// challenging the peer on EAP-Response/Identity and validating its answer on the next round trip
+func radEAPMD5Auth(req *radigo.Packet, aReq *AgentRequest, rpl *radigo.Packet) (bool, error) {
+	eapReq, err := radEAPMessage(req)
+	if err != nil {
+		return false, err
+	}
+	if eapReq.Code != eapCodeResponse {
+		return false, fmt.Errorf("unexpected EAP code: %d", eapReq.Code)
+	}
+	switch eapReq.Type {
+	case eapTypeIdentity:
+		challenge := make([]byte, md5.Size)
+		if _, err = rand.Read(challenge); err != nil {
+			return false, err
+		}
+		sess := &radEAPSession{
+			Identity:   string(eapReq.Data),
+			Identifier: eapReq.Identifier + 1,
+			Challenge:  challenge,
+		}
+		md5Req := &eapPacket{
+			Code:       eapCodeRequest,
+			Identifier: sess.Identifier,
+			Type:       eapTypeMD5,
+			Data:       append([]byte{md5.Size}, challenge...),
+		}
+		return true, radEAPChallenge(rpl, md5Req.Encode(), sess)
+	case eapTypeMD5:
+		sess, err := popRadEAPSession(req)
+		if err != nil {
+			return false, err
+		}
+		nmItems, has := aReq.Vars.Map[utils.UserPassword]
+		if !has {
+			return false, utils.ErrNotFound
+		}
+		if eapReq.Identifier != sess.Identifier ||
+			len(eapReq.Data) < 1+md5.Size || eapReq.Data[0] != md5.Size {
+			radEAPFinish(rpl, eapReq.Identifier, false)
+			return false, nil
+		}
+		h := md5.New()
+		h.Write([]byte{eapReq.Identifier})
+		h.Write([]byte(nmItems.Value.String()))
+		h.Write(sess.Challenge)
+		pass := hmac.Equal(h.Sum(nil), eapReq.Data[1:1+md5.Size])
+		radEAPFinish(rpl, eapReq.Identifier, pass)
+		return pass, nil
+	default: // EAP-Nak or other methods we do not handle locally
+		radEAPFinish(rpl, eapReq.Identifier, false)
+		return false, nil
+	}
+}
@@ -0,0 +1,271 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package agents
+
+import (
+	"bytes"
+	"crypto/md5"
+	"reflect"
+	"testing"
+
+	"github.com/cgrates/cgrates/utils"
+	"github.com/cgrates/radigo"
+)
+
+func TestEAPPacketEncodeDecode(t *testing.T) {
+	eap := &eapPacket{
+		Code:       eapCodeResponse,
+		Identifier: 7,
+		Type:       eapTypeIdentity,
+		Data:       []byte("1001@cgrates.org"),
+	}
+	rcv, err := decodeEAPPacket(eap.Encode())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(eap, rcv) {
+		t.Errorf("expected %+v, received %+v", eap, rcv)
+	}
+	success := &eapPacket{Code: eapCodeSuccess, Identifier: 8}
+	if b := success.Encode(); !bytes.Equal(b, []byte{eapCodeSuccess, 8, 0, 4}) {
+		t.Errorf("unexpected EAP-Success: %v", b)
+	}
+	if _, err := decodeEAPPacket([]byte{eapCodeResponse, 1, 0, 9, 1}); err == nil {
+		t.Error("expected error on invalid length")
+	}
+}
+
+func TestRadAddEAPMessageFragments(t *testing.T) {
+	pkt := radigo.NewPacket(radigo.AccessChallenge, 1, dictRad, coder, "CGRateS.org")
+	eap := &eapPacket{
+		Code:       eapCodeRequest,
+		Identifier: 2,
+		Type:       13, // EAP-TLS
+		Data:       bytes.Repeat([]byte{0xAB}, 600),
+	}
+	radAddEAPMessage(pkt, eap.Encode())
+	if len(pkt.AVPs) != 3 {
+		t.Fatalf("expected 3 EAP-Message attributes, received %d", len(pkt.AVPs))
+	}
+	rcv, err := radEAPMessage(pkt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(eap, rcv) {
+		t.Errorf("expected %+v, received %+v", eap, rcv)
+	}
+}
+
+func TestRadMessageAuthenticator(t *testing.T) {
+	req := radigo.NewPacket(radigo.AccessRequest, 1, dictRad, coder, "CGRateS.org")
+	req.Authenticator = [16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}
+	if err := req.AddAVPWithName("User-Name", "1001", ""); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := radVerifyMessageAuthenticator(req, "CGRateS.org"); err == nil {
+		t.Error("expected missing Message-Authenticator error")
+	}
+	if err := radSignMessageAuthenticator(req, "CGRateS.org"); err != nil {
+		t.Fatal(err)
+	}
+	if valid, err := radVerifyMessageAuthenticator(req, "CGRateS.org"); err != nil {
+		t.Error(err)
+	} else if !valid {
+		t.Error("expected valid Message-Authenticator")
+	}
+	if valid, err := radVerifyMessageAuthenticator(req, "wrongSecret"); err != nil {
+		t.Error(err)
+	} else if valid {
+		t.Error("expected invalid Message-Authenticator")
+	}
+
+	// the reply is signed using the Request Authenticator
+	rpl := req.Reply()
+	rpl.Code = radigo.AccessAccept
+	if err := radSignMessageAuthenticator(rpl, "CGRateS.org"); err != nil {
+		t.Fatal(err)
+	}
+	if rpl.Authenticator != req.Authenticator {
+		t.Error("Authenticator changed while signing the reply")
+	}
+	if valid, err := radVerifyMessageAuthenticator(rpl, "CGRateS.org"); err != nil {
+		t.Error(err)
+	} else if !valid {
+		t.Error("expected valid Message-Authenticator on reply")
+	}
+}
+
+func TestRadVerifyReplyAuthenticators(t *testing.T) {
+	req := radigo.NewPacket(radigo.AccessRequest, 1, dictRad, coder, "CGRateS.org")
+	req.Authenticator = [16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}
+	rpl := req.Reply()
+	rpl.Code = radigo.AccessChallenge
+	radAddEAPMessage(rpl, []byte{1, 2, 0, 6, 4, 16})
+	if err := radSignMessageAuthenticator(rpl, "CGRateS.org"); err != nil {
+		t.Fatal(err)
+	}
+	var buf [radigo.MaxPacketLen]byte
+	n, err := rpl.Encode(buf[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	rcv := radigo.NewPacket(radigo.AccessRequest, 0, dictRad, coder, "")
+	if err := rcv.Decode(buf[:n]); err != nil {
+		t.Fatal(err)
+	}
+	rplAuth := rcv.Authenticator
+	if err := radVerifyReplyAuthenticators(rcv, req.Authenticator, "CGRateS.org"); err != nil {
+		t.Error(err)
+	}
+	if rcv.Authenticator != rplAuth {
+		t.Error("Authenticator changed while verifying the reply")
+	}
+	if err := radVerifyReplyAuthenticators(rcv, req.Authenticator, "wrongSecret"); err == nil {
+		t.Error("expected invalid Response Authenticator")
+	}
+	if err := radVerifyReplyAuthenticators(rcv, [16]byte{}, "CGRateS.org"); err == nil {
+		t.Error("expected invalid Response Authenticator for another request")
+	}
+
+	// tampered Message-Authenticator with a matching Response Authenticator
+	rpl.AVPs[len(rpl.AVPs)-1].RawValue[0] ^= 0xff
+	rpl.Authenticator = req.Authenticator
+	if n, err = rpl.Encode(buf[:]); err != nil {
+		t.Fatal(err)
+	}
+	rcv = radigo.NewPacket(radigo.AccessRequest, 0, dictRad, coder, "")
+	if err := rcv.Decode(buf[:n]); err != nil {
+		t.Fatal(err)
+	}
+	if err := radVerifyReplyAuthenticators(rcv, req.Authenticator, "CGRateS.org"); err == nil ||
+		err.Error() != "invalid "+MessageAuthenticatorAVP {
+		t.Errorf("expected invalid %s, received %v", MessageAuthenticatorAVP, err)
+	}
+}
+
+func TestRadMPPERecrypt(t *testing.T) {
+	upAuth := [16]byte{1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1}
+	nasAuth := [16]byte{2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2}
+	key := bytes.Repeat([]byte{0x5A}, 2*md5.Size+2)
+	key[0], key[1] = 0x80, 0x01 // salt
+	toNAS, err := radMPPERecrypt(key, "upstreamSecret", upAuth, "CGRateS.org", nasAuth)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(toNAS[:2], key[:2]) {
+		t.Error("salt not preserved")
+	}
+	if back, err := radMPPERecrypt(toNAS, "CGRateS.org", nasAuth, "upstreamSecret", upAuth); err != nil {
+		t.Error(err)
+	} else if !bytes.Equal(back, key) {
+		t.Errorf("expected %v, received %v", key, back)
+	}
+	if _, err := radMPPERecrypt(key[:10], "upstreamSecret", upAuth, "CGRateS.org", nasAuth); err == nil {
+		t.Error("expected error on invalid key length")
+	}
+}
+
+func TestRadEAPMD5Auth(t *testing.T) {
+	aReq := NewAgentRequest(nil, &utils.DataNode{Type: utils.NMMapType, Map: map[string]*utils.DataNode{
+		utils.UserPassword: utils.NewLeafNode("CGRateSPassword1"),
+	}}, nil, nil, nil, nil, "cgrates.org", "", nil, nil)
+
+	// EAP-Response/Identity is answered with an EAP-MD5 challenge
+	req := radigo.NewPacket(radigo.AccessRequest, 1, dictRad, coder, "CGRateS.org")
+	radAddEAPMessage(req, (&eapPacket{Code: eapCodeResponse, Identifier: 1,
+		Type: eapTypeIdentity, Data: []byte("1001")}).Encode())
+	rpl := req.Reply()
+	if pass, err := radEAPMD5Auth(req, aReq, rpl); err != nil || !pass {
+		t.Fatalf("pass: %v, err: %v", pass, err)
+	}
+	if rpl.Code != radigo.AccessChallenge {
+		t.Errorf("expected AccessChallenge, received %s", rpl.Code)
+	}
+	state := radRawAttribute(rpl, radAttrState)
+	if len(state) == 0 {
+		t.Fatal("missing State")
+	}
+	md5Req, err := radEAPMessage(rpl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if md5Req.Code != eapCodeRequest || md5Req.Type != eapTypeMD5 || md5Req.Identifier != 2 {
+		t.Fatalf("unexpected EAP-Request: %+v", md5Req)
+	}
+
+	// EAP-Response/MD5-Challenge computed out of the password
+	h := md5.New()
+	h.Write([]byte{md5Req.Identifier})
+	h.Write([]byte("CGRateSPassword1"))
+	h.Write(md5Req.Data[1:])
+	req = radigo.NewPacket(radigo.AccessRequest, 2, dictRad, coder, "CGRateS.org")
+	req.AVPs = append(req.AVPs, &radigo.AVP{Number: radAttrState, RawValue: state})
+	radAddEAPMessage(req, (&eapPacket{Code: eapCodeResponse, Identifier: md5Req.Identifier,
+		Type: eapTypeMD5, Data: append([]byte{md5.Size}, h.Sum(nil)...)}).Encode())
+	rpl = req.Reply()
+	rpl.Code = radigo.AccessAccept
+	if pass, err := radEAPMD5Auth(req, aReq, rpl); err != nil || !pass {
+		t.Fatalf("pass: %v, err: %v", pass, err)
+	}
+	if eapRpl, err := radEAPMessage(rpl); err != nil {
+		t.Error(err)
+	} else if eapRpl.Code != eapCodeSuccess {
+		t.Errorf("expected EAP-Success, received %+v", eapRpl)
+	}
+
+	// the State cannot be replayed
+	rpl = req.Reply()
+	if _, err := radEAPMD5Auth(req, aReq, rpl); err == nil {
+		t.Error("expected error for consumed State")
+	}
+}
+
+func TestRadEAPMD5AuthWrongPassword(t *testing.T) {
+	aReq := NewAgentRequest(nil, &utils.DataNode{Type: utils.NMMapType, Map: map[string]*utils.DataNode{
+		utils.UserPassword: utils.NewLeafNode("CGRateSPassword1"),
+	}}, nil, nil, nil, nil, "cgrates.org", "", nil, nil)
+	req := radigo.NewPacket(radigo.AccessRequest, 1, dictRad, coder, "CGRateS.org")
+	radAddEAPMessage(req, (&eapPacket{Code: eapCodeResponse, Identifier: 1,
+		Type: eapTypeIdentity, Data: []byte("1001")}).Encode())
+	rpl := req.Reply()
+	if _, err := radEAPMD5Auth(req, aReq, rpl); err != nil {
+		t.Fatal(err)
+	}
+	md5Req, err := radEAPMessage(rpl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req = radigo.NewPacket(radigo.AccessRequest, 2, dictRad, coder, "CGRateS.org")
+	req.AVPs = append(req.AVPs, &radigo.AVP{Number: radAttrState,
+		RawValue: radRawAttribute(rpl, radAttrState)})
+	radAddEAPMessage(req, (&eapPacket{Code: eapCodeResponse, Identifier: md5Req.Identifier,
+		Type: eapTypeMD5, Data: append([]byte{md5.Size}, make([]byte, md5.Size)...)}).Encode())
+	rpl = req.Reply()
+	if pass, err := radEAPMD5Auth(req, aReq, rpl); err != nil || pass {
+		t.Fatalf("pass: %v, err: %v", pass, err)
+	}
+	if rpl.Code != radigo.AccessReject {
+		t.Errorf("expected AccessReject, received %s", rpl.Code)
+	}
+	if eapRpl, err := radEAPMessage(rpl); err != nil {
+		t.Error(err)
+	} else if eapRpl.Code != eapCodeFailure {
+		t.Errorf("expected EAP-Failure, received %+v", eapRpl)
+	}
+}
@@ -0,0 +1,217 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package agents
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cgrates/cgrates/utils"
+	"github.com/cgrates/sipingo"
+)
+
+const (
+	byeMethod           = "BYE"
+	cancelMethod        = "CANCEL"
+	sipVersion          = "SIP/2.0"
+	viaHeader           = "Via"
+	toHeader            = "To"
+	cseqHeader          = "CSeq"
+	contactHeader       = "Contact"
+	routeHeader         = "Route"
+	recordRouteHeader   = "Record-Route"
+	maxForwardsHeader   = "Max-Forwards"
+	contentLengthHeader = "Content-Length"
+	statusCode          = "StatusCode"
+
+	sipBranchPrefix = "z9hG4bKcgr" // RFC 3261 magic cookie followed by our own marker
+	sipMaxForwards  = 70
+
+	sipTimerC = 3 * time.Minute  // maximum time to wait for a final answer to the INVITE
+	sipTimerF = 32 * time.Second // time a closed dialog is kept to relay its last messages
+
+	sipMaxDialogDuration = 24 * time.Hour // lifetime of the answered dialogs without dialog_timeout
+)
+
+// sipDialog is a dialog relayed and tracked by the SIPAgent,
+// the caller being the sender of the INVITE and the callee the next hop
+type sipDialog struct {
+	sync.Mutex
+	id         string // Call-ID and tag of the caller, indexing the dialog
+	callID     string
+	invite     sipingo.Message    // INVITE which created the dialog
+	branch     string             // branch of the INVITE transaction relayed to the callee
+	callerW    func([]byte) error // writes towards the caller
+	calleeW    func([]byte) error // writes towards the callee
+	callerURI  string             // Contact of the caller
+	calleeURI  string             // Contact of the callee, out of the 2xx answer
+	to         string             // To header tagged by the callee
+	callerCSeq int                // last CSeq number used by the caller
+	calleeCSeq int                // last CSeq number used by the callee
+	answered   bool
+	closed     bool
+	timer      *time.Timer
+}
+
+// fromCaller checks if the message was originated by the caller, based on the From tag
+func (dlg *sipDialog) fromCaller(m sipingo.Message) bool {
+	return sipTag(m[fromHeader]) == sipTag(dlg.invite[fromHeader])
+}
+
+// matchesCallee checks the tag of the callee once the dialog is answered,
+// the early dialog matching any since the callee did not tag it yet
+func (dlg *sipDialog) matchesCallee(tag string) bool {
+	return !dlg.answered || tag == sipTag(dlg.to)
+}
+
+// byeRequests builds the BYE requests towards the callee and the caller
+func (dlg *sipDialog) byeRequests(sentBy string) (toCallee, toCaller sipingo.Message) {
+	dlg.callerCSeq++
+	toCallee = newSIPRequest(byeMethod, dlg.calleeURI,
+		dlg.invite[fromHeader], dlg.to, dlg.callID, dlg.callerCSeq, sentBy)
+	dlg.calleeCSeq++
+	toCaller = newSIPRequest(byeMethod, dlg.callerURI,
+		dlg.to, dlg.invite[fromHeader], dlg.callID, dlg.calleeCSeq, sentBy)
+	return
+}
+
+// cancelRequest builds the CANCEL of the INVITE relayed to the callee
+func (dlg *sipDialog) cancelRequest(sentBy string) (m sipingo.Message) {
+	inviteCSeq, _ := sipCSeq(dlg.invite)
+	m = newSIPRequest(cancelMethod, strings.Fields(dlg.invite[requestHeader])[1],
+		dlg.invite[fromHeader], dlg.invite[toHeader], dlg.callID, inviteCSeq, sentBy)
+	m[viaHeader] = sipVia(sentBy, dlg.branch)
+	return
+}
+
+// sipDialogID returns the key of the dialog out of its Call-ID and the tag of the caller
+func sipDialogID(callID, callerTag string) string {
+	return utils.ConcatenatedKey(callID, callerTag)
+}
+
+// newSIPRequest builds a request originated by the agent
+func newSIPRequest(method, uri, from, to, callID string, cseq int, sentBy string) sipingo.Message {
+	return sipingo.Message{
+		requestHeader:       fmt.Sprintf("%s %s %s", method, uri, sipVersion),
+		viaHeader:           sipVia(sentBy, sipBranchPrefix+utils.GenUUID()),
+		fromHeader:          from,
+		toHeader:            to,
+		callIDHeader:        callID,
+		cseqHeader:          fmt.Sprintf("%d %s", cseq, method),
+		maxForwardsHeader:   strconv.Itoa(sipMaxForwards),
+		contentLengthHeader: "0",
+	}
+}
+
+// sipSentBy returns the protocol and the address of the agent as advertised in its Via headers
+func sipSentBy(listenNet, listen string) string {
+	transport := strings.ToUpper(listenNet)
+	if listenNet == utils.TCPTLS {
+		transport = "TLS"
+	}
+	return fmt.Sprintf("%s/%s %s", sipVersion, transport, listen)
+}
+
+// sipVia returns the Via header value identifying the agent
+func sipVia(sentBy, branch string) string {
+	return fmt.Sprintf("%s;branch=%s", sentBy, branch)
+}
+
+// sipPushVia adds the agent on top of the Via headers
+func sipPushVia(m sipingo.Message, sentBy, branch string) {
+	via := sipVia(sentBy, branch)
+	if m[viaHeader] != utils.EmptyString {
+		via += utils.FieldsSep + m[viaHeader]
+	}
+	m[viaHeader] = via
+}
+
+// sipPopVia removes the top Via header if it was added by the agent
+func sipPopVia(m sipingo.Message) bool {
+	top, rest, _ := strings.Cut(m[viaHeader], utils.FieldsSep)
+	if !strings.Contains(top, sipBranchPrefix) {
+		return false
+	}
+	if rest == utils.EmptyString {
+		delete(m, viaHeader)
+	} else {
+		m[viaHeader] = rest
+	}
+	return true
+}
+
+// sipStripRoute removes the Route entries pointing to the agent
+func sipStripRoute(m sipingo.Message, listen string) {
+	if m[routeHeader] == utils.EmptyString {
+		return
+	}
+	var routes []string
+	for _, route := range strings.Split(m[routeHeader], utils.FieldsSep) {
+		if !strings.Contains(route, listen) {
+			routes = append(routes, route)
+		}
+	}
+	if len(routes) == 0 {
+		delete(m, routeHeader)
+		return
+	}
+	m[routeHeader] = strings.Join(routes, utils.FieldsSep)
+}
+
+// sipStatusCode returns the status code of a response or 0 for requests
+func sipStatusCode(m sipingo.Message) (code int) {
+	if !strings.HasPrefix(m[requestHeader], sipVersion+" ") {
+		return
+	}
+	if flds := strings.Fields(m[requestHeader]); len(flds) > 1 {
+		code, _ = strconv.Atoi(flds[1])
+	}
+	return
+}
+
+// sipCSeq returns the number and the method out of the CSeq header
+func sipCSeq(m sipingo.Message) (nr int, mthd string) {
+	flds := strings.Fields(m[cseqHeader])
+	if len(flds) != 2 {
+		return
+	}
+	nr, _ = strconv.Atoi(flds[0])
+	return nr, flds[1]
+}
+
+// sipTag returns the tag parameter of a From/To header
+func sipTag(hdr string) string {
+	if tags := sipTagRgx.FindStringSubmatch(hdr); len(tags) > 1 {
+		return tags[1]
+	}
+	return utils.EmptyString
+}
+
+// sipURI returns the URI out of a name-addr or addr-spec header value
+func sipURI(hdr string) string {
+	if _, uri, has := strings.Cut(hdr, "<"); has {
+		uri, _, _ = strings.Cut(uri, ">")
+		return uri
+	}
+	uri, _, _ := strings.Cut(strings.TrimSpace(hdr), utils.InfieldSep)
+	return uri
+}
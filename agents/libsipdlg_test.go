@@ -0,0 +1,224 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package agents
+
+import (
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cgrates/birpc/context"
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/engine"
+	"github.com/cgrates/cgrates/utils"
+	"github.com/cgrates/sipingo"
+)
+
+func TestSIPViaHandling(t *testing.T) {
+	m := sipingo.Message{
+		viaHeader: "SIP/2.0/UDP 127.0.0.1:5080;branch=z9hG4bK-1",
+	}
+	if sipPopVia(m) {
+		t.Error("popped foreign Via")
+	}
+	sipPushVia(m, sipSentBy(utils.UDP, "127.0.0.1:5060"), sipBranchPrefix+"1")
+	if exp := "SIP/2.0/UDP 127.0.0.1:5060;branch=z9hG4bKcgr1,SIP/2.0/UDP 127.0.0.1:5080;branch=z9hG4bK-1"; m[viaHeader] != exp {
+		t.Errorf("expected %q, received %q", exp, m[viaHeader])
+	}
+	if !sipPopVia(m) {
+		t.Error("Via of the agent not popped")
+	}
+	if exp := "SIP/2.0/UDP 127.0.0.1:5080;branch=z9hG4bK-1"; m[viaHeader] != exp {
+		t.Errorf("expected %q, received %q", exp, m[viaHeader])
+	}
+	for listenNet, exp := range map[string]string{
+		utils.TCP:    "SIP/2.0/TCP 127.0.0.1:5060",
+		utils.TCPTLS: "SIP/2.0/TLS 127.0.0.1:5060",
+		utils.WS:     "SIP/2.0/WS 127.0.0.1:5060",
+		utils.WSS:    "SIP/2.0/WSS 127.0.0.1:5060",
+	} {
+		if sentBy := sipSentBy(listenNet, "127.0.0.1:5060"); sentBy != exp {
+			t.Errorf("for %s expected %q, received %q", listenNet, exp, sentBy)
+		}
+	}
+}
+
+func TestSIPHeaderHelpers(t *testing.T) {
+	m := sipingo.Message{
+		requestHeader: "SIP/2.0 183 Session Progress",
+		cseqHeader:    "102 INVITE",
+		routeHeader:   "<sip:127.0.0.1:5060;lr>,<sip:10.0.0.1;lr>",
+		fromHeader:    `"1001" <sip:1001@127.0.0.1>;tag=99f35805`,
+	}
+	if code := sipStatusCode(m); code != 183 {
+		t.Errorf("expected 183, received %d", code)
+	}
+	if nr, mthd := sipCSeq(m); nr != 102 || mthd != inviteMethod {
+		t.Errorf("received CSeq: %d %s", nr, mthd)
+	}
+	if tag := sipTag(m[fromHeader]); tag != "99f35805" {
+		t.Errorf("received tag: %q", tag)
+	}
+	if uri := sipURI(m[fromHeader]); uri != "sip:1001@127.0.0.1" {
+		t.Errorf("received URI: %q", uri)
+	}
+	if uri := sipURI("sip:1002@127.0.0.1:5090;transport=udp"); uri != "sip:1002@127.0.0.1:5090" {
+		t.Errorf("received URI: %q", uri)
+	}
+	sipStripRoute(m, "127.0.0.1:5060")
+	if m[routeHeader] != "<sip:10.0.0.1;lr>" {
+		t.Errorf("received Route: %q", m[routeHeader])
+	}
+	if code := sipStatusCode(sipingo.Message{requestHeader: "INVITE sip:1002@127.0.0.1 SIP/2.0"}); code != 0 {
+		t.Errorf("expected 0 for requests, received %d", code)
+	}
+}
+
+func TestSIPAgentDialogTracking(t *testing.T) {
+	callee, err := net.ListenPacket(utils.UDP, "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer callee.Close()
+	conn, err := net.ListenPacket(utils.UDP, "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	readCallee := func() sipingo.Message {
+		t.Helper()
+		buf := make([]byte, bufferSize)
+		callee.SetReadDeadline(time.Now().Add(time.Second))
+		n, _, err := callee.ReadFrom(buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		m, err := sipingo.NewMessage(string(buf[:n]))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return m
+	}
+
+	cfg := config.NewDefaultCGRConfig()
+	cfg.TemplatesCfg()[utils.MetaErr] = []*config.FCTemplate{} // default one is built for Diameter
+	cfg.SIPAgentCfg().Listen = "127.0.0.1:5060"
+	cfg.SIPAgentCfg().DialogTracking = true
+	cfg.SIPAgentCfg().NextHop = callee.LocalAddr().String()
+	cfg.SIPAgentCfg().RequestProcessors = []*config.RequestProcessor{{
+		ID:    "Track",
+		Flags: utils.FlagsWithParamsFromSlice([]string{utils.MetaNone}),
+	}}
+	sa, err := NewSIPAgent(engine.NewConnManager(cfg, nil), cfg,
+		engine.NewFilterS(cfg, nil, nil), engine.NewCaps(0, utils.MetaBusy))
+	if err != nil {
+		t.Fatal(err)
+	}
+	sa.conn = conn
+	var toCaller []sipingo.Message
+	writeCaller := func(b []byte) error {
+		m, err := sipingo.NewMessage(string(b))
+		toCaller = append(toCaller, m)
+		return err
+	}
+
+	invite := "INVITE sip:1002@127.0.0.1:5060 SIP/2.0\r\n" +
+		"Via: SIP/2.0/UDP 127.0.0.1:5080;branch=z9hG4bK-1\r\n" +
+		"From: <sip:1001@127.0.0.1>;tag=1001tag\r\n" +
+		"To: <sip:1002@127.0.0.1>\r\n" +
+		"Call-ID: dlg1@127.0.0.1\r\n" +
+		"CSeq: 1 INVITE\r\n" +
+		"Contact: <sip:1001@127.0.0.1:5080>\r\n" +
+		"Max-Forwards: 70\r\n" +
+		"Content-Length: 0\r\n\r\n"
+	if err := sa.answerMessage(invite, "127.0.0.1:5080", writeCaller); err != nil {
+		t.Fatal(err)
+	}
+	if len(toCaller) != 1 || toCaller[0][requestHeader] != "SIP/2.0 100 Trying" {
+		t.Fatalf("expected 100 Trying, received: %v", toCaller)
+	}
+	relayed := readCallee()
+	if !strings.HasPrefix(relayed[viaHeader], "SIP/2.0/UDP 127.0.0.1:5060;branch="+sipBranchPrefix) ||
+		relayed[recordRouteHeader] != "<sip:127.0.0.1:5060;lr>" ||
+		relayed[maxForwardsHeader] != "69" {
+		t.Fatalf("unexpected relayed INVITE: %v", relayed)
+	}
+
+	// the callee answers
+	ok := relayed.Clone()
+	ok[requestHeader] = "SIP/2.0 200 OK"
+	ok[toHeader] = "<sip:1002@127.0.0.1>;tag=1002tag"
+	ok[contactHeader] = "<sip:1002@127.0.0.1:5090>"
+	if err := sa.answerMessage(ok.String(), cfg.SIPAgentCfg().NextHop, nil); err != nil {
+		t.Fatal(err)
+	}
+	if len(toCaller) != 2 || toCaller[1][requestHeader] != "SIP/2.0 200 OK" ||
+		toCaller[1][viaHeader] != "SIP/2.0/UDP 127.0.0.1:5080;branch=z9hG4bK-1" {
+		t.Fatalf("unexpected answer relayed to the caller: %v", toCaller)
+	}
+	if _, has := sa.dialogs[sipDialogID("dlg1@127.0.0.1", "1001tag")]; !has {
+		t.Fatalf("dialog not tracked: %v", sa.dialogs)
+	}
+	if dlgTimeout := sa.dialogTimeout(); dlgTimeout != 3*time.Hour {
+		t.Errorf("expected 3h, received %v", dlgTimeout)
+	}
+	cfg.SIPAgentCfg().DialogTimeout = 0 // the dialogs are still limited
+	if dlgTimeout := sa.dialogTimeout(); dlgTimeout != sipMaxDialogDuration {
+		t.Errorf("expected %v, received %v", sipMaxDialogDuration, dlgTimeout)
+	}
+
+	// same Call-ID with other tags is not part of the dialog
+	for _, m := range []sipingo.Message{
+		{fromHeader: "<sip:1001@127.0.0.1>;tag=1001tag", toHeader: "<sip:1002@127.0.0.1>;tag=othertag"},
+		{fromHeader: "<sip:1001@127.0.0.1>;tag=othertag", toHeader: "<sip:1002@127.0.0.1>;tag=1002tag"},
+	} {
+		m[callIDHeader] = "dlg1@127.0.0.1"
+		if dlg, has := sa.dialogOf(m); has {
+			t.Errorf("message %v matched dialog %v", m, dlg)
+		}
+	}
+	if _, has := sa.dialogOf(sipingo.Message{callIDHeader: "dlg1@127.0.0.1",
+		fromHeader: "<sip:1002@127.0.0.1>;tag=1002tag", toHeader: "<sip:1001@127.0.0.1>;tag=1001tag"}); !has {
+		t.Error("request of the callee not matched")
+	}
+
+	// disconnect requested by SessionS
+	var reply string
+	if err := sa.V1DisconnectSession(context.Background(), utils.CGREvent{
+		Event: map[string]any{utils.OriginID: "dlg1@127.0.0.1"}}, &reply); err != nil {
+		t.Fatal(err)
+	}
+	if bye := readCallee(); bye[requestHeader] != "BYE sip:1002@127.0.0.1:5090 SIP/2.0" ||
+		bye[toHeader] != "<sip:1002@127.0.0.1>;tag=1002tag" ||
+		bye[cseqHeader] != "2 BYE" {
+		t.Errorf("unexpected BYE to the callee: %v", bye)
+	}
+	if len(toCaller) != 3 || toCaller[2][requestHeader] != "BYE sip:1001@127.0.0.1:5080 SIP/2.0" ||
+		toCaller[2][fromHeader] != "<sip:1002@127.0.0.1>;tag=1002tag" {
+		t.Errorf("unexpected BYE to the caller: %v", toCaller)
+	}
+	if len(sa.dialogs) != 0 {
+		t.Errorf("dialog still tracked: %v", sa.dialogs)
+	}
+	if err := sa.V1DisconnectSession(context.Background(), utils.CGREvent{
+		Event: map[string]any{utils.OriginID: "dlg1@127.0.0.1"}}, &reply); err != utils.ErrNotFound {
+		t.Errorf("expected %v, received %v", utils.ErrNotFound, err)
+	}
+}
@@ -25,6 +25,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/cgrates/birpc"
@@ -81,6 +82,7 @@ func NewRadiusAgent(cgrCfg *config.CGRConfig, filterS *engine.FilterS, connMgr *
 	}
 	dicts := radigo.NewDictionaries(dts)
 	secrets := radigo.NewSecrets(radAgentCfg.ClientSecrets)
+	radAgent.secrets = secrets
 	radAgent.dacCfg = newRadiusDAClientCfg(dicts, secrets, radAgentCfg)
 	radAgent.rsAuth = make(map[string]*radigo.Server, len(radAgentCfg.Listeners))
 	radAgent.rsAcct = make(map[string]*radigo.Server, len(radAgentCfg.Listeners))
@@ -111,8 +113,13 @@ type RadiusAgent struct {
 	rsAuth  map[string]*radigo.Server
 	rsAcct  map[string]*radigo.Server
 	dacCfg  radiusDAClientCfg
+	secrets *radigo.Secrets
 	ctx     *context.Context
 	sync.WaitGroup
+
+	eapClnt    *radigo.Client         // connection towards the upstream EAP server, protected by the RWMutex
+	eapClntCfg config.RadiusEAPServer // configuration eapClnt was opened with, recreated once it changes
+	eapReqID   atomic.Uint32          // identifier of the requests relayed to the upstream EAP server
 }
 
 // radiusDAClientCfg holds the dictionaries and secrets necessary for initializing Dynamic Authorization Clients in RADIUS (only for
@@ -152,6 +159,13 @@ func (ra *RadiusAgent) handleAuth(reqPacket *radigo.Packet) (*radigo.Packet, err
 		defer ra.caps.Deallocate()
 	}
 	reqPacket.SetAVPValues() // populate string values in AVPs
+	secret := ra.clientSecret(reqPacket)
+	signReply, err := ra.checkMessageAuthenticator(reqPacket, secret)
+	if err != nil {
+		utils.Logger.Warning(fmt.Sprintf("<%s> error: <%v> discarding request: %s",
+			utils.RadiusAgent, err, utils.ToJSON(reqPacket)))
+		return nil, nil
+	}
 	replyPacket := reqPacket.Reply()
 	replyPacket.Code = radigo.AccessAccept
 	cgrReplyNM := &utils.DataNode{Type: utils.NMMapType, Map: map[string]*utils.DataNode{}}
@@ -197,9 +211,47 @@ func (ra *RadiusAgent) handleAuth(reqPacket *radigo.Packet) (*radigo.Packet, err
 			utils.RadiusAgent, err, utils.ToIJSON(reqPacket)))
 		return nil, err
 	}
+	if signReply {
+		if err := radSignMessageAuthenticator(replyPacket, secret); err != nil {
+			utils.Logger.Err(fmt.Sprintf("<%s> err: %v, signing reply to message: %+v",
+				utils.RadiusAgent, err, utils.ToIJSON(reqPacket)))
+			return nil, err
+		}
+	}
 	return replyPacket, nil
 }
 
+// clientSecret returns the secret shared with the client sending the packet
+func (ra *RadiusAgent) clientSecret(pkt *radigo.Packet) string {
+	host, _, err := net.SplitHostPort(pkt.RemoteAddr().String())
+	if err != nil {
+		host = pkt.RemoteAddr().String()
+	}
+	return ra.secrets.GetSecret(host)
+}
+
+// checkMessageAuthenticator validates the Message-Authenticator of an authentication request,
+// mandatory for EAP (RFC 3579) or when requested via configuration, and returns whether the
+// reply needs to be signed as well
+func (ra *RadiusAgent) checkMessageAuthenticator(req *radigo.Packet, secret string) (bool, error) {
+	hasMsgAuth := req.Has(radAttrMessageAuthenticator)
+	if !hasMsgAuth {
+		if req.Code == radigo.AccessRequest &&
+			(req.Has(radAttrEAPMessage) || ra.cgrCfg.RadiusAgentCfg().RequireMessageAuthenticator) {
+			return false, utils.NewErrMandatoryIeMissing(MessageAuthenticatorAVP)
+		}
+		return false, nil
+	}
+	valid, err := radVerifyMessageAuthenticator(req, secret)
+	if err != nil {
+		return false, err
+	}
+	if !valid {
+		return false, fmt.Errorf("invalid %s", MessageAuthenticatorAVP)
+	}
+	return true, nil
+}
+
 // handleAcct processes RADIUS Accounting requests and generates a reply.
 // It supports Acct-Status-Type values: Start, Interim-Update, Stop.
 func (ra *RadiusAgent) handleAcct(reqPacket *radigo.Packet) (*radigo.Packet, error) {
@@ -485,7 +537,12 @@ func (ra *RadiusAgent) processRequest(req *radigo.Packet, reqProcessor *config.R
 	case utils.MetaCDRs: // allow this method
 	case utils.MetaRadauth:
 		var pass bool
-		if pass, err = radauthReq(reqProcessor.Flags, req, agReq, rpl); err != nil {
+		if reqProcessor.Flags.Has(utils.MetaEAPPassthrough) {
+			pass, err = ra.eapPassthrough(req, rpl)
+		} else {
+			pass, err = radauthReq(reqProcessor.Flags, req, agReq, rpl)
+		}
+		if err != nil {
 			replyState = utils.ErrReplyStateRadauth
 			agReq.CGRReply.Map[utils.Error] = utils.NewLeafNode(err.Error())
 		} else if !pass {
@@ -738,6 +795,113 @@ func (ra *RadiusAgent) sendRadDaReq(requestType radigo.PacketCode, requestTempla
 	return dynAuthReply.Code, nil
 }
 
+// eapPassthrough relays the EAP conversation to the upstream EAP server (ie. for EAP-TLS or PEAP),
+// tracking the upstream State within the local EAP session between the Access-Challenge round trips
+func (ra *RadiusAgent) eapPassthrough(req, rpl *radigo.Packet) (bool, error) {
+	if !req.Has(radAttrEAPMessage) {
+		return false, utils.NewErrMandatoryIeMissing(EAPMessageAVP)
+	}
+	sess := new(radEAPSession)
+	if req.Has(radAttrState) {
+		var err error
+		if sess, err = popRadEAPSession(req); err != nil {
+			return false, err
+		}
+	}
+	clnt, eapCfg, err := ra.eapServerClient()
+	if err != nil {
+		return false, err
+	}
+	upReq := clnt.NewRequest(radigo.AccessRequest, uint8(ra.eapReqID.Add(1)))
+	for _, avp := range req.AVPs {
+		switch avp.Number {
+		case radAttrState, radAttrProxyState, radAttrMessageAuthenticator:
+			continue // hop-by-hop attributes
+		}
+		upReq.AVPs = append(upReq.AVPs, &radigo.AVP{
+			Number:   avp.Number,
+			RawValue: avp.RawValue,
+		})
+	}
+	if len(sess.UpstreamState) != 0 {
+		upReq.AVPs = append(upReq.AVPs, &radigo.AVP{
+			Number:   radAttrState,
+			RawValue: sess.UpstreamState,
+		})
+	}
+	if err = radSignMessageAuthenticator(upReq, eapCfg.Secret); err != nil {
+		return false, err
+	}
+	upRpl, err := clnt.SendRequest(upReq)
+	if err != nil {
+		ra.Lock()
+		ra.eapClnt = nil // reconnect on next request
+		ra.Unlock()
+		return false, fmt.Errorf("EAP server request failed: %w", err)
+	}
+	if err = radVerifyReplyAuthenticators(upRpl, upReq.Authenticator, eapCfg.Secret); err != nil {
+		return false, fmt.Errorf("EAP server reply discarded: %w", err)
+	}
+	for _, avp := range upRpl.AVPs {
+		switch avp.Number {
+		case radAttrEAPMessage:
+			rpl.AVPs = append(rpl.AVPs, &radigo.AVP{
+				Number:   avp.Number,
+				RawValue: avp.RawValue,
+			})
+		case radigo.VendorSpecificNumber:
+			vsa, err := radigo.NewVSAFromAVP(avp)
+			if err != nil || vsa.Vendor != msVendorCode ||
+				(vsa.Number != msMPPESendKey && vsa.Number != msMPPERecvKey) {
+				continue
+			}
+			if vsa.RawValue, err = radMPPERecrypt(vsa.RawValue,
+				eapCfg.Secret, upReq.Authenticator,
+				ra.clientSecret(req), req.Authenticator); err != nil {
+				return false, err
+			}
+			rpl.AVPs = append(rpl.AVPs, vsa.AVP())
+		}
+	}
+	switch upRpl.Code {
+	case radigo.AccessChallenge:
+		sess.UpstreamState = radRawAttribute(upRpl, radAttrState)
+		rpl.Code = radigo.AccessChallenge
+		state := utils.GenUUID()
+		rpl.AVPs = append(rpl.AVPs, &radigo.AVP{
+			Number:   radAttrState,
+			RawValue: []byte(state),
+		})
+		return true, engine.Cache.Set(utils.CacheRadiusEAPSessions, state, sess,
+			nil, true, utils.NonTransactional)
+	case radigo.AccessAccept:
+		return true, nil
+	case radigo.AccessReject:
+		rpl.Code = radigo.AccessReject
+		return false, nil
+	default:
+		return false, fmt.Errorf("unexpected reply code from EAP server: %s", upRpl.Code)
+	}
+}
+
+// eapServerClient returns the connection towards the upstream EAP server together with the
+// configuration it was opened with, (re)opening it if needed or after a config reload
+func (ra *RadiusAgent) eapServerClient() (*radigo.Client, config.RadiusEAPServer, error) {
+	ra.Lock()
+	defer ra.Unlock()
+	eapCfg := ra.cgrCfg.RadiusAgentCfg().EAPPassthrough
+	if ra.eapClnt != nil && ra.eapClntCfg == eapCfg {
+		return ra.eapClnt, eapCfg, nil
+	}
+	clnt, err := radigo.NewClient(eapCfg.Transport, eapCfg.Address, eapCfg.Secret,
+		radigo.RFC2865Dictionary(), ra.cgrCfg.GeneralCfg().ConnectAttempts, nil, utils.Logger)
+	if err != nil {
+		return nil, eapCfg, fmt.Errorf("EAP server client init failed: %w", err)
+	}
+	ra.eapClnt, ra.eapClntCfg = clnt, eapCfg
+	return clnt, eapCfg, nil
+}
+
 // daRequestAddress ranges over the client_da_addresses map and returns the address configured for a
 // specific client alongside the host.
 func daRequestAddress(remoteAddr string, dynAuthAddresses map[string]config.DAClientOpts) (string, string, error) {
@@ -19,19 +19,24 @@ along with this program.  If not, see <https://www.gnu.org/licenses/>
 package agents
 
 import (
+	"crypto/tls"
 	"fmt"
 	"net"
+	"net/http"
 	"regexp"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
 
+	"github.com/cgrates/birpc"
 	"github.com/cgrates/birpc/context"
 	"github.com/cgrates/cgrates/config"
 	"github.com/cgrates/cgrates/engine"
 	"github.com/cgrates/cgrates/sessions"
 	"github.com/cgrates/cgrates/utils"
 	"github.com/cgrates/sipingo"
+	"nhooyr.io/websocket"
 )
 
 const (
@@ -44,6 +49,8 @@ const (
 	sipServerErr    = "SIP/2.0 500 Internal Server Error"
 	userAgentHeader = "User-Agent"
 	method          = "Method"
+
+	sipWSSubprotocol = "sip" // RFC 7118
 )
 
 var (
@@ -59,8 +66,21 @@ func NewSIPAgent(connMgr *engine.ConnManager, cfg *config.CGRConfig,
 		cfg:      cfg,
 		caps:     caps,
 		ackMap:   make(map[string]chan struct{}),
+		dialogs:  make(map[string]*sipDialog),
 		stopChan: make(chan struct{}),
 	}
+	srv, err := birpc.NewServiceWithMethodsRename(sa, utils.AgentV1, true, func(oldFn string) (newFn string) {
+		return strings.TrimPrefix(oldFn, "V1")
+	})
+	if err != nil {
+		return nil, err
+	}
+	sa.ctx = context.WithClient(context.TODO(), srv)
+	if sa.cfg.SIPAgentCfg().DialogTracking {
+		if sa.nextHop, err = net.ResolveUDPAddr(utils.UDP, sa.cfg.SIPAgentCfg().NextHop); err != nil {
+			return nil, err
+		}
+	}
 	msgTemplates := sa.cfg.TemplatesCfg()
 	// Inflate *template field types
 	for _, procsr := range sa.cfg.SIPAgentCfg().RequestProcessors {
@@ -84,9 +104,15 @@ type SIPAgent struct {
 	filterS  *engine.FilterS
 	cfg      *config.CGRConfig
 	caps     *engine.Caps
+	ctx      *context.Context
 	stopChan chan struct{}
 	ackMap   map[string]chan struct{}
 	ackLocks sync.RWMutex
+
+	nextHop *net.UDPAddr
+	conn    net.PacketConn        // UDP connection used to relay the tracked dialogs
+	dialogs map[string]*sipDialog // tracked dialogs indexed on Call-ID and the tag of the caller
+	dlgLck  sync.RWMutex
 }
 
 // Shutdown will stop the SIPAgent server
@@ -96,6 +122,12 @@ func (sa *SIPAgent) Shutdown() {
 		close(ch)
 	}
 	sa.ackLocks.Unlock()
+	sa.dlgLck.Lock()
+	for dlgID, dlg := range sa.dialogs {
+		dlg.timer.Stop()
+		delete(sa.dialogs, dlgID)
+	}
+	sa.dlgLck.Unlock()
 	close(sa.stopChan)
 }
 
@@ -105,9 +137,23 @@ func (sa *SIPAgent) ListenAndServe() (err error) {
 		utils.SIPAgent, sa.cfg.SIPAgentCfg().ListenNet, sa.cfg.SIPAgentCfg().Listen))
 	switch sa.cfg.SIPAgentCfg().ListenNet {
 	case utils.TCP:
-		return sa.serveTCP(sa.stopChan)
+		return sa.serveTCP(sa.stopChan, nil)
+	case utils.TCPTLS:
+		var tlsCfg *tls.Config
+		if tlsCfg, err = newTLSServerConfig(sa.cfg.TLSCfg()); err != nil {
+			return
+		}
+		return sa.serveTCP(sa.stopChan, tlsCfg)
 	case utils.UDP:
 		return sa.serveUDP(sa.stopChan)
+	case utils.WS:
+		return sa.serveWS(sa.stopChan, nil)
+	case utils.WSS:
+		var tlsCfg *tls.Config
+		if tlsCfg, err = newTLSServerConfig(sa.cfg.TLSCfg()); err != nil {
+			return
+		}
+		return sa.serveWS(sa.stopChan, tlsCfg)
 	default:
 		return fmt.Errorf("Unecepected protocol %s", sa.cfg.SIPAgentCfg().ListenNet)
 	}
@@ -126,6 +172,9 @@ func (sa *SIPAgent) serveUDP(stop chan struct{}) (err error) {
 	}
 
 	defer conn.Close()
+	sa.dlgLck.Lock()
+	sa.conn = conn
+	sa.dlgLck.Unlock()
 
 	buf := make([]byte, bufferSize)
 	wg := sync.WaitGroup{}
@@ -164,7 +213,8 @@ func (sa *SIPAgent) serveUDP(stop chan struct{}) (err error) {
 	}
 }
 
-func (sa *SIPAgent) serveTCP(stop chan struct{}) (err error) {
+// serveTCP serves the SIP messages over TCP, secured with TLS if tlsCfg is provided
+func (sa *SIPAgent) serveTCP(stop chan struct{}, tlsCfg *tls.Config) (err error) {
 	var l *net.TCPListener
 	var addr *net.TCPAddr
 	if addr, err = net.ResolveTCPAddr("tcp", sa.cfg.SIPAgentCfg().Listen); err != nil {
@@ -181,6 +231,10 @@ func (sa *SIPAgent) serveTCP(stop chan struct{}) (err error) {
 	}
 
 	defer l.Close()
+	var ln net.Listener = l
+	if tlsCfg != nil { // the deadlines are still set on the TCP listener
+		ln = tls.NewListener(l, tlsCfg)
+	}
 
 	wg := sync.WaitGroup{}
 	for {
@@ -192,7 +246,7 @@ func (sa *SIPAgent) serveTCP(stop chan struct{}) (err error) {
 		}
 		l.SetDeadline(time.Now().Add(time.Second))
 		var conn net.Conn
-		if conn, err = l.Accept(); err != nil {
+		if conn, err = ln.Accept(); err != nil {
 			if opErr, ok := err.(*net.OpError); ok && opErr.Timeout() {
 				continue
 			}
@@ -232,6 +286,75 @@ func (sa *SIPAgent) serveTCP(stop chan struct{}) (err error) {
 	}
 }
 
+// serveWS serves the SIP messages over WebSocket (RFC 7118), secured with TLS if tlsCfg is provided
+func (sa *SIPAgent) serveWS(stop chan struct{}, tlsCfg *tls.Config) (err error) {
+	var l net.Listener
+	if l, err = net.Listen(utils.TCP, sa.cfg.SIPAgentCfg().Listen); err != nil {
+		utils.Logger.Err(
+			fmt.Sprintf("<%s> error: %s unable to listen to: %s",
+				utils.SIPAgent, err.Error(), sa.cfg.SIPAgentCfg().Listen))
+		return
+	}
+	if tlsCfg != nil {
+		l = tls.NewListener(l, tlsCfg)
+	}
+	srv := &http.Server{
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			sa.serveWSConn(w, r, stop)
+		}),
+	}
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- srv.Serve(l)
+	}()
+	select {
+	case <-stop:
+		return srv.Close() // the connections already upgraded are closed by serveWSConn
+	case err = <-errChan:
+		utils.Logger.Err(
+			fmt.Sprintf("<%s> error: %s serving WebSocket on: %s",
+				utils.SIPAgent, err.Error(), sa.cfg.SIPAgentCfg().Listen))
+		return
+	}
+}
+
+// serveWSConn upgrades the HTTP request to WebSocket and answers the SIP messages received on it
+func (sa *SIPAgent) serveWSConn(w http.ResponseWriter, r *http.Request, stop chan struct{}) {
+	conn, err := websocket.Accept(w, r, &websocket.AcceptOptions{
+		Subprotocols:   []string{sipWSSubprotocol},
+		OriginPatterns: sa.cfg.SIPAgentCfg().WSOrigins,
+	})
+	if err != nil {
+		utils.Logger.Warning(
+			fmt.Sprintf("<%s> error: %s upgrading connection from: %s",
+				utils.SIPAgent, err.Error(), r.RemoteAddr))
+		return
+	}
+	defer conn.CloseNow()
+	if conn.Subprotocol() != sipWSSubprotocol {
+		conn.Close(websocket.StatusPolicyViolation, "sip subprotocol required")
+		return
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-stop:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	for {
+		_, msg, err := conn.Read(ctx)
+		if err != nil {
+			return
+		}
+		sa.answerMessage(string(msg), r.RemoteAddr, func(ans []byte) error {
+			return conn.Write(ctx, websocket.MessageText, ans)
+		}) // do not log the received error because is already logged in function so for now just ignore it
+	}
+}
+
 func (sa *SIPAgent) answerMessage(messageStr, addr string, write func(ans []byte) error) (err error) {
 	var sipMessage sipingo.Message // recreate map SIP
 	if sipMessage, err = sipingo.NewMessage(messageStr); err != nil {
@@ -244,6 +367,10 @@ func (sa *SIPAgent) answerMessage(messageStr, addr string, write func(ans []byte
 	// in case we get a wrong sip message ( without tag in the From header) the next line should panic
 	key := utils.ConcatenatedKey(sipMessage[callIDHeader], tags[1])
 	method := sipMessage.MethodFrom(requestHeader)
+	if sa.cfg.SIPAgentCfg().DialogTracking &&
+		sa.handleDialogMessage(sipMessage, addr) {
+		return
+	}
 	if ackMethod == method {
 		if sa.cfg.SIPAgentCfg().RetransmissionTimer == 0 { // ignore ACK
 			return
@@ -257,7 +384,12 @@ func (sa *SIPAgent) answerMessage(messageStr, addr string, write func(ans []byte
 		sa.ackLocks.Unlock() // log the message if we did not find it in the map
 	}
 	var sipAnswer sipingo.Message
-	if sipAnswer = sa.handleMessage(sipMessage, addr); len(sipAnswer) == 0 {
+	var processed bool
+	if sipAnswer, processed = sa.handleMessage(sipMessage.Clone(), addr); len(sipAnswer) == 0 {
+		if processed && method == inviteMethod &&
+			sa.cfg.SIPAgentCfg().DialogTracking {
+			sa.relayInvite(sipMessage, write)
+		}
 		return // do not write the message if we do not have anything to reply
 	}
 	ans := []byte(sipAnswer.String())
@@ -297,10 +429,10 @@ func (sa *SIPAgent) answerMessage(messageStr, addr string, write func(ans []byte
 	return
 }
 
-func (sa *SIPAgent) handleMessage(sipMessage sipingo.Message, remoteHost string) (sipAnswer sipingo.Message) {
+func (sa *SIPAgent) handleMessage(sipMessage sipingo.Message, remoteHost string) (sipAnswer sipingo.Message, processed bool) {
 	if sa.caps.IsLimited() {
 		if err := sa.caps.Allocate(); err != nil {
-			return bareSipErr(sipMessage, "SIP/2.0 503 Service Unavailable")
+			return bareSipErr(sipMessage, "SIP/2.0 503 Service Unavailable"), false
 		}
 		defer sa.caps.Deallocate()
 	}
@@ -312,7 +444,6 @@ func (sa *SIPAgent) handleMessage(sipMessage sipingo.Message, remoteHost string)
 		sipMessageIface[k] = v
 	}
 	dp := utils.MapStorage(sipMessageIface)
-	var processed bool
 	cgrRplyNM := &utils.DataNode{Type: utils.NMMapType, Map: map[string]*utils.DataNode{}}
 	rplyNM := utils.NewOrderedNavigableMap()
 	opts := utils.MapStorage{}
@@ -323,6 +454,9 @@ func (sa *SIPAgent) handleMessage(sipMessage sipingo.Message, remoteHost string)
 			method:           utils.NewLeafNode(sipMessage.MethodFrom(requestHeader)),
 		},
 	}
+	if code := sipStatusCode(sipMessage); code != 0 {
+		reqVars.Map[statusCode] = utils.NewLeafNode(code)
+	}
 	// build the negative error answer
 	sErr, err := sipErr(
 		dp, sipMessage.Clone(), reqVars,
@@ -334,7 +468,7 @@ func (sa *SIPAgent) handleMessage(sipMessage sipingo.Message, remoteHost string)
 		utils.Logger.Warning(
 			fmt.Sprintf("<%s> error: %s building errSIP for message: %s",
 				utils.SIPAgent, err.Error(), sipMessage))
-		return bareSipErr(sipMessage, sipServerErr)
+		return bareSipErr(sipMessage, sipServerErr), false
 	}
 
 	for _, reqProcessor := range sa.cfg.SIPAgentCfg().RequestProcessors {
@@ -362,7 +496,7 @@ func (sa *SIPAgent) handleMessage(sipMessage sipingo.Message, remoteHost string)
 		utils.Logger.Warning(
 			fmt.Sprintf("<%s> error: %s processing message: %s from %s",
 				utils.SIPAgent, err.Error(), sipMessage, remoteHost))
-		return sErr
+		return sErr, processed
 	}
 	if !processed {
 		utils.Logger.Warning(
@@ -377,10 +511,10 @@ func (sa *SIPAgent) handleMessage(sipMessage sipingo.Message, remoteHost string)
 		utils.Logger.Warning(
 			fmt.Sprintf("<%s> error: %s encoding out %s",
 				utils.SIPAgent, err.Error(), utils.ToJSON(rplyNM)))
-		return sErr
+		return sErr, processed
 	}
 	sipMessage.PrepareReply()
-	return sipMessage
+	return sipMessage, processed
 }
 
 // processRequest represents one processor processing the request
@@ -398,9 +532,9 @@ func (sa *SIPAgent) processRequest(reqProcessor *config.RequestProcessor,
 	cgrEv := utils.NMAsCGREvent(agReq.CGRRequest, agReq.Tenant, agReq.Opts)
 	var reqType string
 	for _, typ := range []string{
-		utils.MetaDryRun, utils.MetaAuthorize, /*
-			utils.MetaInitiate, utils.MetaUpdate,
-			utils.MetaTerminate, utils.MetaMessage,
+		utils.MetaDryRun, utils.MetaAuthorize,
+		utils.MetaInitiate, utils.MetaTerminate, /*
+			utils.MetaUpdate, utils.MetaMessage,
 			utils.MetaCDRs, */utils.MetaEvent, utils.MetaNone} {
 		if reqProcessor.Flags.Has(typ) { // request type is identified through flags
 			reqType = typ
@@ -452,13 +586,50 @@ func (sa *SIPAgent) processRequest(reqProcessor *config.RequestProcessor,
 			reqProcessor.Flags.ParamValue(utils.MetaRoutesMaxCost),
 		)
 		rply := new(sessions.V1AuthorizeReply)
-		err = sa.connMgr.Call(context.TODO(), sa.cfg.SIPAgentCfg().SessionSConns, utils.SessionSv1AuthorizeEvent,
+		err = sa.connMgr.Call(sa.ctx, sa.cfg.SIPAgentCfg().SessionSConns, utils.SessionSv1AuthorizeEvent,
 			authArgs, rply)
 		if err != nil {
 			replyState = utils.ErrReplyStateAuthorize
 		}
 		rply.SetMaxUsageNeeded(authArgs.GetMaxUsage)
 		agReq.setCGRReply(rply, err)
+	case utils.MetaInitiate:
+		initArgs := sessions.NewV1InitSessionArgs(
+			reqProcessor.Flags.GetBool(utils.MetaAttributes),
+			reqProcessor.Flags.ParamsSlice(utils.MetaAttributes, utils.MetaIDs),
+			reqProcessor.Flags.GetBool(utils.MetaThresholds),
+			reqProcessor.Flags.ParamsSlice(utils.MetaThresholds, utils.MetaIDs),
+			reqProcessor.Flags.GetBool(utils.MetaStats),
+			reqProcessor.Flags.ParamsSlice(utils.MetaStats, utils.MetaIDs),
+			reqProcessor.Flags.GetBool(utils.MetaResources),
+			reqProcessor.Flags.GetBool(utils.MetaIPs),
+			reqProcessor.Flags.Has(utils.MetaAccounts),
+			cgrEv, reqProcessor.Flags.Has(utils.MetaFD))
+		rply := new(sessions.V1InitSessionReply)
+		err = sa.connMgr.Call(sa.ctx, sa.cfg.SIPAgentCfg().SessionSConns, utils.SessionSv1InitiateSession,
+			initArgs, rply)
+		if err != nil {
+			replyState = utils.ErrReplyStateInitiate
+		}
+		rply.SetMaxUsageNeeded(initArgs.InitSession)
+		agReq.setCGRReply(rply, err)
+	case utils.MetaTerminate:
+		terminateArgs := sessions.NewV1TerminateSessionArgs(
+			reqProcessor.Flags.Has(utils.MetaAccounts),
+			reqProcessor.Flags.GetBool(utils.MetaResources),
+			reqProcessor.Flags.GetBool(utils.MetaIPs),
+			reqProcessor.Flags.GetBool(utils.MetaThresholds),
+			reqProcessor.Flags.ParamsSlice(utils.MetaThresholds, utils.MetaIDs),
+			reqProcessor.Flags.GetBool(utils.MetaStats),
+			reqProcessor.Flags.ParamsSlice(utils.MetaStats, utils.MetaIDs),
+			cgrEv, reqProcessor.Flags.Has(utils.MetaFD))
+		var rply string
+		err = sa.connMgr.Call(sa.ctx, sa.cfg.SIPAgentCfg().SessionSConns, utils.SessionSv1TerminateSession,
+			terminateArgs, &rply)
+		if err != nil {
+			replyState = utils.ErrReplyStateTerminate
+		}
+		agReq.setCGRReply(nil, err)
 	case utils.MetaEvent:
 		evArgs := &sessions.V1ProcessEventArgs{
 			Flags:     reqProcessor.Flags.SliceFlags(),
@@ -467,7 +638,7 @@ func (sa *SIPAgent) processRequest(reqProcessor *config.RequestProcessor,
 		}
 
 		rply := new(sessions.V1ProcessEventReply)
-		err = sa.connMgr.Call(context.TODO(), sa.cfg.SIPAgentCfg().SessionSConns, utils.SessionSv1ProcessEvent,
+		err = sa.connMgr.Call(sa.ctx, sa.cfg.SIPAgentCfg().SessionSConns, utils.SessionSv1ProcessEvent,
 			evArgs, rply)
 		if err != nil {
 			replyState = utils.ErrReplyStateEvent
@@ -526,7 +697,7 @@ func (sa *SIPAgent) processRequest(reqProcessor *config.RequestProcessor,
 		statIDs := strings.Split(rawStatIDs, utils.ANDSep)
 		ev.APIOpts[utils.OptsStatsProfileIDs] = statIDs
 		var reply []string
-		if err := sa.connMgr.Call(context.TODO(), sa.cfg.SIPAgentCfg().StatSConns,
+		if err := sa.connMgr.Call(sa.ctx, sa.cfg.SIPAgentCfg().StatSConns,
 			utils.StatSv1ProcessEvent, ev, &reply); err != nil {
 			return false, fmt.Errorf("failed to process %s event in %s: %v",
 				utils.SIPAgent, utils.StatS, err)
@@ -538,7 +709,7 @@ func (sa *SIPAgent) processRequest(reqProcessor *config.RequestProcessor,
 		thIDs := strings.Split(rawThIDs, utils.ANDSep)
 		ev.APIOpts[utils.OptsThresholdsProfileIDs] = thIDs
 		var reply []string
-		if err := sa.connMgr.Call(context.TODO(), sa.cfg.SIPAgentCfg().ThresholdSConns,
+		if err := sa.connMgr.Call(sa.ctx, sa.cfg.SIPAgentCfg().ThresholdSConns,
 			utils.ThresholdSv1ProcessEvent, ev, &reply); err != nil {
 			return false, fmt.Errorf("failed to process %s event in %s: %v",
 				utils.SIPAgent, utils.ThresholdS, err)
@@ -546,3 +717,296 @@ func (sa *SIPAgent) processRequest(reqProcessor *config.RequestProcessor,
 	}
 	return true, nil
 }
+
+// nextHopWriter returns the function writing towards the next hop over the listening connection
+func (sa *SIPAgent) nextHopWriter() func([]byte) error {
+	sa.dlgLck.RLock()
+	conn := sa.conn
+	sa.dlgLck.RUnlock()
+	return func(b []byte) (err error) {
+		_, err = conn.WriteTo(b, sa.nextHop)
+		return
+	}
+}
+
+// relayInvite relays the INVITE to the next hop, tracking the dialog it creates
+func (sa *SIPAgent) relayInvite(sipMessage sipingo.Message, write func([]byte) error) {
+	cseq, _ := sipCSeq(sipMessage)
+	dlg := &sipDialog{
+		id:         sipDialogID(sipMessage[callIDHeader], sipTag(sipMessage[fromHeader])),
+		callID:     sipMessage[callIDHeader],
+		invite:     sipMessage.Clone(),
+		branch:     sipBranchPrefix + utils.GenUUID(),
+		callerW:    write,
+		calleeW:    sa.nextHopWriter(),
+		callerURI:  sipURI(sipMessage[contactHeader]),
+		callerCSeq: cseq,
+	}
+	dlg.timer = time.AfterFunc(sipTimerC, func() { sa.onDialogTimeout(dlg) })
+	sa.dlgLck.Lock()
+	sa.dialogs[dlg.id] = dlg
+	sa.dlgLck.Unlock()
+	trying := sipMessage.Clone()
+	trying[requestHeader] = "SIP/2.0 100 Trying"
+	trying.PrepareReply()
+	if err := write([]byte(trying.String())); err != nil {
+		utils.Logger.Warning(
+			fmt.Sprintf("<%s> error: %s sending message: %s",
+				utils.SIPAgent, err.Error(), trying))
+	}
+	sa.relayRequest(sipMessage, dlg.branch, true, dlg.calleeW)
+}
+
+// relayRequest adds the agent on the path of the request and writes it towards its destination
+func (sa *SIPAgent) relayRequest(sipMessage sipingo.Message, branch string,
+	recordRoute bool, write func([]byte) error) {
+	listen := sa.cfg.SIPAgentCfg().Listen
+	fwd := sipMessage.Clone()
+	sipStripRoute(fwd, listen)
+	sipPushVia(fwd, sa.sentBy(), branch)
+	if recordRoute {
+		rr := fmt.Sprintf("<sip:%s;lr>", listen)
+		if fwd[recordRouteHeader] != utils.EmptyString {
+			rr += utils.FieldsSep + fwd[recordRouteHeader]
+		}
+		fwd[recordRouteHeader] = rr
+	}
+	if maxFwds, err := strconv.Atoi(fwd[maxForwardsHeader]); err == nil {
+		fwd[maxForwardsHeader] = strconv.Itoa(maxFwds - 1)
+	}
+	if err := write([]byte(fwd.String())); err != nil {
+		utils.Logger.Warning(
+			fmt.Sprintf("<%s> error: %s relaying message: %s",
+				utils.SIPAgent, err.Error(), fwd))
+	}
+}
+
+// sentBy returns the protocol and the address of the agent as advertised in its Via headers
+func (sa *SIPAgent) sentBy() string {
+	return sipSentBy(sa.cfg.SIPAgentCfg().ListenNet, sa.cfg.SIPAgentCfg().Listen)
+}
+
+// dialogOf returns the tracked dialog of the message, matching the Call-ID together with the tag
+// of the caller (From for its own requests, To for the callee ones) and the tag of the callee
+func (sa *SIPAgent) dialogOf(sipMessage sipingo.Message) (dlg *sipDialog, has bool) {
+	callID := sipMessage[callIDHeader]
+	fromTag, toTag := sipTag(sipMessage[fromHeader]), sipTag(sipMessage[toHeader])
+	calleeTag := toTag
+	sa.dlgLck.RLock()
+	if dlg, has = sa.dialogs[sipDialogID(callID, fromTag)]; !has {
+		dlg, has = sa.dialogs[sipDialogID(callID, toTag)]
+		calleeTag = fromTag
+	}
+	sa.dlgLck.RUnlock()
+	if !has {
+		return
+	}
+	dlg.Lock()
+	defer dlg.Unlock()
+	if !dlg.matchesCallee(calleeTag) {
+		return nil, false
+	}
+	return
+}
+
+// handleDialogMessage relays the messages belonging to the tracked dialogs,
+// returning false for the ones which should be answered statelessly
+func (sa *SIPAgent) handleDialogMessage(sipMessage sipingo.Message, addr string) bool {
+	if code := sipStatusCode(sipMessage); code != 0 {
+		sa.relayResponse(sipMessage, addr, code)
+		return true
+	}
+	dlg, has := sa.dialogOf(sipMessage)
+	if !has {
+		return false
+	}
+	method := sipMessage.MethodFrom(requestHeader)
+	cseq, _ := sipCSeq(sipMessage)
+	dlg.Lock()
+	write := dlg.calleeW
+	if dlg.fromCaller(sipMessage) {
+		dlg.callerCSeq = max(dlg.callerCSeq, cseq)
+	} else {
+		write = dlg.callerW
+		dlg.calleeCSeq = max(dlg.calleeCSeq, cseq)
+	}
+	branch := sipBranchPrefix + utils.GenUUID()
+	if !dlg.answered &&
+		(method == inviteMethod || method == ackMethod || method == cancelMethod) {
+		branch = dlg.branch // part of the INVITE transaction
+	}
+	closing := method == byeMethod && !dlg.closed
+	if closing {
+		dlg.closed = true
+		dlg.timer.Reset(sipTimerF)
+	}
+	dlg.Unlock()
+	if closing { // the answer is ignored since the BYE goes further anyway
+		sa.handleMessage(sipMessage.Clone(), addr)
+	}
+	sa.relayRequest(sipMessage, branch, false, write)
+	return true
+}
+
+// relayResponse relays the response back on the path of the request,
+// starting the session once the INVITE is answered
+func (sa *SIPAgent) relayResponse(sipMessage sipingo.Message, addr string, code int) {
+	if !sipPopVia(sipMessage) {
+		utils.Logger.Warning(
+			fmt.Sprintf("<%s> ignoring response not sent via the agent: %s",
+				utils.SIPAgent, sipMessage))
+		return
+	}
+	if sipMessage[viaHeader] == utils.EmptyString {
+		return // answer to a request originated by the agent
+	}
+	dlg, has := sa.dialogOf(sipMessage)
+	if !has {
+		return
+	}
+	_, cseqMethod := sipCSeq(sipMessage)
+	var answered bool
+	dlg.Lock()
+	write := dlg.callerW
+	if !dlg.fromCaller(sipMessage) { // answer to a request of the callee
+		write = dlg.calleeW
+	} else if cseqMethod == inviteMethod && !dlg.answered && !dlg.closed {
+		switch {
+		case code >= 200 && code < 300:
+			answered = true
+			dlg.answered = true
+			dlg.calleeURI = sipURI(sipMessage[contactHeader])
+			dlg.to = sipMessage[toHeader]
+			dlg.timer.Reset(sa.dialogTimeout())
+		case code >= 300:
+			dlg.closed = true
+			dlg.timer.Reset(sipTimerF)
+		}
+	}
+	dlg.Unlock()
+	var failed bool
+	if answered { // the answer of the processors is an error since we cannot reply to a response
+		sipAnswer, _ := sa.handleMessage(sipMessage.Clone(), addr)
+		failed = len(sipAnswer) != 0
+	}
+	if err := write([]byte(sipMessage.String())); err != nil {
+		utils.Logger.Warning(
+			fmt.Sprintf("<%s> error: %s relaying message: %s",
+				utils.SIPAgent, err.Error(), sipMessage))
+	}
+	if failed {
+		sa.disconnectDialog(dlg)
+	}
+}
+
+// dialogTimeout returns the maximum duration of the answered dialogs
+func (sa *SIPAgent) dialogTimeout() time.Duration {
+	if dlgTimeout := sa.cfg.SIPAgentCfg().DialogTimeout; dlgTimeout > 0 {
+		return dlgTimeout
+	}
+	return sipMaxDialogDuration
+}
+
+// onDialogTimeout is called when the timer of the dialog expires
+func (sa *SIPAgent) onDialogTimeout(dlg *sipDialog) {
+	dlg.Lock()
+	closed := dlg.closed
+	dlg.Unlock()
+	if closed {
+		sa.removeDialog(dlg)
+		return
+	}
+	if bye := sa.disconnectDialog(dlg); bye != nil { // terminate the session as for a received BYE
+		sa.handleMessage(bye, sa.cfg.SIPAgentCfg().Listen)
+	}
+}
+
+// removeDialog stops tracking the dialog
+func (sa *SIPAgent) removeDialog(dlg *sipDialog) {
+	dlg.timer.Stop()
+	sa.dlgLck.Lock()
+	if sa.dialogs[dlg.id] == dlg {
+		delete(sa.dialogs, dlg.id)
+	}
+	sa.dlgLck.Unlock()
+}
+
+// disconnectDialog ends the dialog on both sides, returning the BYE sent to the callee
+// unanswered dialogs are cancelled towards the callee and timed out towards the caller
+func (sa *SIPAgent) disconnectDialog(dlg *sipDialog) (byeCallee sipingo.Message) {
+	sentBy := sa.sentBy()
+	var toCallee, toCaller sipingo.Message
+	dlg.Lock()
+	if dlg.closed { // already ending
+		dlg.Unlock()
+		return
+	}
+	if dlg.answered {
+		toCallee, toCaller = dlg.byeRequests(sentBy)
+		byeCallee = toCallee
+	} else {
+		toCallee = dlg.cancelRequest(sentBy)
+		toCaller = dlg.invite.Clone()
+		toCaller[requestHeader] = "SIP/2.0 408 Request Timeout"
+		toCaller.PrepareReply()
+	}
+	dlg.closed = true
+	dlg.Unlock()
+	sa.removeDialog(dlg)
+	for _, msg := range []struct {
+		m     sipingo.Message
+		write func([]byte) error
+	}{{toCallee, dlg.calleeW}, {toCaller, dlg.callerW}} {
+		if err := msg.write([]byte(msg.m.String())); err != nil {
+			utils.Logger.Warning(
+				fmt.Sprintf("<%s> error: %s sending message: %s",
+					utils.SIPAgent, err.Error(), msg.m))
+		}
+	}
+	return
+}
+
+// V1DisconnectSession is part of the sessions.BiRPClient, sending BYE to both sides
+// of the dialogs with the Call-ID matching the OriginID
+func (sa *SIPAgent) V1DisconnectSession(ctx *context.Context, cgrEv utils.CGREvent, reply *string) (err error) {
+	originID, err := cgrEv.FieldAsString(utils.OriginID)
+	if err != nil {
+		return fmt.Errorf("could not retrieve OriginID: %w", err)
+	}
+	var dlgs []*sipDialog
+	sa.dlgLck.RLock()
+	for _, dlg := range sa.dialogs {
+		if dlg.callID == originID {
+			dlgs = append(dlgs, dlg)
+		}
+	}
+	sa.dlgLck.RUnlock()
+	if len(dlgs) == 0 {
+		return utils.ErrNotFound
+	}
+	for _, dlg := range dlgs {
+		sa.disconnectDialog(dlg)
+	}
+	*reply = utils.OK
+	return
+}
+
+// V1GetActiveSessionIDs is part of the sessions.BiRPClient
+func (*SIPAgent) V1GetActiveSessionIDs(*context.Context, string, *[]*sessions.SessionID) error {
+	return utils.ErrNotImplemented
+}
+
+// V1AlterSession is part of the sessions.BiRPClient
+func (*SIPAgent) V1AlterSession(*context.Context, utils.CGREvent, *string) error {
+	return utils.ErrNotImplemented
+}
+
+// V1DisconnectPeer is part of the sessions.BiRPClient
+func (*SIPAgent) V1DisconnectPeer(*context.Context, *utils.DPRArgs, *string) error {
+	return utils.ErrNotImplemented
+}
+
+// V1WarnDisconnect is part of the sessions.BiRPClient
+func (*SIPAgent) V1WarnDisconnect(*context.Context, map[string]any, *string) error {
+	return utils.ErrNotImplemented
+}
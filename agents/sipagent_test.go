@@ -0,0 +1,186 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package agents
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/engine"
+	"github.com/cgrates/cgrates/utils"
+	"github.com/cgrates/sipingo"
+	"nhooyr.io/websocket"
+)
+
+const sipOptionsMsg = "OPTIONS sip:1002@127.0.0.1 SIP/2.0\r\n" +
+	"Via: SIP/2.0/TLS 127.0.0.1:5080;branch=z9hG4bK-1\r\n" +
+	"From: <sip:1001@127.0.0.1>;tag=1001tag\r\n" +
+	"To: <sip:1002@127.0.0.1>\r\n" +
+	"Call-ID: opts1@127.0.0.1\r\n" +
+	"CSeq: 1 OPTIONS\r\n" +
+	"Content-Length: 0\r\n\r\n"
+
+// newTestSIPAgent starts a SIPAgent answering 405 to any request over listenNet
+func newTestSIPAgent(t *testing.T, listenNet string) (sa *SIPAgent, addr string) {
+	t.Helper()
+	l, err := net.Listen(utils.TCP, "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr = l.Addr().String()
+	l.Close()
+	cfg := config.NewDefaultCGRConfig()
+	cfg.TemplatesCfg()[utils.MetaErr] = []*config.FCTemplate{}
+	cfg.TLSCfg().ServerCerificate = "../data/tls/server.crt"
+	cfg.TLSCfg().ServerKey = "../data/tls/server.key"
+	cfg.SIPAgentCfg().Listen = addr
+	cfg.SIPAgentCfg().ListenNet = listenNet
+	cfg.SIPAgentCfg().RetransmissionTimer = 0
+	rplyFld := &config.FCTemplate{
+		Tag:   "Request",
+		Path:  utils.MetaRep + utils.NestingSep + "Request",
+		Type:  utils.MetaConstant,
+		Value: config.NewRSRParsersMustCompile("SIP/2.0 405 Method Not Allowed", utils.InfieldSep),
+	}
+	rplyFld.ComputePath()
+	cfg.SIPAgentCfg().RequestProcessors = []*config.RequestProcessor{{
+		ID:          "NotAllowed",
+		Flags:       utils.FlagsWithParamsFromSlice([]string{utils.MetaNone}),
+		ReplyFields: []*config.FCTemplate{rplyFld},
+	}}
+	if sa, err = NewSIPAgent(engine.NewConnManager(cfg, nil), cfg,
+		engine.NewFilterS(cfg, nil, nil), engine.NewCaps(0, utils.MetaBusy)); err != nil {
+		t.Fatal(err)
+	}
+	go sa.ListenAndServe()
+	t.Cleanup(sa.Shutdown)
+	return
+}
+
+func TestSIPAgentServeTCPTLS(t *testing.T) {
+	_, addr := newTestSIPAgent(t, utils.TCPTLS)
+	var conn *tls.Conn
+	var err error
+	for range 20 { // wait for the listener
+		if conn, err = tls.Dial(utils.TCP, addr, &tls.Config{InsecureSkipVerify: true}); err == nil {
+			break
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	if _, err = conn.Write([]byte(sipOptionsMsg)); err != nil {
+		t.Fatal(err)
+	}
+	conn.SetReadDeadline(time.Now().Add(2 * time.Second))
+	buf := make([]byte, bufferSize)
+	n, err := conn.Read(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rply, err := sipingo.NewMessage(string(buf[:n])); err != nil {
+		t.Error(err)
+	} else if rply[requestHeader] != "SIP/2.0 405 Method Not Allowed" {
+		t.Errorf("unexpected reply: %s", rply)
+	}
+}
+
+func TestSIPAgentServeWSS(t *testing.T) {
+	sa, addr := newTestSIPAgent(t, utils.WSS)
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	dialOpts := &websocket.DialOptions{
+		Subprotocols: []string{sipWSSubprotocol},
+		HTTPClient: &http.Client{Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+		}},
+	}
+	var conn *websocket.Conn
+	var err error
+	for range 20 { // wait for the listener
+		if conn, _, err = websocket.Dial(ctx, "wss://"+addr, dialOpts); err == nil {
+			break
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.CloseNow()
+	if conn.Subprotocol() != sipWSSubprotocol {
+		t.Errorf("expected subprotocol %q, received %q", sipWSSubprotocol, conn.Subprotocol())
+	}
+	if err = conn.Write(ctx, websocket.MessageText, []byte(sipOptionsMsg)); err != nil {
+		t.Fatal(err)
+	}
+	_, msg, err := conn.Read(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rply, err := sipingo.NewMessage(string(msg)); err != nil {
+		t.Error(err)
+	} else if rply[requestHeader] != "SIP/2.0 405 Method Not Allowed" {
+		t.Errorf("unexpected reply: %s", rply)
+	}
+
+	// the sip subprotocol is mandatory
+	dialOpts.Subprotocols = nil
+	if conn, _, err = websocket.Dial(ctx, "wss://"+addr, dialOpts); err != nil {
+		t.Fatal(err)
+	}
+	defer conn.CloseNow()
+	if _, _, err = conn.Read(ctx); websocket.CloseStatus(err) != websocket.StatusPolicyViolation {
+		t.Errorf("expected policy violation, received: %v", err)
+	}
+
+	// other origins only when allowed
+	dialOpts.Subprotocols = []string{sipWSSubprotocol}
+	dialOpts.HTTPHeader = http.Header{"Origin": {"https://webrtc.example.com"}}
+	if _, _, err = websocket.Dial(ctx, "wss://"+addr, dialOpts); err == nil {
+		t.Error("expected the origin to be rejected")
+	}
+	sa.cfg.SIPAgentCfg().WSOrigins = []string{"*.example.com"}
+	if conn, _, err = websocket.Dial(ctx, "wss://"+addr, dialOpts); err != nil {
+		t.Fatal(err)
+	}
+	conn.CloseNow()
+}
+
+func TestNewTLSServerConfig(t *testing.T) {
+	tlsCfg := &config.TLSCfg{
+		ServerCerificate: "../data/tls/server.crt",
+		ServerKey:        "../data/tls/server.key",
+	}
+	if rcv, err := newTLSServerConfig(tlsCfg); err != nil {
+		t.Fatal(err)
+	} else if len(rcv.Certificates) != 1 || rcv.MinVersion != tls.VersionTLS12 {
+		t.Errorf("unexpected TLS config: %+v", rcv)
+	}
+	tlsCfg.ServerKey = "/tmp/inexistent.key"
+	if _, err := newTLSServerConfig(tlsCfg); err == nil {
+		t.Error("expected error loading the certificate")
+	}
+}
@@ -512,6 +512,223 @@ func (apierSv1 *APIerSv1) modifyBalance(aType string, attr *AttrAddBalance, repl
 	return nil
 }
 
+// AttrCreateHold is the argument of CreateHold
+type AttrCreateHold struct {
+	Tenant      string
+	Account     string
+	HoldID      string // reference of the hold
+	BalanceType string
+	Value       float64
+	TTL         string // the hold never expires if empty
+}
+
+// AttrHold identifies the hold to capture or to release
+type AttrHold struct {
+	Tenant  string
+	Account string
+	HoldID  string
+	Value   *float64 // value to capture, all the held one if missing
+}
+
+// CreateHold reserves credit on the account without debiting it
+func (apierSv1 *APIerSv1) CreateHold(ctx *context.Context, attr *AttrCreateHold, reply *string) (err error) {
+	if missing := utils.MissingStructFields(attr, []string{utils.AccountField, utils.HoldID, utils.BalanceType}); len(missing) != 0 {
+		return utils.NewErrMandatoryIeMissing(missing...)
+	}
+	var ttl time.Duration
+	if ttl, err = utils.ParseDurationWithNanosecs(attr.TTL); err != nil {
+		return
+	}
+	if err = apierSv1.modifyAccount(attr.Tenant, attr.Account, utils.APIerSv1CreateHold, func(acc *engine.Account) error {
+		return acc.CreateHold(attr.HoldID, attr.BalanceType, attr.Value, ttl)
+	}); err != nil {
+		return
+	}
+	*reply = utils.OK
+	return
+}
+
+// CaptureHold debits the value out of the hold and releases the rest of it
+func (apierSv1 *APIerSv1) CaptureHold(ctx *context.Context, attr *AttrHold, reply *string) (err error) {
+	if missing := utils.MissingStructFields(attr, []string{utils.AccountField, utils.HoldID}); len(missing) != 0 {
+		return utils.NewErrMandatoryIeMissing(missing...)
+	}
+	if err = apierSv1.modifyAccount(attr.Tenant, attr.Account, utils.APIerSv1CaptureHold, func(acc *engine.Account) error {
+		var val float64
+		if attr.Value != nil {
+			val = *attr.Value
+		} else if h, has := acc.Holds[attr.HoldID]; has {
+			val = h.Value
+		}
+		return acc.CaptureHold(attr.HoldID, val, apierSv1.FilterS)
+	}); err != nil {
+		return
+	}
+	*reply = utils.OK
+	return
+}
+
+// ReleaseHold removes the hold without debiting the account
+func (apierSv1 *APIerSv1) ReleaseHold(ctx *context.Context, attr *AttrHold, reply *string) (err error) {
+	if missing := utils.MissingStructFields(attr, []string{utils.AccountField, utils.HoldID}); len(missing) != 0 {
+		return utils.NewErrMandatoryIeMissing(missing...)
+	}
+	if err = apierSv1.modifyAccount(attr.Tenant, attr.Account, utils.APIerSv1ReleaseHold, func(acc *engine.Account) error {
+		return acc.ReleaseHold(attr.HoldID)
+	}); err != nil {
+		return
+	}
+	*reply = utils.OK
+	return
+}
+
+// AttrStartSubscription is the argument of StartSubscription
+type AttrStartSubscription struct {
+	Tenant         string
+	Account        string
+	SubscriptionID string
+	Fee            float64 // charged for each monthly billing cycle
+	BalanceID      string  // *monetary balance charged, *default if empty
+	Anchor         string  // start of a billing cycle, the activation time if empty
+	GracePeriod    string  // unpaid time tolerated before suspending the account
+}
+
+// AttrChangeSubscription is the argument of ChangeSubscription
+type AttrChangeSubscription struct {
+	Tenant         string
+	Account        string
+	SubscriptionID string
+	Fee            float64
+}
+
+// AttrSubscription identifies the subscription to stop
+type AttrSubscription struct {
+	Tenant         string
+	Account        string
+	SubscriptionID string
+}
+
+// StartSubscription adds a recurring fee to the account, charging the rest of the current billing cycle.
+// The next cycles are charged by the *bill_subscriptions action, scheduled via an ActionPlan.
+func (apierSv1 *APIerSv1) StartSubscription(ctx *context.Context, attr *AttrStartSubscription, reply *string) (err error) {
+	if missing := utils.MissingStructFields(attr, []string{utils.AccountField, utils.SubscriptionID}); len(missing) != 0 {
+		return utils.NewErrMandatoryIeMissing(missing...)
+	}
+	sub := &engine.AccountSubscription{
+		ID:        attr.SubscriptionID,
+		Fee:       attr.Fee,
+		BalanceID: attr.BalanceID,
+	}
+	if sub.Anchor, err = utils.ParseTimeDetectLayout(attr.Anchor,
+		apierSv1.Config.GeneralCfg().DefaultTimezone); err != nil {
+		return
+	}
+	if sub.GracePeriod, err = utils.ParseDurationWithNanosecs(attr.GracePeriod); err != nil {
+		return
+	}
+	if err = apierSv1.modifyAccount(attr.Tenant, attr.Account, utils.APIerSv1StartSubscription, func(acc *engine.Account) error {
+		return acc.StartSubscription(sub, time.Now(), apierSv1.FilterS)
+	}); err != nil {
+		return
+	}
+	*reply = utils.OK
+	return
+}
+
+// ChangeSubscription replaces the fee of the subscription, prorating the difference for the period already paid
+func (apierSv1 *APIerSv1) ChangeSubscription(ctx *context.Context, attr *AttrChangeSubscription, reply *string) (err error) {
+	if missing := utils.MissingStructFields(attr, []string{utils.AccountField, utils.SubscriptionID}); len(missing) != 0 {
+		return utils.NewErrMandatoryIeMissing(missing...)
+	}
+	if err = apierSv1.modifyAccount(attr.Tenant, attr.Account, utils.APIerSv1ChangeSubscription, func(acc *engine.Account) error {
+		return acc.ChangeSubscription(attr.SubscriptionID, attr.Fee, time.Now(), apierSv1.FilterS)
+	}); err != nil {
+		return
+	}
+	*reply = utils.OK
+	return
+}
+
+// StopSubscription removes the subscription, refunding the rest of the period already paid
+func (apierSv1 *APIerSv1) StopSubscription(ctx *context.Context, attr *AttrSubscription, reply *string) (err error) {
+	if missing := utils.MissingStructFields(attr, []string{utils.AccountField, utils.SubscriptionID}); len(missing) != 0 {
+		return utils.NewErrMandatoryIeMissing(missing...)
+	}
+	if err = apierSv1.modifyAccount(attr.Tenant, attr.Account, utils.APIerSv1StopSubscription, func(acc *engine.Account) error {
+		return acc.StopSubscription(attr.SubscriptionID, time.Now(), apierSv1.FilterS)
+	}); err != nil {
+		return
+	}
+	*reply = utils.OK
+	return
+}
+
+// modifyAccount applies f on the locked account and stores it back, journaling the balance changes under the API name
+func (apierSv1 *APIerSv1) modifyAccount(tnt, acnt, apiName string, f func(acc *engine.Account) error) error {
+	if tnt == utils.EmptyString {
+		tnt = apierSv1.Config.GeneralCfg().DefaultTenant
+	}
+	accID := utils.ConcatenatedKey(tnt, acnt)
+	return guardian.Guardian.Guard(func() error {
+		acc, err := apierSv1.DataManager.GetAccount(accID)
+		if err != nil {
+			return err
+		}
+		return engine.JournalBalances(acc, &engine.BalanceJournalCause{Source: apiName}, func() error {
+			if err := f(acc); err != nil {
+				return err
+			}
+			return apierSv1.DataManager.SetAccount(acc)
+		})
+	}, apierSv1.Config.GeneralCfg().LockingTimeout, utils.AccountPrefix+accID)
+}
+
+// AttrGetBalanceJournal filters the balance journal of an account
+type AttrGetBalanceJournal struct {
+	Tenant    string
+	Account   string
+	TimeStart string // entries recorded starting with this time
+	TimeEnd   string // entries recorded before this time
+	utils.Paginator
+}
+
+// GetBalanceJournal returns the balance changes of an account in chronological order
+func (apierSv1 *APIerSv1) GetBalanceJournal(ctx *context.Context, attr *AttrGetBalanceJournal, reply *[]*engine.BalanceJournalEntry) (err error) {
+	if missing := utils.MissingStructFields(attr, []string{utils.AccountField}); len(missing) != 0 {
+		return utils.NewErrMandatoryIeMissing(missing...)
+	}
+	fltr := &utils.BalanceJournalFilter{
+		Tenant:    attr.Tenant,
+		Account:   attr.Account,
+		Paginator: attr.Paginator,
+	}
+	if fltr.Tenant == utils.EmptyString {
+		fltr.Tenant = apierSv1.Config.GeneralCfg().DefaultTenant
+	}
+	for _, tm := range []struct {
+		val string
+		out **time.Time
+	}{
+		{attr.TimeStart, &fltr.Time.Begin},
+		{attr.TimeEnd, &fltr.Time.End},
+	} {
+		if tm.val == utils.EmptyString {
+			continue
+		}
+		var t time.Time
+		if t, err = utils.ParseTimeDetectLayout(tm.val, apierSv1.Config.GeneralCfg().DefaultTimezone); err != nil {
+			return
+		}
+		*tm.out = &t
+	}
+	var jes []*engine.BalanceJournalEntry
+	if jes, err = apierSv1.CdrDb.GetBalanceJournal(fltr); err != nil {
+		return
+	}
+	*reply = jes
+	return
+}
+
 // SetBalance sets the balance for the given account
 // if the account is not already created it will create the account also
 func (apierSv1 *APIerSv1) SetBalance(ctx *context.Context, attr *utils.AttrSetBalance, reply *string) (err error) {
@@ -20,6 +20,7 @@ package v1
 
 import (
 	"testing"
+	"time"
 
 	"github.com/cgrates/birpc/context"
 	"github.com/cgrates/cgrates/config"
@@ -88,3 +89,94 @@ func TestAccountsSetGet(t *testing.T) {
 		t.Errorf("Accounts returned: %+v", accounts)
 	}
 }
+
+func TestAccountsHolds(t *testing.T) {
+	cfg := config.NewDefaultCGRConfig()
+	db, err := engine.NewInternalDB(nil, nil, true, nil, cfg.DataDbCfg().Items)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dm := engine.NewDataManager(db, cfg.CacheCfg(), nil)
+	apierSv1 := &APIerSv1{
+		DataManager: dm,
+		Config:      cfg,
+		FilterS:     engine.NewFilterS(cfg, nil, dm),
+	}
+	if err := dm.SetAccount(&engine.Account{ID: "cgrates.org:1001",
+		BalanceMap: map[string]engine.Balances{utils.MetaSMS: {&engine.Balance{Uuid: "sms", Value: 10}}}}); err != nil {
+		t.Fatal(err)
+	}
+	var reply string
+	if err := apierSv1.CreateHold(context.Background(), &AttrCreateHold{Account: "1001",
+		HoldID: "Bundle1", BalanceType: utils.MetaSMS, Value: 8, TTL: "1h"}, &reply); err != nil {
+		t.Fatal(err)
+	}
+	if err := apierSv1.CreateHold(context.Background(), &AttrCreateHold{Account: "1001",
+		HoldID: "Bundle2", BalanceType: utils.MetaSMS, Value: 3}, &reply); err != utils.ErrInsufficientCredit {
+		t.Errorf("expected %v, received %v", utils.ErrInsufficientCredit, err)
+	}
+	if err := apierSv1.CaptureHold(context.Background(), &AttrHold{Account: "1001",
+		HoldID: "Bundle1", Value: utils.Float64Pointer(5)}, &reply); err != nil {
+		t.Fatal(err)
+	}
+	acc, err := dm.GetAccount("cgrates.org:1001")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if acc.Holds != nil || acc.BalanceMap[utils.MetaSMS][0].Value != 5 {
+		t.Errorf("unexpected account: %s", utils.ToJSON(acc))
+	}
+	if err := apierSv1.ReleaseHold(context.Background(), &AttrHold{Account: "1001",
+		HoldID: "Bundle1"}, &reply); err != utils.ErrNotFound {
+		t.Errorf("expected %v, received %v", utils.ErrNotFound, err)
+	}
+}
+
+func TestAccountsSubscriptions(t *testing.T) {
+	cfg := config.NewDefaultCGRConfig()
+	db, err := engine.NewInternalDB(nil, nil, true, nil, cfg.DataDbCfg().Items)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dm := engine.NewDataManager(db, cfg.CacheCfg(), nil)
+	apierSv1 := &APIerSv1{
+		DataManager: dm,
+		Config:      cfg,
+		FilterS:     engine.NewFilterS(cfg, nil, dm),
+	}
+	if err := dm.SetAccount(&engine.Account{ID: "cgrates.org:1001",
+		BalanceMap: map[string]engine.Balances{utils.MetaMonetary: {&engine.Balance{Uuid: "mon", ID: "main", Value: 100}}}}); err != nil {
+		t.Fatal(err)
+	}
+	var reply string
+	if err := apierSv1.StartSubscription(context.Background(), &AttrStartSubscription{Account: "1001",
+		SubscriptionID: "TV", Fee: 10, BalanceID: "main", GracePeriod: "72h"}, &reply); err != nil {
+		t.Fatal(err)
+	}
+	acc, err := dm.GetAccount("cgrates.org:1001")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sub, has := acc.Subscriptions["TV"]; !has || sub.GracePeriod != 72*time.Hour ||
+		acc.BalanceMap[utils.MetaMonetary][0].Value != 90 {
+		t.Errorf("unexpected account: %s", utils.ToJSON(acc))
+	}
+	if err := apierSv1.ChangeSubscription(context.Background(), &AttrChangeSubscription{Account: "1001",
+		SubscriptionID: "TV", Fee: 1000}, &reply); err != utils.ErrInsufficientCredit {
+		t.Errorf("expected %v, received %v", utils.ErrInsufficientCredit, err)
+	}
+	if err := apierSv1.StopSubscription(context.Background(), &AttrSubscription{Account: "1001",
+		SubscriptionID: "TV"}, &reply); err != nil {
+		t.Fatal(err)
+	}
+	if acc, err = dm.GetAccount("cgrates.org:1001"); err != nil {
+		t.Fatal(err)
+	}
+	if acc.Subscriptions != nil || acc.BalanceMap[utils.MetaMonetary][0].Value < 99.99 {
+		t.Errorf("unexpected account: %s", utils.ToJSON(acc))
+	}
+	if err := apierSv1.StopSubscription(context.Background(), &AttrSubscription{Account: "1001",
+		SubscriptionID: "TV"}, &reply); err != utils.ErrNotFound {
+		t.Errorf("expected %v, received %v", utils.ErrNotFound, err)
+	}
+}
@@ -611,68 +611,9 @@ func (apierSv1 *APIerSv1) SetActions(ctx *context.Context, attrs *V1AttrSetActio
 	}
 	storeActions := make(engine.Actions, len(attrs.Actions))
 	for idx, apiAct := range attrs.Actions {
-		var blocker *bool
-		if apiAct.BalanceBlocker != utils.EmptyString {
-			if x, err := strconv.ParseBool(apiAct.BalanceBlocker); err == nil {
-				blocker = &x
-			} else {
-				return err
-			}
-		}
-
-		var disabled *bool
-		if apiAct.BalanceDisabled != utils.EmptyString {
-			if x, err := strconv.ParseBool(apiAct.BalanceDisabled); err == nil {
-				disabled = &x
-			} else {
-				return err
-			}
-		}
-		a := &engine.Action{
-			Id:               attrs.ActionsId,
-			ActionType:       apiAct.Identifier,
-			Weight:           apiAct.Weight,
-			ExpirationString: apiAct.ExpiryTime,
-			ExtraParameters:  apiAct.ExtraParameters,
-			Filters:          apiAct.Filters,
-			Balance: &engine.BalanceFilter{ // TODO: update this part
-				Uuid:           utils.StringPointer(apiAct.BalanceUuid),
-				ID:             utils.StringPointer(apiAct.BalanceId),
-				Type:           utils.StringPointer(apiAct.BalanceType),
-				Value:          &utils.ValueFormula{Static: apiAct.Units},
-				Weight:         apiAct.BalanceWeight,
-				DestinationIDs: utils.StringMapPointer(utils.ParseStringMap(apiAct.DestinationIds)),
-				RatingSubject:  utils.StringPointer(apiAct.RatingSubject),
-				SharedGroups:   utils.StringMapPointer(utils.ParseStringMap(apiAct.SharedGroups)),
-				Categories:     utils.StringMapPointer(utils.ParseStringMap(apiAct.Categories)),
-				TimingIDs:      utils.StringMapPointer(utils.ParseStringMap(apiAct.TimingTags)),
-				Blocker:        blocker,
-				Disabled:       disabled,
-			},
-		}
-		// load action timings from tags
-		if apiAct.TimingTags != "" {
-			timingIds := strings.Split(apiAct.TimingTags, utils.InfieldSep)
-			for _, timingID := range timingIds {
-				timingID = strings.TrimPrefix(timingID, utils.NegativePrefix)
-				timing, err := apierSv1.DataManager.GetTiming(timingID, false,
-					utils.NonTransactional)
-				if err != nil {
-					return fmt.Errorf("error: %v querying timing with id: %q",
-						err.Error(), timingID)
-				}
-				a.Balance.Timings = append(a.Balance.Timings, &engine.RITiming{
-					ID:        timingID,
-					Years:     timing.Years,
-					Months:    timing.Months,
-					MonthDays: timing.MonthDays,
-					WeekDays:  timing.WeekDays,
-					StartTime: timing.StartTime,
-					EndTime:   timing.EndTime,
-				})
-			}
+		if storeActions[idx], err = apierSv1.newActionFromV1(attrs.ActionsId, apiAct); err != nil {
+			return
 		}
-		storeActions[idx] = a
 	}
 	if err := apierSv1.DataManager.SetActions(attrs.ActionsId, storeActions); err != nil {
 		return utils.NewErrServerError(err)
@@ -692,6 +633,72 @@ func (apierSv1 *APIerSv1) SetActions(ctx *context.Context, attrs *V1AttrSetActio
 	return nil
 }
 
+// newActionFromV1 converts the API action into the engine one
+func (apierSv1 *APIerSv1) newActionFromV1(actsID string, apiAct *V1TPAction) (*engine.Action, error) {
+	var blocker *bool
+	if apiAct.BalanceBlocker != utils.EmptyString {
+		if x, err := strconv.ParseBool(apiAct.BalanceBlocker); err == nil {
+			blocker = &x
+		} else {
+			return nil, err
+		}
+	}
+
+	var disabled *bool
+	if apiAct.BalanceDisabled != utils.EmptyString {
+		if x, err := strconv.ParseBool(apiAct.BalanceDisabled); err == nil {
+			disabled = &x
+		} else {
+			return nil, err
+		}
+	}
+	a := &engine.Action{
+		Id:               actsID,
+		ActionType:       apiAct.Identifier,
+		Weight:           apiAct.Weight,
+		ExpirationString: apiAct.ExpiryTime,
+		ExtraParameters:  apiAct.ExtraParameters,
+		Filters:          apiAct.Filters,
+		Balance: &engine.BalanceFilter{ // TODO: update this part
+			Uuid:           utils.StringPointer(apiAct.BalanceUuid),
+			ID:             utils.StringPointer(apiAct.BalanceId),
+			Type:           utils.StringPointer(apiAct.BalanceType),
+			Value:          &utils.ValueFormula{Static: apiAct.Units},
+			Weight:         apiAct.BalanceWeight,
+			DestinationIDs: utils.StringMapPointer(utils.ParseStringMap(apiAct.DestinationIds)),
+			RatingSubject:  utils.StringPointer(apiAct.RatingSubject),
+			SharedGroups:   utils.StringMapPointer(utils.ParseStringMap(apiAct.SharedGroups)),
+			Categories:     utils.StringMapPointer(utils.ParseStringMap(apiAct.Categories)),
+			TimingIDs:      utils.StringMapPointer(utils.ParseStringMap(apiAct.TimingTags)),
+			Blocker:        blocker,
+			Disabled:       disabled,
+		},
+	}
+	// load action timings from tags
+	if apiAct.TimingTags != "" {
+		timingIds := strings.Split(apiAct.TimingTags, utils.InfieldSep)
+		for _, timingID := range timingIds {
+			timingID = strings.TrimPrefix(timingID, utils.NegativePrefix)
+			timing, err := apierSv1.DataManager.GetTiming(timingID, false,
+				utils.NonTransactional)
+			if err != nil {
+				return nil, fmt.Errorf("error: %v querying timing with id: %q",
+					err.Error(), timingID)
+			}
+			a.Balance.Timings = append(a.Balance.Timings, &engine.RITiming{
+				ID:        timingID,
+				Years:     timing.Years,
+				Months:    timing.Months,
+				MonthDays: timing.MonthDays,
+				WeekDays:  timing.WeekDays,
+				StartTime: timing.StartTime,
+				EndTime:   timing.EndTime,
+			})
+		}
+	}
+	return a, nil
+}
+
 // Retrieves actions attached to specific ActionsId within cache
 func (apierSv1 *APIerSv1) GetActions(ctx *context.Context, actsId *string, reply *[]*utils.TPAction) error {
 	if len(*actsId) == 0 {
@@ -1047,6 +1054,14 @@ func (apierSv1 *APIerSv1) LoadTariffPlanFromFolder(ctx *context.Context, attrs *
 	if err := loader.ReloadCache(caching, true, attrs.APIOpts, apierSv1.Config.GeneralCfg().DefaultTenant); err != nil {
 		return utils.NewErrServerError(err)
 	}
+	// the lookup tables are loaded directly into DataDB since they are too large for the TpReader
+	lktPath := path.Join(attrs.FolderPath, utils.LookupTablesCsv)
+	if _, err := os.Stat(lktPath); err == nil {
+		if err = apierSv1.loadLookupTables(lktPath, utils.CSVSep, apierSv1.Config.GeneralCfg().DefaultTenant,
+			caching, attrs.APIOpts); err != nil {
+			return err
+		}
+	}
 	if len(apierSv1.Config.ApierCfg().SchedulerConns) != 0 {
 		utils.Logger.Info("APIerSv1.LoadTariffPlanFromFolder, reloading scheduler.")
 		if err := loader.ReloadScheduler(true); err != nil {
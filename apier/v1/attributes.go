@@ -174,6 +174,12 @@ func (alSv1 *AttributeSv1) GetAttributeForEvent(ctx *context.Context, args *util
 	return alSv1.attrS.V1GetAttributeForEvent(ctx, args, reply)
 }
 
+// ExplainEvent details why the AttributeProfiles were matched or not for Event
+func (alSv1 *AttributeSv1) ExplainEvent(ctx *context.Context, args *utils.CGREvent,
+	reply *engine.MatchExplanation) (err error) {
+	return alSv1.attrS.V1ExplainEvent(ctx, args, reply)
+}
+
 // ProcessEvent will replace event fields with the ones in matching AttributeProfile
 func (alSv1 *AttributeSv1) ProcessEvent(ctx *context.Context, args *utils.CGREvent,
 	reply *engine.AttrSProcessEventReply) error {
@@ -79,6 +79,9 @@ func (apierSv1 *APIerSv1) SetChargerProfile(ctx *context.Context, arg *ChargerWi
 	if arg.Tenant == utils.EmptyString {
 		arg.Tenant = apierSv1.Config.GeneralCfg().DefaultTenant
 	}
+	if err := arg.CheckShare(); err != nil {
+		return utils.APIErrorHandler(err)
+	}
 	if err := apierSv1.DataManager.SetChargerProfile(arg.ChargerProfile, true); err != nil {
 		return utils.APIErrorHandler(err)
 	}
@@ -146,6 +149,12 @@ func (cSv1 *ChargerSv1) GetChargersForEvent(ctx *context.Context, cgrEv *utils.C
 	return cSv1.cS.V1GetChargersForEvent(ctx, cgrEv, reply)
 }
 
+// ExplainEvent details why the ChargerProfiles were matched or not for Event
+func (cSv1 *ChargerSv1) ExplainEvent(ctx *context.Context, cgrEv *utils.CGREvent,
+	reply *engine.MatchExplanation) error {
+	return cSv1.cS.V1ExplainEvent(ctx, cgrEv, reply)
+}
+
 // ProcessEvent
 func (cSv1 *ChargerSv1) ProcessEvent(ctx *context.Context, args *utils.CGREvent,
 	reply *[]*engine.ChrgSProcessEventReply) error {
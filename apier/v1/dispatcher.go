@@ -899,6 +899,12 @@ func (dSv1 DispatcherSv1) GetProfilesForEvent(ctx *context.Context, ev *utils.CG
 	return dSv1.dS.DispatcherSv1GetProfilesForEvent(ctx, ev, dPrfl)
 }
 
+// ExplainEvent details why the dispatcher profiles were matched or not for the provided event
+func (dSv1 DispatcherSv1) ExplainEvent(ctx *context.Context, ev *utils.CGREvent,
+	reply *engine.MatchExplanation) error {
+	return dSv1.dS.DispatcherSv1ExplainEvent(ctx, ev, reply)
+}
+
 func (dS *DispatcherSv1) RemoteStatus(ctx *context.Context, args *cores.V1StatusParams, reply *map[string]any) (err error) {
 	return dS.dS.DispatcherSv1RemoteStatus(ctx, args, reply)
 }
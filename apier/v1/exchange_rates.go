@@ -0,0 +1,80 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package v1
+
+import (
+	"github.com/cgrates/birpc/context"
+	"github.com/cgrates/cgrates/engine"
+	"github.com/cgrates/cgrates/utils"
+)
+
+// AttrExchangeRate identifies the exchange rate of a currency pair
+type AttrExchangeRate struct {
+	From string
+	To   string
+}
+
+// SetExchangeRate stores the rates converting the From currency into the To one,
+// replacing the ones already defined for the pair
+func (apierSv1 *APIerSv1) SetExchangeRate(ctx *context.Context, er *engine.ExchangeRate, reply *string) (err error) {
+	if missing := utils.MissingStructFields(er, []string{"From", "To", "Rates"}); len(missing) != 0 {
+		return utils.NewErrMandatoryIeMissing(missing...)
+	}
+	for _, erv := range er.Rates {
+		if erv == nil || erv.Rate <= 0 {
+			return utils.NewErrMandatoryIeMissing("Rate")
+		}
+	}
+	if err = apierSv1.DataManager.SetExchangeRate(er.Clone()); err != nil {
+		return utils.NewErrServerError(err)
+	}
+	*reply = utils.OK
+	return
+}
+
+// GetExchangeRate returns the rates converting the From currency into the To one
+func (apierSv1 *APIerSv1) GetExchangeRate(ctx *context.Context, attr *AttrExchangeRate, reply *engine.ExchangeRate) (err error) {
+	if missing := utils.MissingStructFields(attr, []string{"From", "To"}); len(missing) != 0 {
+		return utils.NewErrMandatoryIeMissing(missing...)
+	}
+	var er *engine.ExchangeRate
+	if er, err = apierSv1.DataManager.GetExchangeRate(attr.From, attr.To); err != nil {
+		if err != utils.ErrNotFound {
+			err = utils.NewErrServerError(err)
+		}
+		return
+	}
+	*reply = *er
+	return
+}
+
+// RemoveExchangeRate removes the rates of the currency pair
+func (apierSv1 *APIerSv1) RemoveExchangeRate(ctx *context.Context, attr *AttrExchangeRate, reply *string) (err error) {
+	if missing := utils.MissingStructFields(attr, []string{"From", "To"}); len(missing) != 0 {
+		return utils.NewErrMandatoryIeMissing(missing...)
+	}
+	if err = apierSv1.DataManager.RemoveExchangeRate(attr.From, attr.To); err != nil {
+		if err != utils.ErrNotFound {
+			err = utils.NewErrServerError(err)
+		}
+		return
+	}
+	*reply = utils.OK
+	return
+}
@@ -66,6 +66,10 @@ func (apierSv1 *APIerSv1) RemoveFilterIndexes(ctx *context.Context, arg *AttrRem
 		arg.ItemType = utils.CacheResourceFilterIndexes
 	case utils.MetaChargers:
 		arg.ItemType = utils.CacheChargerFilterIndexes
+	case utils.MetaFrauds:
+		arg.ItemType = utils.CacheFraudFilterIndexes
+	case utils.MetaTaxes:
+		arg.ItemType = utils.CacheTaxFilterIndexes
 	case utils.MetaDispatchers:
 		if missing := utils.MissingStructFields(arg, []string{"Context"}); len(missing) != 0 { //Params missing
 			return utils.NewErrMandatoryIeMissing(missing...)
@@ -117,6 +121,10 @@ func (apierSv1 *APIerSv1) GetFilterIndexes(ctx *context.Context, arg *AttrGetFil
 		arg.ItemType = utils.CacheResourceFilterIndexes
 	case utils.MetaChargers:
 		arg.ItemType = utils.CacheChargerFilterIndexes
+	case utils.MetaFrauds:
+		arg.ItemType = utils.CacheFraudFilterIndexes
+	case utils.MetaTaxes:
+		arg.ItemType = utils.CacheTaxFilterIndexes
 	case utils.MetaDispatchers:
 		if missing := utils.MissingStructFields(arg, []string{"Context"}); len(missing) != 0 { //Params missing
 			return utils.NewErrMandatoryIeMissing(missing...)
@@ -334,6 +342,40 @@ func (apierSv1 *APIerSv1) ComputeFilterIndexes(ctx *context.Context, args *utils
 		}
 		args.ChargerS = indexes.Size() != 0
 	}
+	//FraudProfile Indexes
+	if args.FraudS {
+		cacheIDs[utils.CacheFraudFilterIndexes] = []string{utils.MetaAny}
+		if indexes, err = engine.ComputeIndexes(apierSv1.DataManager, tnt, args.Context, utils.CacheFraudFilterIndexes,
+			nil, transactionID, func(tnt, id, ctx string) (*[]string, error) {
+				fp, e := apierSv1.DataManager.GetFraudProfile(tnt, id, true, false, utils.NonTransactional)
+				if e != nil {
+					return nil, e
+				}
+				fltrIDs := make([]string, len(fp.FilterIDs))
+				copy(fltrIDs, fp.FilterIDs)
+				return &fltrIDs, nil
+			}, nil); err != nil && err != utils.ErrNotFound {
+			return utils.APIErrorHandler(err)
+		}
+		args.FraudS = indexes.Size() != 0
+	}
+	//TaxProfile Indexes
+	if args.TaxS {
+		cacheIDs[utils.CacheTaxFilterIndexes] = []string{utils.MetaAny}
+		if indexes, err = engine.ComputeIndexes(apierSv1.DataManager, tnt, args.Context, utils.CacheTaxFilterIndexes,
+			nil, transactionID, func(tnt, id, ctx string) (*[]string, error) {
+				tp, e := apierSv1.DataManager.GetTaxProfile(tnt, id, true, false, utils.NonTransactional)
+				if e != nil {
+					return nil, e
+				}
+				fltrIDs := make([]string, len(tp.FilterIDs))
+				copy(fltrIDs, tp.FilterIDs)
+				return &fltrIDs, nil
+			}, nil); err != nil && err != utils.ErrNotFound {
+			return utils.APIErrorHandler(err)
+		}
+		args.TaxS = indexes.Size() != 0
+	}
 	//DispatcherProfile Indexes
 	if args.DispatcherS {
 		cacheIDs[utils.CacheDispatcherFilterIndexes] = []string{utils.MetaAny}
@@ -396,6 +438,18 @@ func (apierSv1 *APIerSv1) ComputeFilterIndexes(ctx *context.Context, args *utils
 			return
 		}
 	}
+	//FraudProfile Indexes
+	if args.FraudS {
+		if err = apierSv1.DataManager.SetIndexes(utils.CacheFraudFilterIndexes, tnt, nil, true, transactionID); err != nil {
+			return
+		}
+	}
+	//TaxProfile Indexes
+	if args.TaxS {
+		if err = apierSv1.DataManager.SetIndexes(utils.CacheTaxFilterIndexes, tnt, nil, true, transactionID); err != nil {
+			return
+		}
+	}
 	//DispatcherProfile Indexes
 	if args.DispatcherS {
 		if err = apierSv1.DataManager.SetIndexes(utils.CacheDispatcherFilterIndexes, tntCtx, nil, true, transactionID); err != nil {
@@ -531,6 +585,38 @@ func (apierSv1 *APIerSv1) ComputeFilterIndexIDs(ctx *context.Context, args *util
 	if indexes.Size() != 0 {
 		cacheIDs[utils.CacheChargerFilterIndexes] = indexes.AsSlice()
 	}
+	//FraudProfile Indexes
+	if indexes, err = engine.ComputeIndexes(apierSv1.DataManager, tnt, args.Context, utils.CacheFraudFilterIndexes,
+		&args.FraudIDs, transactionID, func(tnt, id, ctx string) (*[]string, error) {
+			fp, e := apierSv1.DataManager.GetFraudProfile(tnt, id, true, false, utils.NonTransactional)
+			if e != nil {
+				return nil, e
+			}
+			fltrIDs := make([]string, len(fp.FilterIDs))
+			copy(fltrIDs, fp.FilterIDs)
+			return &fltrIDs, nil
+		}, nil); err != nil && err != utils.ErrNotFound {
+		return utils.APIErrorHandler(err)
+	}
+	if indexes.Size() != 0 {
+		cacheIDs[utils.CacheFraudFilterIndexes] = indexes.AsSlice()
+	}
+	//TaxProfile Indexes
+	if indexes, err = engine.ComputeIndexes(apierSv1.DataManager, tnt, args.Context, utils.CacheTaxFilterIndexes,
+		&args.TaxIDs, transactionID, func(tnt, id, ctx string) (*[]string, error) {
+			tp, e := apierSv1.DataManager.GetTaxProfile(tnt, id, true, false, utils.NonTransactional)
+			if e != nil {
+				return nil, e
+			}
+			fltrIDs := make([]string, len(tp.FilterIDs))
+			copy(fltrIDs, tp.FilterIDs)
+			return &fltrIDs, nil
+		}, nil); err != nil && err != utils.ErrNotFound {
+		return utils.APIErrorHandler(err)
+	}
+	if indexes.Size() != 0 {
+		cacheIDs[utils.CacheTaxFilterIndexes] = indexes.AsSlice()
+	}
 	//DispatcherProfile Indexes
 	if indexes, err = engine.ComputeIndexes(apierSv1.DataManager, tnt, args.Context, utils.CacheDispatcherFilterIndexes,
 		&args.DispatcherIDs, transactionID, func(tnt, id, ctx string) (*[]string, error) {
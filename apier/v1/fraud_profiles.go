@@ -0,0 +1,137 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package v1
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cgrates/birpc/context"
+	"github.com/cgrates/cgrates/engine"
+	"github.com/cgrates/cgrates/utils"
+)
+
+// SetFraudProfile stores the FraudProfile, replacing the one with the same ID
+func (apierSv1 *APIerSv1) SetFraudProfile(ctx *context.Context, arg *engine.FraudProfileWithAPIOpts, reply *string) (err error) {
+	if arg.FraudProfile == nil {
+		return utils.NewErrMandatoryIeMissing(utils.ID)
+	}
+	if missing := utils.MissingStructFields(arg.FraudProfile, []string{utils.ID, "Rules"}); len(missing) != 0 {
+		return utils.NewErrMandatoryIeMissing(missing...)
+	}
+	fp := arg.FraudProfile.Clone()
+	if fp.Tenant == utils.EmptyString {
+		fp.Tenant = apierSv1.Config.GeneralCfg().DefaultTenant
+	}
+	if err = fp.Validate(); err != nil {
+		return
+	}
+	if err = apierSv1.DataManager.SetFraudProfile(fp, true); err != nil {
+		return utils.APIErrorHandler(err)
+	}
+	//generate a loadID for CacheFraudProfiles and store it in database
+	if err = apierSv1.DataManager.SetLoadIDs(map[string]int64{utils.CacheFraudProfiles: time.Now().UnixNano()}); err != nil {
+		return utils.APIErrorHandler(err)
+	}
+	// delay if needed before cache call
+	if apierSv1.Config.GeneralCfg().CachingDelay != 0 {
+		utils.Logger.Info(fmt.Sprintf("<SetFraudProfile> Delaying cache call for %v", apierSv1.Config.GeneralCfg().CachingDelay))
+		time.Sleep(apierSv1.Config.GeneralCfg().CachingDelay)
+	}
+	//handle caching for FraudProfile
+	if err = apierSv1.CallCache(utils.IfaceAsString(arg.APIOpts[utils.CacheOpt]), fp.Tenant, utils.CacheFraudProfiles,
+		fp.TenantID(), utils.EmptyString, &fp.FilterIDs, nil, arg.APIOpts); err != nil {
+		return utils.APIErrorHandler(err)
+	}
+	*reply = utils.OK
+	return
+}
+
+// GetFraudProfile returns the FraudProfile with the given ID
+func (apierSv1 *APIerSv1) GetFraudProfile(ctx *context.Context, arg *utils.TenantID, reply *engine.FraudProfile) (err error) {
+	if missing := utils.MissingStructFields(arg, []string{utils.ID}); len(missing) != 0 {
+		return utils.NewErrMandatoryIeMissing(missing...)
+	}
+	tnt := arg.Tenant
+	if tnt == utils.EmptyString {
+		tnt = apierSv1.Config.GeneralCfg().DefaultTenant
+	}
+	var fp *engine.FraudProfile
+	if fp, err = apierSv1.DataManager.GetFraudProfile(tnt, arg.ID, true, true, utils.NonTransactional); err != nil {
+		return utils.APIErrorHandler(err)
+	}
+	*reply = *fp
+	return
+}
+
+// GetFraudProfiles returns all the FraudProfiles of the tenant
+func (apierSv1 *APIerSv1) GetFraudProfiles(ctx *context.Context, arg *utils.TenantWithAPIOpts, reply *[]*engine.FraudProfile) (err error) {
+	tnt := arg.Tenant
+	if tnt == utils.EmptyString {
+		tnt = apierSv1.Config.GeneralCfg().DefaultTenant
+	}
+	prfx := utils.FraudProfilesPrefix + tnt + utils.ConcatenatedKeySep
+	var keys []string
+	if keys, err = apierSv1.DataManager.DataDB().GetKeysForPrefix(prfx, utils.EmptyString); err != nil {
+		return
+	}
+	if len(keys) == 0 {
+		return utils.ErrNotFound
+	}
+	fps := make([]*engine.FraudProfile, 0, len(keys))
+	for _, key := range keys {
+		var fp *engine.FraudProfile
+		if fp, err = apierSv1.DataManager.GetFraudProfile(tnt, key[len(prfx):], true, true, utils.NonTransactional); err != nil {
+			return utils.APIErrorHandler(err)
+		}
+		fps = append(fps, fp)
+	}
+	*reply = fps
+	return
+}
+
+// RemoveFraudProfile removes the FraudProfile with the given ID
+func (apierSv1 *APIerSv1) RemoveFraudProfile(ctx *context.Context, arg *utils.TenantIDWithAPIOpts, reply *string) (err error) {
+	if missing := utils.MissingStructFields(arg, []string{utils.ID}); len(missing) != 0 {
+		return utils.NewErrMandatoryIeMissing(missing...)
+	}
+	tnt := arg.Tenant
+	if tnt == utils.EmptyString {
+		tnt = apierSv1.Config.GeneralCfg().DefaultTenant
+	}
+	if err = apierSv1.DataManager.RemoveFraudProfile(tnt, arg.ID, true); err != nil {
+		return utils.APIErrorHandler(err)
+	}
+	//generate a loadID for CacheFraudProfiles and store it in database
+	if err = apierSv1.DataManager.SetLoadIDs(map[string]int64{utils.CacheFraudProfiles: time.Now().UnixNano()}); err != nil {
+		return utils.APIErrorHandler(err)
+	}
+	// delay if needed before cache call
+	if apierSv1.Config.GeneralCfg().CachingDelay != 0 {
+		utils.Logger.Info(fmt.Sprintf("<RemoveFraudProfile> Delaying cache call for %v", apierSv1.Config.GeneralCfg().CachingDelay))
+		time.Sleep(apierSv1.Config.GeneralCfg().CachingDelay)
+	}
+	//handle caching for FraudProfile
+	if err = apierSv1.CallCache(utils.IfaceAsString(arg.APIOpts[utils.CacheOpt]), tnt, utils.CacheFraudProfiles,
+		utils.ConcatenatedKey(tnt, arg.ID), utils.EmptyString, nil, nil, arg.APIOpts); err != nil {
+		return utils.APIErrorHandler(err)
+	}
+	*reply = utils.OK
+	return
+}
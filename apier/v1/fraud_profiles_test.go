@@ -0,0 +1,88 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package v1
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cgrates/birpc/context"
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/engine"
+	"github.com/cgrates/cgrates/utils"
+)
+
+func TestFraudProfilesSetGetRemove(t *testing.T) {
+	cfg := config.NewDefaultCGRConfig()
+	data, err := engine.NewInternalDB(nil, nil, true, nil, cfg.DataDbCfg().Items)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dm := engine.NewDataManager(data, cfg.CacheCfg(), nil)
+	apierSv1 := &APIerSv1{
+		DataManager: dm,
+		Config:      cfg,
+	}
+	var reply string
+	noCache := map[string]any{utils.CacheOpt: utils.MetaNone}
+	if err = apierSv1.SetFraudProfile(context.Background(), &engine.FraudProfileWithAPIOpts{
+		FraudProfile: &engine.FraudProfile{ID: "FRP1",
+			Rules: []*engine.FraudRule{{ID: "CALLS", Type: utils.MetaVelocity, Metric: utils.MetaCalls}}},
+		APIOpts: noCache}, &reply); err == nil {
+		t.Error("expected validation error")
+	}
+	if err = apierSv1.SetFraudProfile(context.Background(), &engine.FraudProfileWithAPIOpts{
+		FraudProfile: &engine.FraudProfile{ID: "FRP1", FilterIDs: []string{"*string:~*req.Account:1001"},
+			Rules: []*engine.FraudRule{{ID: "CALLS", Type: utils.MetaVelocity, Metric: utils.MetaCalls,
+				Window: time.Hour, Threshold: 10, Score: 50}}, BlockScore: 50},
+		APIOpts: noCache}, &reply); err != nil {
+		t.Fatal(err)
+	}
+	var fp engine.FraudProfile
+	if err = apierSv1.GetFraudProfile(context.Background(), &utils.TenantID{ID: "FRP1"}, &fp); err != nil {
+		t.Fatal(err)
+	} else if fp.Tenant != "cgrates.org" || len(fp.Rules) != 1 {
+		t.Errorf("unexpected profile: %s", utils.ToJSON(fp))
+	}
+	var fps []*engine.FraudProfile
+	if err = apierSv1.GetFraudProfiles(context.Background(), &utils.TenantWithAPIOpts{}, &fps); err != nil {
+		t.Fatal(err)
+	} else if len(fps) != 1 {
+		t.Errorf("unexpected profiles: %s", utils.ToJSON(fps))
+	}
+	var idx []string
+	if err = apierSv1.GetFilterIndexes(context.Background(), &AttrGetFilterIndexes{ItemType: utils.MetaFrauds}, &idx); err != nil {
+		t.Fatal(err)
+	} else if exp := []string{"*string:*req.Account:1001:FRP1"}; !reflect.DeepEqual(exp, idx) {
+		t.Errorf("expected %v, received %v", exp, idx)
+	}
+	rmArgs := &utils.TenantIDWithAPIOpts{TenantID: &utils.TenantID{ID: "FRP1"}, APIOpts: noCache}
+	if err = apierSv1.RemoveFraudProfile(context.Background(), rmArgs, &reply); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = dm.GetIndexes(utils.CacheFraudFilterIndexes, "cgrates.org", false, false); err != utils.ErrNotFound {
+		t.Errorf("expected %v, received %v", utils.ErrNotFound, err)
+	}
+	engine.Cache.Clear(nil) // the profile is still cached since the APIs ran with *none caching
+	if err = apierSv1.RemoveFraudProfile(context.Background(), rmArgs, &reply); err == nil ||
+		err.Error() != utils.ErrNotFound.Error() {
+		t.Errorf("expected %v, received %v", utils.ErrNotFound, err)
+	}
+}
@@ -0,0 +1,45 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package v1
+
+import (
+	"github.com/cgrates/birpc/context"
+	"github.com/cgrates/cgrates/engine"
+	"github.com/cgrates/cgrates/utils"
+)
+
+// NewFraudSv1 initializes the FraudSv1 object.
+func NewFraudSv1(fS *engine.FraudService) *FraudSv1 {
+	return &FraudSv1{fS: fS}
+}
+
+// FraudSv1 exports the FraudS APIs
+type FraudSv1 struct {
+	fS *engine.FraudService
+}
+
+// AuthorizeEvent returns the risk of the event, without recording it
+func (fSv1 *FraudSv1) AuthorizeEvent(ctx *context.Context, args *utils.CGREvent, reply *engine.FraudReply) error {
+	return fSv1.fS.V1AuthorizeEvent(ctx, args, reply)
+}
+
+// ProcessEvent records the event and returns its risk
+func (fSv1 *FraudSv1) ProcessEvent(ctx *context.Context, args *utils.CGREvent, reply *engine.FraudReply) error {
+	return fSv1.fS.V1ProcessEvent(ctx, args, reply)
+}
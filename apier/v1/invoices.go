@@ -0,0 +1,196 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package v1
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cgrates/birpc/context"
+	"github.com/cgrates/cgrates/engine"
+	"github.com/cgrates/cgrates/utils"
+)
+
+// AttrGenerateInvoice selects the account and the billing period of the invoice
+type AttrGenerateInvoice struct {
+	Tenant      string
+	Account     string
+	PeriodStart string
+	PeriodEnd   string   // excluded from the period
+	RunIDs      []string // the CDRs charged, *default if empty
+}
+
+// AttrInvoice identifies an invoice of an account
+type AttrInvoice struct {
+	Tenant  string
+	Account string
+	ID      string
+}
+
+// AttrExportInvoice selects the invoice and the exporters it is sent to
+type AttrExportInvoice struct {
+	AttrInvoice
+	ExporterIDs []string
+	Verbose     bool
+}
+
+// getInvoice returns the invoice with the given ID
+func (apierSv1 *APIerSv1) getInvoice(tnt, acnt, invID string) (inv *engine.Invoice, err error) {
+	var invs []*engine.Invoice
+	if invs, err = apierSv1.CdrDb.GetInvoices(&utils.InvoiceFilter{
+		Tenant:  tnt,
+		Account: acnt,
+		IDs:     []string{invID},
+	}); err != nil {
+		return
+	}
+	return invs[0], nil
+}
+
+// GenerateInvoice (re)generates the invoice of an account for the billing period.
+// Late CDRs of the last final invoice before the period are included, while final invoices cannot be regenerated.
+func (apierSv1 *APIerSv1) GenerateInvoice(ctx *context.Context, attr *AttrGenerateInvoice, reply *engine.Invoice) (err error) {
+	if missing := utils.MissingStructFields(attr, []string{utils.AccountField,
+		utils.PeriodStart, utils.PeriodEnd}); len(missing) != 0 {
+		return utils.NewErrMandatoryIeMissing(missing...)
+	}
+	tnt := attr.Tenant
+	if tnt == utils.EmptyString {
+		tnt = apierSv1.Config.GeneralCfg().DefaultTenant
+	}
+	var start, end time.Time
+	if start, err = utils.ParseTimeDetectLayout(attr.PeriodStart, apierSv1.Config.GeneralCfg().DefaultTimezone); err != nil {
+		return
+	}
+	if end, err = utils.ParseTimeDetectLayout(attr.PeriodEnd, apierSv1.Config.GeneralCfg().DefaultTimezone); err != nil {
+		return
+	}
+	runIDs := attr.RunIDs
+	if len(runIDs) == 0 {
+		runIDs = []string{utils.MetaDefault}
+	}
+	invs, err := apierSv1.CdrDb.GetInvoices(&utils.InvoiceFilter{Tenant: tnt, Account: attr.Account})
+	if err != nil && err != utils.ErrNotFound {
+		return utils.NewErrServerError(err)
+	}
+	var prev *engine.Invoice
+	for _, inv := range invs {
+		if !inv.Final {
+			continue
+		}
+		if inv.PeriodStart.Before(end) && start.Before(inv.PeriodEnd) {
+			return fmt.Errorf("%w: %s", engine.ErrInvoiceFinal, inv.ID)
+		}
+		if !inv.PeriodEnd.After(start) &&
+			(prev == nil || inv.PeriodEnd.After(prev.PeriodEnd)) {
+			prev = inv
+		}
+	}
+	var inv *engine.Invoice
+	if inv, err = engine.NewInvoice(apierSv1.CdrDb, tnt, attr.Account, start, end, runIDs, prev); err != nil {
+		return
+	}
+	if err = apierSv1.CdrDb.SetInvoice(inv); err != nil {
+		return utils.NewErrServerError(err)
+	}
+	*reply = *inv
+	return
+}
+
+// FinalizeInvoice marks the invoice as final, the CDRs arriving late for its period going to the next invoice
+func (apierSv1 *APIerSv1) FinalizeInvoice(ctx *context.Context, attr *AttrInvoice, reply *string) (err error) {
+	if missing := utils.MissingStructFields(attr, []string{utils.AccountField, utils.ID}); len(missing) != 0 {
+		return utils.NewErrMandatoryIeMissing(missing...)
+	}
+	tnt := attr.Tenant
+	if tnt == utils.EmptyString {
+		tnt = apierSv1.Config.GeneralCfg().DefaultTenant
+	}
+	var inv *engine.Invoice
+	if inv, err = apierSv1.getInvoice(tnt, attr.Account, attr.ID); err != nil {
+		return
+	}
+	if !inv.Final {
+		inv.Final = true
+		if err = apierSv1.CdrDb.SetInvoice(inv); err != nil {
+			return utils.NewErrServerError(err)
+		}
+	}
+	*reply = utils.OK
+	return
+}
+
+// GetInvoices returns the invoices of an account ordered by their period
+func (apierSv1 *APIerSv1) GetInvoices(ctx *context.Context, fltr *utils.InvoiceFilter, reply *[]*engine.Invoice) (err error) {
+	if missing := utils.MissingStructFields(fltr, []string{utils.AccountField}); len(missing) != 0 {
+		return utils.NewErrMandatoryIeMissing(missing...)
+	}
+	if fltr.Tenant == utils.EmptyString {
+		fltr.Tenant = apierSv1.Config.GeneralCfg().DefaultTenant
+	}
+	var invs []*engine.Invoice
+	if invs, err = apierSv1.CdrDb.GetInvoices(fltr); err != nil {
+		return
+	}
+	*reply = invs
+	return
+}
+
+// ExportInvoice sends the invoice to EEs as one event per line, followed by the *total line
+func (apierSv1 *APIerSv1) ExportInvoice(ctx *context.Context, attr *AttrExportInvoice, reply *map[string]any) (err error) {
+	if missing := utils.MissingStructFields(&attr.AttrInvoice, []string{utils.AccountField, utils.ID}); len(missing) != 0 {
+		return utils.NewErrMandatoryIeMissing(missing...)
+	}
+	if len(apierSv1.Config.ApierCfg().EEsConns) == 0 {
+		return utils.NewErrNotConnected(utils.EEs)
+	}
+	tnt := attr.Tenant
+	if tnt == utils.EmptyString {
+		tnt = apierSv1.Config.GeneralCfg().DefaultTenant
+	}
+	var inv *engine.Invoice
+	if inv, err = apierSv1.getInvoice(tnt, attr.Account, attr.ID); err != nil {
+		return
+	}
+	withErros := false
+	var rplyEv map[string]map[string]any
+	for _, cgrEv := range inv.AsCGREvents() {
+		if attr.Verbose {
+			cgrEv.APIOpts[utils.OptsEEsVerbose] = struct{}{}
+		}
+		if err := apierSv1.ConnMgr.Call(context.TODO(), apierSv1.Config.ApierCfg().EEsConns,
+			utils.EeSv1ProcessEvent,
+			&engine.CGREventWithEeIDs{
+				EeIDs:    attr.ExporterIDs,
+				CGREvent: cgrEv,
+			}, &rplyEv); err != nil {
+			utils.Logger.Warning(fmt.Sprintf("<%s> error: <%s> processing event: <%s> with <%s>",
+				utils.ApierS, err.Error(), utils.ToJSON(cgrEv), utils.EEs))
+			withErros = true
+		}
+	}
+	if withErros {
+		return utils.ErrPartiallyExecuted
+	}
+	// the last reply has the metrics updated
+	for exporterID, metrics := range rplyEv {
+		(*reply)[exporterID] = metrics
+	}
+	return
+}
@@ -0,0 +1,74 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package v1
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cgrates/birpc/context"
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/engine"
+	"github.com/cgrates/cgrates/utils"
+)
+
+func TestInvoicesGenerateFinalize(t *testing.T) {
+	cfg := config.NewDefaultCGRConfig()
+	db, err := engine.NewInternalDB(nil, nil, false, nil, cfg.StorDbCfg().Items)
+	if err != nil {
+		t.Fatal(err)
+	}
+	apierSv1 := &APIerSv1{
+		CdrDb:  db,
+		Config: cfg,
+	}
+	if err = db.SetCDR(&engine.CDR{CGRID: "cgrid1", RunID: utils.MetaDefault, Tenant: "cgrates.org",
+		Account: "1001", Category: "call", Destination: "1002", Usage: time.Minute, Cost: 2,
+		AnswerTime: time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC)}, false); err != nil {
+		t.Fatal(err)
+	}
+	var inv engine.Invoice
+	if err = apierSv1.GenerateInvoice(context.Background(), &AttrGenerateInvoice{Account: "1001",
+		PeriodStart: "2026-01-01T00:00:00Z", PeriodEnd: "2026-02-01T00:00:00Z"}, &inv); err != nil {
+		t.Fatal(err)
+	}
+	if inv.Total != 2 || len(inv.UsageLines) != 1 {
+		t.Errorf("unexpected invoice: %s", utils.ToJSON(inv))
+	}
+	var reply string
+	if err = apierSv1.FinalizeInvoice(context.Background(), &AttrInvoice{Account: "1001", ID: inv.ID}, &reply); err != nil {
+		t.Fatal(err)
+	}
+	// final invoices cannot be regenerated, nor overlapped
+	if err = apierSv1.GenerateInvoice(context.Background(), &AttrGenerateInvoice{Account: "1001",
+		PeriodStart: "2026-01-15T00:00:00Z", PeriodEnd: "2026-02-15T00:00:00Z"}, &inv); !errors.Is(err, engine.ErrInvoiceFinal) {
+		t.Errorf("expected %v, received %v", engine.ErrInvoiceFinal, err)
+	}
+	var invs []*engine.Invoice
+	if err = apierSv1.GetInvoices(context.Background(), &utils.InvoiceFilter{Account: "1001"}, &invs); err != nil {
+		t.Fatal(err)
+	} else if len(invs) != 1 || !invs[0].Final {
+		t.Errorf("unexpected invoices: %s", utils.ToJSON(invs))
+	}
+	if err = apierSv1.ExportInvoice(context.Background(), &AttrExportInvoice{AttrInvoice: AttrInvoice{Account: "1001",
+		ID: inv.ID}}, nil); err == nil || err.Error() != utils.NewErrNotConnected(utils.EEs).Error() {
+		t.Errorf("expected %v, received %v", utils.NewErrNotConnected(utils.EEs), err)
+	}
+}
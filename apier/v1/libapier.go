@@ -135,7 +135,7 @@ func (apierSv1 *APIerSv1) composeArgsReload(tnt, cacheID, itemID string, filterI
 		if fltr, err = apierSv1.DataManager.GetFilter(tnt, id, true, true, utils.NonTransactional); err != nil {
 			return
 		}
-		for _, flt := range fltr.Rules {
+		for _, flt := range fltr.IndexRules() {
 			if !engine.FilterIndexTypes.Has(flt.Type) ||
 				engine.IsDynamicDPPath(flt.Element) {
 				continue
@@ -261,7 +261,7 @@ func (apierSv1 *APIerSv1) callCacheMultiple(cacheopt, tnt, cacheID string, itemI
 
 func composeCacheArgsForFilter(dm *engine.DataManager, fltr *engine.Filter, tnt, tntID string, args map[string][]string) (_ map[string][]string, err error) {
 	indxIDs := make([]string, 0, len(fltr.Rules))
-	for _, flt := range fltr.Rules {
+	for _, flt := range fltr.IndexRules() {
 		if !engine.FilterIndexTypes.Has(flt.Type) ||
 			engine.IsDynamicDPPath(flt.Element) {
 			continue
@@ -0,0 +1,170 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package v1
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/cgrates/birpc/context"
+	"github.com/cgrates/cgrates/engine"
+	"github.com/cgrates/cgrates/utils"
+)
+
+// GetLookupTable returns the LookupTable
+func (apierSv1 *APIerSv1) GetLookupTable(ctx *context.Context, arg *utils.TenantIDWithAPIOpts, reply *engine.LookupTable) (err error) {
+	if missing := utils.MissingStructFields(arg, []string{utils.ID}); len(missing) != 0 { //Params missing
+		return utils.NewErrMandatoryIeMissing(missing...)
+	}
+	tnt := arg.Tenant
+	if tnt == utils.EmptyString {
+		tnt = apierSv1.Config.GeneralCfg().DefaultTenant
+	}
+	var lt *engine.LookupTable
+	if lt, err = apierSv1.DataManager.GetLookupTable(tnt, arg.ID, true, true, utils.NonTransactional); err != nil {
+		return utils.APIErrorHandler(err)
+	}
+	*reply = *lt
+	return
+}
+
+// GetLookupValue returns the value mapped to the key within the LookupTable or its default
+func (apierSv1 *APIerSv1) GetLookupValue(ctx *context.Context, args *engine.LookupValueArgs, reply *string) (err error) {
+	if missing := utils.MissingStructFields(args, []string{utils.ID}); len(missing) != 0 { //Params missing
+		return utils.NewErrMandatoryIeMissing(missing...)
+	}
+	tnt := args.Tenant
+	if tnt == utils.EmptyString {
+		tnt = apierSv1.Config.GeneralCfg().DefaultTenant
+	}
+	var lt *engine.LookupTable
+	if lt, err = apierSv1.DataManager.GetLookupTable(tnt, args.ID, true, true, utils.NonTransactional); err != nil {
+		return utils.APIErrorHandler(err)
+	}
+	val, has := lt.Lookup(args.Key)
+	if !has {
+		return utils.ErrNotFound
+	}
+	*reply = val
+	return
+}
+
+// SetLookupTable stores the LookupTable, replacing the previous one
+func (apierSv1 *APIerSv1) SetLookupTable(ctx *context.Context, args *engine.LookupTableWithAPIOpts, reply *string) (err error) {
+	if args.LookupTable == nil {
+		return utils.NewErrMandatoryIeMissing(utils.ID)
+	}
+	if missing := utils.MissingStructFields(args.LookupTable, []string{utils.ID}); len(missing) != 0 {
+		return utils.NewErrMandatoryIeMissing(missing...)
+	}
+	if args.Tenant == utils.EmptyString {
+		args.Tenant = apierSv1.Config.GeneralCfg().DefaultTenant
+	}
+	if err = args.Compile(); err != nil {
+		return utils.APIErrorHandler(err)
+	}
+	if err = apierSv1.DataManager.SetLookupTable(args.LookupTable); err != nil {
+		return utils.APIErrorHandler(err)
+	}
+	//generate a loadID for CacheLookupTables and store it in database
+	if err = apierSv1.DataManager.SetLoadIDs(map[string]int64{utils.CacheLookupTables: time.Now().UnixNano()}); err != nil {
+		return utils.APIErrorHandler(err)
+	}
+	//handle caching for LookupTables
+	if err = apierSv1.CallCache(utils.IfaceAsString(args.APIOpts[utils.CacheOpt]), args.Tenant,
+		utils.CacheLookupTables, args.TenantID(), utils.EmptyString, nil, nil, args.APIOpts); err != nil {
+		return utils.APIErrorHandler(err)
+	}
+	*reply = utils.OK
+	return
+}
+
+// RemoveLookupTable removes the LookupTable
+func (apierSv1 *APIerSv1) RemoveLookupTable(ctx *context.Context, args *utils.TenantIDWithAPIOpts, reply *string) (err error) {
+	if missing := utils.MissingStructFields(args, []string{utils.ID}); len(missing) != 0 { //Params missing
+		return utils.NewErrMandatoryIeMissing(missing...)
+	}
+	tnt := args.Tenant
+	if tnt == utils.EmptyString {
+		tnt = apierSv1.Config.GeneralCfg().DefaultTenant
+	}
+	if err = apierSv1.DataManager.RemoveLookupTable(tnt, args.ID); err != nil {
+		return utils.APIErrorHandler(err)
+	}
+	//handle caching for LookupTables
+	if err = apierSv1.CallCache(utils.IfaceAsString(args.APIOpts[utils.CacheOpt]), tnt,
+		utils.CacheLookupTables, utils.ConcatenatedKey(tnt, args.ID), utils.EmptyString, nil, nil, args.APIOpts); err != nil {
+		return utils.APIErrorHandler(err)
+	}
+	//generate a loadID for CacheLookupTables and store it in database
+	if err = apierSv1.DataManager.SetLoadIDs(map[string]int64{utils.CacheLookupTables: time.Now().UnixNano()}); err != nil {
+		return utils.APIErrorHandler(err)
+	}
+	*reply = utils.OK
+	return
+}
+
+// AttrLoadLookupTables is the argument of LoadLookupTables
+type AttrLoadLookupTables struct {
+	Tenant         string // used for the lines without tenant
+	FilePath       string // CSV file with the columns: Tenant,ID,MatchType,Key,Value
+	FieldSeparator string // defaults to comma
+	APIOpts        map[string]any
+}
+
+// LoadLookupTables loads the LookupTables out of a CSV file, replacing the ones already stored
+func (apierSv1 *APIerSv1) LoadLookupTables(ctx *context.Context, args *AttrLoadLookupTables, reply *string) (err error) {
+	if args.FilePath == utils.EmptyString {
+		return utils.NewErrMandatoryIeMissing("FilePath")
+	}
+	sep := utils.CSVSep
+	if args.FieldSeparator != utils.EmptyString {
+		sep = rune(args.FieldSeparator[0])
+	}
+	if err = apierSv1.loadLookupTables(args.FilePath, sep,
+		utils.FirstNonEmpty(args.Tenant, apierSv1.Config.GeneralCfg().DefaultTenant),
+		utils.IfaceAsString(args.APIOpts[utils.CacheOpt]), args.APIOpts); err != nil {
+		return
+	}
+	*reply = utils.OK
+	return
+}
+
+// loadLookupTables loads the LookupTables out of the CSV file and handles their caching
+func (apierSv1 *APIerSv1) loadLookupTables(fPath string, sep rune, tnt, caching string, opts map[string]any) (err error) {
+	var f *os.File
+	if f, err = os.Open(fPath); err != nil {
+		return utils.NewErrServerError(err)
+	}
+	defer f.Close()
+	var tntIDs []string
+	if tntIDs, err = engine.LoadLookupTablesCSV(apierSv1.DataManager, f, sep, tnt); err != nil {
+		return utils.NewErrServerError(fmt.Errorf("%w, loading <%s>", err, fPath))
+	}
+	utils.Logger.Info(fmt.Sprintf("<%s> loaded %d lookup tables out of <%s>",
+		utils.APIerSv1, len(tntIDs), fPath))
+	if err = apierSv1.DataManager.SetLoadIDs(map[string]int64{utils.CacheLookupTables: time.Now().UnixNano()}); err != nil {
+		return utils.APIErrorHandler(err)
+	}
+	if err = apierSv1.callCacheMultiple(caching, tnt, utils.CacheLookupTables, tntIDs, opts); err != nil {
+		return utils.APIErrorHandler(err)
+	}
+	return
+}
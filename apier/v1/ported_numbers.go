@@ -0,0 +1,138 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package v1
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/cgrates/birpc/context"
+	"github.com/cgrates/cgrates/engine"
+	"github.com/cgrates/cgrates/utils"
+)
+
+// GetPortedNumber returns the porting information of the number
+func (apierSv1 *APIerSv1) GetPortedNumber(ctx *context.Context, number *string, reply *engine.PortedNumber) (err error) {
+	if *number == utils.EmptyString {
+		return utils.NewErrMandatoryIeMissing("Number")
+	}
+	var pn *engine.PortedNumber
+	if pn, err = apierSv1.DataManager.GetPortedNumber(*number, true, true, utils.NonTransactional); err != nil {
+		return utils.APIErrorHandler(err)
+	}
+	*reply = *pn
+	return
+}
+
+// SetPortedNumber stores the porting information of the number
+func (apierSv1 *APIerSv1) SetPortedNumber(ctx *context.Context, args *engine.PortedNumberWithAPIOpts, reply *string) (err error) {
+	if args.PortedNumber == nil {
+		return utils.NewErrMandatoryIeMissing("Number")
+	}
+	if missing := utils.MissingStructFields(args.PortedNumber, []string{"Number"}); len(missing) != 0 {
+		return utils.NewErrMandatoryIeMissing(missing...)
+	}
+	if args.RoutingNumber == utils.EmptyString &&
+		args.RecipientOperator == utils.EmptyString {
+		return utils.NewErrMandatoryIeMissing("RoutingNumber", "RecipientOperator")
+	}
+	if err = apierSv1.DataManager.SetPortedNumber(args.PortedNumber); err != nil {
+		return utils.APIErrorHandler(err)
+	}
+	//generate a loadID for CachePortedNumbers and store it in database
+	if err = apierSv1.DataManager.SetLoadIDs(map[string]int64{utils.CachePortedNumbers: time.Now().UnixNano()}); err != nil {
+		return utils.APIErrorHandler(err)
+	}
+	//handle caching for PortedNumbers
+	if err = apierSv1.CallCache(utils.IfaceAsString(args.APIOpts[utils.CacheOpt]), apierSv1.Config.GeneralCfg().DefaultTenant,
+		utils.CachePortedNumbers, args.Number, utils.EmptyString, nil, nil, args.APIOpts); err != nil {
+		return utils.APIErrorHandler(err)
+	}
+	*reply = utils.OK
+	return
+}
+
+// RemovePortedNumber removes the porting information of the number, used when the number is ported back
+func (apierSv1 *APIerSv1) RemovePortedNumber(ctx *context.Context, args *utils.StringWithAPIOpts, reply *string) (err error) {
+	if args.Arg == utils.EmptyString {
+		return utils.NewErrMandatoryIeMissing("Number")
+	}
+	tnt := args.Tenant
+	if tnt == utils.EmptyString {
+		tnt = apierSv1.Config.GeneralCfg().DefaultTenant
+	}
+	if err = apierSv1.DataManager.RemovePortedNumber(args.Arg); err != nil {
+		return utils.APIErrorHandler(err)
+	}
+	//handle caching for PortedNumbers
+	if err = apierSv1.CallCache(utils.IfaceAsString(args.APIOpts[utils.CacheOpt]), tnt,
+		utils.CachePortedNumbers, args.Arg, utils.EmptyString, nil, nil, args.APIOpts); err != nil {
+		return utils.APIErrorHandler(err)
+	}
+	//generate a loadID for CachePortedNumbers and store it in database
+	if err = apierSv1.DataManager.SetLoadIDs(map[string]int64{utils.CachePortedNumbers: time.Now().UnixNano()}); err != nil {
+		return utils.APIErrorHandler(err)
+	}
+	*reply = utils.OK
+	return
+}
+
+// AttrLoadPortedNumbers is the argument of LoadPortedNumbers
+type AttrLoadPortedNumbers struct {
+	FilePath       string // CSV file or number portability database dump
+	FieldSeparator string // defaults to comma
+	APIOpts        map[string]any
+}
+
+// LoadPortedNumbers loads in bulk the porting information out of a CSV file or a number portability database dump
+func (apierSv1 *APIerSv1) LoadPortedNumbers(ctx *context.Context, args *AttrLoadPortedNumbers, reply *string) (err error) {
+	if args.FilePath == utils.EmptyString {
+		return utils.NewErrMandatoryIeMissing("FilePath")
+	}
+	sep := utils.CSVSep
+	if args.FieldSeparator != utils.EmptyString {
+		sep = rune(args.FieldSeparator[0])
+	}
+	var f *os.File
+	if f, err = os.Open(args.FilePath); err != nil {
+		return utils.NewErrServerError(err)
+	}
+	defer f.Close()
+	var loaded, removed int
+	if loaded, removed, err = engine.LoadPortedNumbersCSV(apierSv1.DataManager, f, sep); err != nil {
+		return utils.NewErrServerError(fmt.Errorf("%w, after loading %d and removing %d numbers",
+			err, loaded, removed))
+	}
+	utils.Logger.Info(fmt.Sprintf("<%s> loaded %d and removed %d ported numbers out of <%s>",
+		utils.APIerSv1, loaded, removed, args.FilePath))
+	if err = apierSv1.DataManager.SetLoadIDs(map[string]int64{utils.CachePortedNumbers: time.Now().UnixNano()}); err != nil {
+		return utils.APIErrorHandler(err)
+	}
+	// the dumps are usually too large to reload per number so we clear the partition instead
+	if utils.FirstNonEmpty(utils.IfaceAsString(args.APIOpts[utils.CacheOpt]),
+		apierSv1.Config.GeneralCfg().DefaultCaching) != utils.MetaNone {
+		if err = apierSv1.CallCache(utils.MetaClear, apierSv1.Config.GeneralCfg().DefaultTenant,
+			utils.CachePortedNumbers, utils.EmptyString, utils.EmptyString, nil, nil, args.APIOpts); err != nil {
+			return utils.APIErrorHandler(err)
+		}
+	}
+	*reply = utils.OK
+	return
+}
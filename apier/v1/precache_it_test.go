@@ -232,6 +232,7 @@ func testPrecacheGetCacheStatsAfterRestart(t *testing.T) {
 		utils.MetaAPIBan:                {},
 		utils.CacheReplicationHosts:     {},
 		utils.CacheRadiusPackets:        {},
+		utils.CacheRadiusEAPSessions:    {},
 		utils.CacheRankings:             {},
 		utils.CacheRankingProfiles:      {},
 		utils.CacheTrends:               {},
@@ -878,6 +878,152 @@ func (rplSv1 *ReplicatorSv1) RemoveSessionBackup(ctx *context.Context, args *eng
 	return
 }
 
+// SetExchangeRate is the replication method coresponding to the dataDB driver method
+func (rplSv1 *ReplicatorSv1) SetExchangeRate(ctx *context.Context, er *engine.ExchangeRateWithAPIOpts, reply *string) (err error) {
+	if err = rplSv1.dm.DataDB().SetExchangeRateDrv(er.ExchangeRate); err != nil {
+		return
+	}
+	*reply = utils.OK
+	return
+}
+
+// RemoveExchangeRate is the replication method coresponding to the dataDB driver method
+func (rplSv1 *ReplicatorSv1) RemoveExchangeRate(ctx *context.Context, pair *utils.StringWithAPIOpts, reply *string) (err error) {
+	ccys := utils.SplitConcatenatedKey(pair.Arg)
+	if len(ccys) != 2 {
+		return fmt.Errorf("invalid currency pair: <%s>", pair.Arg)
+	}
+	if err = rplSv1.dm.DataDB().RemoveExchangeRateDrv(ccys[0], ccys[1]); err != nil {
+		return
+	}
+	*reply = utils.OK
+	return
+}
+
+// SetTaxProfile is the replication method coresponding to the dataDB driver method
+func (rplSv1 *ReplicatorSv1) SetTaxProfile(ctx *context.Context, tp *engine.TaxProfileWithAPIOpts, reply *string) (err error) {
+	if err = rplSv1.dm.DataDB().SetTaxProfileDrv(tp.TaxProfile); err != nil {
+		return
+	}
+	// delay if needed before cache call
+	if rplSv1.v1.Config.GeneralCfg().CachingDelay != 0 {
+		utils.Logger.Info(fmt.Sprintf("<ReplicatorSv1SetTaxProfile> Delaying cache call for %v", rplSv1.v1.Config.GeneralCfg().CachingDelay))
+		time.Sleep(rplSv1.v1.Config.GeneralCfg().CachingDelay)
+	}
+	if err = rplSv1.v1.CallCache(utils.IfaceAsString(tp.APIOpts[utils.CacheOpt]),
+		tp.Tenant, utils.CacheTaxProfiles, tp.TenantID(), utils.EmptyString, &tp.FilterIDs, nil, tp.APIOpts); err != nil {
+		return
+	}
+	*reply = utils.OK
+	return
+}
+
+// RemoveTaxProfile is the replication method coresponding to the dataDB driver method
+func (rplSv1 *ReplicatorSv1) RemoveTaxProfile(ctx *context.Context, args *utils.TenantIDWithAPIOpts, reply *string) (err error) {
+	if err = rplSv1.dm.DataDB().RemoveTaxProfileDrv(args.Tenant, args.ID); err != nil {
+		return
+	}
+	// delay if needed before cache call
+	if rplSv1.v1.Config.GeneralCfg().CachingDelay != 0 {
+		utils.Logger.Info(fmt.Sprintf("<ReplicatorSv1RemoveTaxProfile> Delaying cache call for %v", rplSv1.v1.Config.GeneralCfg().CachingDelay))
+		time.Sleep(rplSv1.v1.Config.GeneralCfg().CachingDelay)
+	}
+	if err = rplSv1.v1.CallCache(utils.IfaceAsString(args.APIOpts[utils.CacheOpt]),
+		args.Tenant, utils.CacheTaxProfiles, args.TenantID.TenantID(), utils.EmptyString, nil, nil, args.APIOpts); err != nil {
+		return
+	}
+	*reply = utils.OK
+	return
+}
+
+// SetFraudProfile is the replication method coresponding to the dataDB driver method
+func (rplSv1 *ReplicatorSv1) SetFraudProfile(ctx *context.Context, fp *engine.FraudProfileWithAPIOpts, reply *string) (err error) {
+	if err = rplSv1.dm.DataDB().SetFraudProfileDrv(fp.FraudProfile); err != nil {
+		return
+	}
+	// delay if needed before cache call
+	if rplSv1.v1.Config.GeneralCfg().CachingDelay != 0 {
+		utils.Logger.Info(fmt.Sprintf("<ReplicatorSv1SetFraudProfile> Delaying cache call for %v", rplSv1.v1.Config.GeneralCfg().CachingDelay))
+		time.Sleep(rplSv1.v1.Config.GeneralCfg().CachingDelay)
+	}
+	if err = rplSv1.v1.CallCache(utils.IfaceAsString(fp.APIOpts[utils.CacheOpt]),
+		fp.Tenant, utils.CacheFraudProfiles, fp.TenantID(), utils.EmptyString, &fp.FilterIDs, nil, fp.APIOpts); err != nil {
+		return
+	}
+	*reply = utils.OK
+	return
+}
+
+// RemoveFraudProfile is the replication method coresponding to the dataDB driver method
+func (rplSv1 *ReplicatorSv1) RemoveFraudProfile(ctx *context.Context, args *utils.TenantIDWithAPIOpts, reply *string) (err error) {
+	if err = rplSv1.dm.DataDB().RemoveFraudProfileDrv(args.Tenant, args.ID); err != nil {
+		return
+	}
+	// delay if needed before cache call
+	if rplSv1.v1.Config.GeneralCfg().CachingDelay != 0 {
+		utils.Logger.Info(fmt.Sprintf("<ReplicatorSv1RemoveFraudProfile> Delaying cache call for %v", rplSv1.v1.Config.GeneralCfg().CachingDelay))
+		time.Sleep(rplSv1.v1.Config.GeneralCfg().CachingDelay)
+	}
+	if err = rplSv1.v1.CallCache(utils.IfaceAsString(args.APIOpts[utils.CacheOpt]),
+		args.Tenant, utils.CacheFraudProfiles, args.TenantID.TenantID(), utils.EmptyString, nil, nil, args.APIOpts); err != nil {
+		return
+	}
+	*reply = utils.OK
+	return
+}
+
+// SetPortedNumber is the replication method coresponding to the dataDb driver method
+func (rplSv1 *ReplicatorSv1) SetPortedNumber(ctx *context.Context, pn *engine.PortedNumberWithAPIOpts, reply *string) (err error) {
+	if err = rplSv1.dm.DataDB().SetPortedNumberDrv(pn.PortedNumber); err != nil {
+		return
+	}
+	if err = rplSv1.v1.CallCache(utils.IfaceAsString(pn.APIOpts[utils.CacheOpt]),
+		rplSv1.v1.Config.GeneralCfg().DefaultTenant, utils.CachePortedNumbers, pn.Number, utils.EmptyString, nil, nil, pn.APIOpts); err != nil {
+		return
+	}
+	*reply = utils.OK
+	return
+}
+
+// RemovePortedNumber is the replication method coresponding to the dataDb driver method
+func (rplSv1 *ReplicatorSv1) RemovePortedNumber(ctx *context.Context, number *utils.StringWithAPIOpts, reply *string) (err error) {
+	if err = rplSv1.dm.DataDB().RemovePortedNumberDrv(number.Arg); err != nil {
+		return
+	}
+	if err = rplSv1.v1.CallCache(utils.IfaceAsString(number.APIOpts[utils.CacheOpt]),
+		number.Tenant, utils.CachePortedNumbers, number.Arg, utils.EmptyString, nil, nil, number.APIOpts); err != nil {
+		return
+	}
+	*reply = utils.OK
+	return
+}
+
+// SetLookupTable is the replication method coresponding to the dataDb driver method
+func (rplSv1 *ReplicatorSv1) SetLookupTable(ctx *context.Context, lt *engine.LookupTableWithAPIOpts, reply *string) (err error) {
+	if err = rplSv1.dm.DataDB().SetLookupTableDrv(lt.LookupTable); err != nil {
+		return
+	}
+	if err = rplSv1.v1.CallCache(utils.IfaceAsString(lt.APIOpts[utils.CacheOpt]),
+		lt.Tenant, utils.CacheLookupTables, lt.TenantID(), utils.EmptyString, nil, nil, lt.APIOpts); err != nil {
+		return
+	}
+	*reply = utils.OK
+	return
+}
+
+// RemoveLookupTable is the replication method coresponding to the dataDb driver method
+func (rplSv1 *ReplicatorSv1) RemoveLookupTable(ctx *context.Context, args *utils.TenantIDWithAPIOpts, reply *string) (err error) {
+	if err = rplSv1.dm.DataDB().RemoveLookupTableDrv(args.Tenant, args.ID); err != nil {
+		return
+	}
+	if err = rplSv1.v1.CallCache(utils.IfaceAsString(args.APIOpts[utils.CacheOpt]),
+		args.Tenant, utils.CacheLookupTables, args.TenantID.TenantID(), utils.EmptyString, nil, nil, args.APIOpts); err != nil {
+		return
+	}
+	*reply = utils.OK
+	return
+}
+
 // RemoveThreshold is the replication method coresponding to the dataDb driver method
 func (rplSv1 *ReplicatorSv1) RemoveThreshold(ctx *context.Context, args *utils.TenantIDWithAPIOpts, reply *string) (err error) {
 	if err = rplSv1.dm.DataDB().RemoveThresholdDrv(args.Tenant, args.ID); err != nil {
@@ -41,6 +41,11 @@ func (rsv1 *ResourceSv1) GetResourcesForEvent(ctx *context.Context, args *utils.
 	return rsv1.rls.V1GetResourcesForEvent(ctx, args, reply)
 }
 
+// ExplainEvent details why the ResourceProfiles were matched or not for a specific event
+func (rsv1 *ResourceSv1) ExplainEvent(ctx *context.Context, args *utils.CGREvent, reply *engine.MatchExplanation) error {
+	return rsv1.rls.V1ExplainEvent(ctx, args, reply)
+}
+
 // AuthorizeResources checks if there are limits imposed for event
 func (rsv1 *ResourceSv1) AuthorizeResources(ctx *context.Context, args *utils.CGREvent, reply *string) error {
 	return rsv1.rls.V1AuthorizeResources(ctx, args, reply)
@@ -112,6 +117,14 @@ func (apierSv1 *APIerSv1) SetResourceProfile(ctx *context.Context, arg *engine.R
 	if arg.Tenant == utils.EmptyString {
 		arg.Tenant = apierSv1.Config.GeneralCfg().DefaultTenant
 	}
+	if arg.ParentID == arg.ID {
+		return fmt.Errorf("resource <%s> cannot be its own parent", arg.ID)
+	}
+	if arg.RateLimit != nil {
+		if err = arg.RateLimit.Validate(); err != nil {
+			return
+		}
+	}
 	if err = apierSv1.DataManager.SetResourceProfile(arg.ResourceProfile, true); err != nil {
 		return utils.APIErrorHandler(err)
 	}
@@ -144,6 +144,11 @@ func (rS *RouteSv1) GetRouteProfilesForEvent(ctx *context.Context, args *utils.C
 	return rS.rS.V1GetRouteProfilesForEvent(ctx, args, reply)
 }
 
+// ExplainEvent details why the RouteProfiles were matched or not for Event
+func (rS *RouteSv1) ExplainEvent(ctx *context.Context, args *utils.CGREvent, reply *engine.MatchExplanation) error {
+	return rS.rS.V1ExplainEvent(ctx, args, reply)
+}
+
 // GetRoutesList returns sorted list of routes for Event as a string slice
 func (rS *RouteSv1) GetRoutesList(ctx *context.Context, args *utils.CGREvent, reply *[]string) error {
 	return rS.rS.V1GetRoutesList(ctx, args, reply)
@@ -0,0 +1,103 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package v1
+
+import (
+	"github.com/cgrates/birpc/context"
+	"github.com/cgrates/cgrates/engine"
+	"github.com/cgrates/cgrates/utils"
+)
+
+// AttrSimulateActions selects the account and the actions simulated over it
+type AttrSimulateActions struct {
+	Tenant       string
+	Account      string
+	ActionPlanID string        // simulate the actions of all the timings within the ActionPlan
+	ActionsID    string        // simulate stored actions
+	Actions      []*V1TPAction // simulate actions defined inline, executed after the stored ones
+}
+
+// SimulateActions executes the actions over a copy of the account, returning the balance changes,
+// the fired action triggers and the events which would have been published.
+// Nothing is persisted and actions reaching outside of the account are reported as skipped.
+func (apierSv1 *APIerSv1) SimulateActions(ctx *context.Context, attr *AttrSimulateActions, reply *engine.ActionsSimulation) (err error) {
+	if missing := utils.MissingStructFields(attr, []string{utils.AccountField}); len(missing) != 0 {
+		return utils.NewErrMandatoryIeMissing(missing...)
+	}
+	if attr.ActionPlanID == utils.EmptyString &&
+		attr.ActionsID == utils.EmptyString &&
+		len(attr.Actions) == 0 {
+		return utils.NewErrMandatoryIeMissing("ActionPlanID", "ActionsID", "Actions")
+	}
+	tnt := attr.Tenant
+	if tnt == utils.EmptyString {
+		tnt = apierSv1.Config.GeneralCfg().DefaultTenant
+	}
+	accID := utils.ConcatenatedKey(tnt, attr.Account)
+	var actsList []engine.Actions
+	if attr.ActionPlanID != utils.EmptyString {
+		var ap *engine.ActionPlan
+		if ap, err = apierSv1.DataManager.GetActionPlan(attr.ActionPlanID, true, true, utils.NonTransactional); err != nil {
+			if err != utils.ErrNotFound {
+				err = utils.NewErrServerError(err)
+			}
+			return
+		}
+		engine.ActionTimingWeightOnlyPriorityList(ap.ActionTimings).Sort()
+		for _, at := range ap.ActionTimings {
+			var acts engine.Actions
+			if acts, err = apierSv1.DataManager.GetActions(at.ActionsID, false, utils.NonTransactional); err != nil {
+				return utils.NewErrServerError(err)
+			}
+			actsList = append(actsList, acts)
+		}
+	}
+	if attr.ActionsID != utils.EmptyString {
+		var acts engine.Actions
+		if acts, err = apierSv1.DataManager.GetActions(attr.ActionsID, false, utils.NonTransactional); err != nil {
+			if err != utils.ErrNotFound {
+				err = utils.NewErrServerError(err)
+			}
+			return
+		}
+		actsList = append(actsList, acts)
+	}
+	if len(attr.Actions) != 0 {
+		acts := make(engine.Actions, len(attr.Actions))
+		for i, apiAct := range attr.Actions {
+			if acts[i], err = apierSv1.newActionFromV1(utils.MetaDryRun, apiAct); err != nil {
+				return
+			}
+		}
+		actsList = append(actsList, acts)
+	}
+	acc, err := apierSv1.DataManager.GetAccount(accID)
+	if err != nil {
+		if err != utils.ErrNotFound {
+			return utils.NewErrServerError(err)
+		}
+		acc = &engine.Account{ID: accID} // simulate over a new account, as created by the scheduler
+	}
+	var sim *engine.ActionsSimulation
+	if sim, err = acc.SimulateActions(apierSv1.FilterS, actsList...); err != nil {
+		return
+	}
+	*reply = *sim
+	return
+}
@@ -155,6 +155,11 @@ func (stsv1 *StatSv1) GetStatQueuesForEvent(ctx *context.Context, args *utils.CG
 	return stsv1.sS.V1GetStatQueuesForEvent(ctx, args, reply)
 }
 
+// ExplainEvent details why the StatQueueProfiles were matched or not for Event
+func (stsv1 *StatSv1) ExplainEvent(ctx *context.Context, args *utils.CGREvent, reply *engine.MatchExplanation) (err error) {
+	return stsv1.sS.V1ExplainEvent(ctx, args, reply)
+}
+
 // GetStatQueue returns a StatQueue object
 func (stsv1 *StatSv1) GetStatQueue(ctx *context.Context, args *utils.TenantIDWithAPIOpts, reply *engine.StatQueue) (err error) {
 	return stsv1.sS.V1GetStatQueue(ctx, args, reply)
@@ -0,0 +1,159 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package v1
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cgrates/birpc/context"
+	"github.com/cgrates/cgrates/engine"
+	"github.com/cgrates/cgrates/utils"
+)
+
+// SetTaxProfile stores the TaxProfile, replacing the one with the same ID
+func (apierSv1 *APIerSv1) SetTaxProfile(ctx *context.Context, arg *engine.TaxProfileWithAPIOpts, reply *string) (err error) {
+	if arg.TaxProfile == nil {
+		return utils.NewErrMandatoryIeMissing(utils.ID)
+	}
+	if missing := utils.MissingStructFields(arg.TaxProfile, []string{utils.ID, "Taxes"}); len(missing) != 0 {
+		return utils.NewErrMandatoryIeMissing(missing...)
+	}
+	tp := arg.TaxProfile.Clone()
+	if tp.Tenant == utils.EmptyString {
+		tp.Tenant = apierSv1.Config.GeneralCfg().DefaultTenant
+	}
+	if err = tp.Validate(); err != nil {
+		return
+	}
+	if err = apierSv1.DataManager.SetTaxProfile(tp, true); err != nil {
+		return utils.APIErrorHandler(err)
+	}
+	//generate a loadID for CacheTaxProfiles and store it in database
+	if err = apierSv1.DataManager.SetLoadIDs(map[string]int64{utils.CacheTaxProfiles: time.Now().UnixNano()}); err != nil {
+		return utils.APIErrorHandler(err)
+	}
+	// delay if needed before cache call
+	if apierSv1.Config.GeneralCfg().CachingDelay != 0 {
+		utils.Logger.Info(fmt.Sprintf("<SetTaxProfile> Delaying cache call for %v", apierSv1.Config.GeneralCfg().CachingDelay))
+		time.Sleep(apierSv1.Config.GeneralCfg().CachingDelay)
+	}
+	//handle caching for TaxProfile
+	if err = apierSv1.CallCache(utils.IfaceAsString(arg.APIOpts[utils.CacheOpt]), tp.Tenant, utils.CacheTaxProfiles,
+		tp.TenantID(), utils.EmptyString, &tp.FilterIDs, nil, arg.APIOpts); err != nil {
+		return utils.APIErrorHandler(err)
+	}
+	*reply = utils.OK
+	return
+}
+
+// GetTaxProfile returns the TaxProfile with the given ID
+func (apierSv1 *APIerSv1) GetTaxProfile(ctx *context.Context, arg *utils.TenantID, reply *engine.TaxProfile) (err error) {
+	if missing := utils.MissingStructFields(arg, []string{utils.ID}); len(missing) != 0 {
+		return utils.NewErrMandatoryIeMissing(missing...)
+	}
+	tnt := arg.Tenant
+	if tnt == utils.EmptyString {
+		tnt = apierSv1.Config.GeneralCfg().DefaultTenant
+	}
+	var tp *engine.TaxProfile
+	if tp, err = apierSv1.DataManager.GetTaxProfile(tnt, arg.ID, true, true, utils.NonTransactional); err != nil {
+		return utils.APIErrorHandler(err)
+	}
+	*reply = *tp
+	return
+}
+
+// GetTaxProfiles returns all the TaxProfiles of the tenant
+func (apierSv1 *APIerSv1) GetTaxProfiles(ctx *context.Context, arg *utils.TenantWithAPIOpts, reply *[]*engine.TaxProfile) (err error) {
+	tnt := arg.Tenant
+	if tnt == utils.EmptyString {
+		tnt = apierSv1.Config.GeneralCfg().DefaultTenant
+	}
+	prfx := utils.TaxProfilesPrefix + tnt + utils.ConcatenatedKeySep
+	var keys []string
+	if keys, err = apierSv1.DataManager.DataDB().GetKeysForPrefix(prfx, utils.EmptyString); err != nil {
+		return
+	}
+	if len(keys) == 0 {
+		return utils.ErrNotFound
+	}
+	tps := make([]*engine.TaxProfile, 0, len(keys))
+	for _, key := range keys {
+		var tp *engine.TaxProfile
+		if tp, err = apierSv1.DataManager.GetTaxProfile(tnt, key[len(prfx):], true, true, utils.NonTransactional); err != nil {
+			return utils.APIErrorHandler(err)
+		}
+		tps = append(tps, tp)
+	}
+	*reply = tps
+	return
+}
+
+// RemoveTaxProfile removes the TaxProfile with the given ID
+func (apierSv1 *APIerSv1) RemoveTaxProfile(ctx *context.Context, arg *utils.TenantIDWithAPIOpts, reply *string) (err error) {
+	if missing := utils.MissingStructFields(arg, []string{utils.ID}); len(missing) != 0 {
+		return utils.NewErrMandatoryIeMissing(missing...)
+	}
+	tnt := arg.Tenant
+	if tnt == utils.EmptyString {
+		tnt = apierSv1.Config.GeneralCfg().DefaultTenant
+	}
+	if err = apierSv1.DataManager.RemoveTaxProfile(tnt, arg.ID, true); err != nil {
+		return utils.APIErrorHandler(err)
+	}
+	//generate a loadID for CacheTaxProfiles and store it in database
+	if err = apierSv1.DataManager.SetLoadIDs(map[string]int64{utils.CacheTaxProfiles: time.Now().UnixNano()}); err != nil {
+		return utils.APIErrorHandler(err)
+	}
+	// delay if needed before cache call
+	if apierSv1.Config.GeneralCfg().CachingDelay != 0 {
+		utils.Logger.Info(fmt.Sprintf("<RemoveTaxProfile> Delaying cache call for %v", apierSv1.Config.GeneralCfg().CachingDelay))
+		time.Sleep(apierSv1.Config.GeneralCfg().CachingDelay)
+	}
+	//handle caching for TaxProfile
+	if err = apierSv1.CallCache(utils.IfaceAsString(arg.APIOpts[utils.CacheOpt]), tnt, utils.CacheTaxProfiles,
+		utils.ConcatenatedKey(tnt, arg.ID), utils.EmptyString, nil, nil, arg.APIOpts); err != nil {
+		return utils.APIErrorHandler(err)
+	}
+	*reply = utils.OK
+	return
+}
+
+// GetCDRTaxes returns the taxes of the TaxProfile matching the event, applied on its Cost
+func (apierSv1 *APIerSv1) GetCDRTaxes(ctx *context.Context, args *utils.CGREvent, reply *engine.CDRTaxes) (err error) {
+	if missing := utils.MissingMapFields(args.Event, []string{utils.Cost}); len(missing) != 0 {
+		return utils.NewErrMandatoryIeMissing(missing...)
+	}
+	tnt := args.Tenant
+	if tnt == utils.EmptyString {
+		tnt = apierSv1.Config.GeneralCfg().DefaultTenant
+	}
+	var cdr *engine.CDR
+	if cdr, err = engine.NewMapEvent(args.Event).AsCDR(apierSv1.Config, tnt,
+		apierSv1.Config.GeneralCfg().DefaultTimezone); err != nil {
+		return utils.NewErrServerError(err)
+	}
+	var cdrTxs *engine.CDRTaxes
+	if cdrTxs, err = engine.CDRTaxesFor(apierSv1.DataManager, apierSv1.FilterS, cdr); err != nil {
+		return utils.APIErrorHandler(err)
+	}
+	*reply = *cdrTxs
+	return
+}
@@ -0,0 +1,88 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package v1
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/cgrates/birpc/context"
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/engine"
+	"github.com/cgrates/cgrates/utils"
+)
+
+func TestTaxProfilesSetGetRemove(t *testing.T) {
+	cfg := config.NewDefaultCGRConfig()
+	data, err := engine.NewInternalDB(nil, nil, true, nil, cfg.DataDbCfg().Items)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dm := engine.NewDataManager(data, cfg.CacheCfg(), nil)
+	apierSv1 := &APIerSv1{
+		DataManager: dm,
+		Config:      cfg,
+		FilterS:     engine.NewFilterS(cfg, nil, dm),
+	}
+	var reply string
+	noCache := map[string]any{utils.CacheOpt: utils.MetaNone}
+	if err = apierSv1.SetTaxProfile(context.Background(), &engine.TaxProfileWithAPIOpts{
+		TaxProfile: &engine.TaxProfile{ID: "TXP1",
+			Taxes: []*engine.Tax{{Code: "VAT", Type: "*unknown"}}},
+		APIOpts: noCache}, &reply); err == nil {
+		t.Error("expected validation error")
+	}
+	if err = apierSv1.SetTaxProfile(context.Background(), &engine.TaxProfileWithAPIOpts{
+		TaxProfile: &engine.TaxProfile{ID: "TXP1", FilterIDs: []string{"*string:~*req.Account:1001"},
+			Taxes: []*engine.Tax{{Code: "VAT", Type: utils.MetaPercent, Rate: 20}}},
+		APIOpts: noCache}, &reply); err != nil {
+		t.Fatal(err)
+	}
+	var tp engine.TaxProfile
+	if err = apierSv1.GetTaxProfile(context.Background(), &utils.TenantID{ID: "TXP1"}, &tp); err != nil {
+		t.Fatal(err)
+	} else if tp.Tenant != "cgrates.org" || len(tp.Taxes) != 1 {
+		t.Errorf("unexpected profile: %s", utils.ToJSON(tp))
+	}
+	var cdrTxs engine.CDRTaxes
+	if err = apierSv1.GetCDRTaxes(context.Background(), &utils.CGREvent{
+		Event: map[string]any{utils.AccountField: "1001", utils.Cost: 2.5}}, &cdrTxs); err != nil {
+		t.Fatal(err)
+	} else if cdrTxs.Total != 0.5 {
+		t.Errorf("expected 0.5 taxes, received %s", utils.ToJSON(cdrTxs))
+	}
+	var idx []string
+	if err = apierSv1.GetFilterIndexes(context.Background(), &AttrGetFilterIndexes{ItemType: utils.MetaTaxes}, &idx); err != nil {
+		t.Fatal(err)
+	} else if exp := []string{"*string:*req.Account:1001:TXP1"}; !reflect.DeepEqual(exp, idx) {
+		t.Errorf("expected %v, received %v", exp, idx)
+	}
+	rmArgs := &utils.TenantIDWithAPIOpts{TenantID: &utils.TenantID{ID: "TXP1"}, APIOpts: noCache}
+	if err = apierSv1.RemoveTaxProfile(context.Background(), rmArgs, &reply); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = dm.GetIndexes(utils.CacheTaxFilterIndexes, "cgrates.org", false, false); err != utils.ErrNotFound {
+		t.Errorf("expected %v, received %v", utils.ErrNotFound, err)
+	}
+	engine.Cache.Clear(nil) // the profile is still cached since the APIs ran with *none caching
+	if err = apierSv1.RemoveTaxProfile(context.Background(), rmArgs, &reply); err == nil ||
+		err.Error() != utils.ErrNotFound.Error() {
+		t.Errorf("expected %v, received %v", utils.ErrNotFound, err)
+	}
+}
@@ -47,6 +47,11 @@ func (tSv1 *ThresholdSv1) GetThresholdsForEvent(ctx *context.Context, args *util
 	return tSv1.tS.V1GetThresholdsForEvent(ctx, args, reply)
 }
 
+// ExplainEvent details why the ThresholdProfiles were matched or not for an event
+func (tSv1 *ThresholdSv1) ExplainEvent(ctx *context.Context, args *utils.CGREvent, reply *engine.MatchExplanation) error {
+	return tSv1.tS.V1ExplainEvent(ctx, args, reply)
+}
+
 // GetThreshold queries a Threshold
 func (tSv1 *ThresholdSv1) GetThreshold(ctx *context.Context, tntID *utils.TenantIDWithAPIOpts, t *engine.Threshold) error {
 	return tSv1.tS.V1GetThreshold(ctx, tntID.TenantID, t)
@@ -62,6 +67,21 @@ func (tSv1 *ThresholdSv1) ResetThreshold(ctx *context.Context, tntID *utils.Tena
 	return tSv1.tS.V1ResetThreshold(ctx, tntID.TenantID, reply)
 }
 
+// AcknowledgeThreshold acknowledges the alert of a threshold
+func (tSv1 *ThresholdSv1) AcknowledgeThreshold(ctx *context.Context, tntID *utils.TenantIDWithAPIOpts, reply *string) error {
+	return tSv1.tS.V1AcknowledgeThreshold(ctx, tntID.TenantID, reply)
+}
+
+// SnoozeThreshold snoozes the alert of a threshold
+func (tSv1 *ThresholdSv1) SnoozeThreshold(ctx *context.Context, args *engine.ThresholdSnoozeArgs, reply *string) error {
+	return tSv1.tS.V1SnoozeThreshold(ctx, args, reply)
+}
+
+// ResolveThreshold resolves the alert of a threshold
+func (tSv1 *ThresholdSv1) ResolveThreshold(ctx *context.Context, tntID *utils.TenantIDWithAPIOpts, reply *string) error {
+	return tSv1.tS.V1ResolveThreshold(ctx, tntID.TenantID, reply)
+}
+
 // BiRPCv1RegisterInternalBiJSONConn will register the internal BiRPC connection towards ThresholdS
 func (tSv1 *ThresholdSv1) RegisterInternalBiJSONConn(ctx *context.Context, args string, rply *string) (err error) {
 	return tSv1.tS.BiRPCv1RegisterInternalBiJSONConn(ctx, args, rply)
@@ -24,6 +24,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"path"
 	"strings"
 	"time"
 
@@ -343,6 +344,43 @@ func getLoader(cfg *config.CGRConfig) (loader engine.LoadReader, err error) {
 	return
 }
 
+// isLocalFolder checks if the tariff plan is read out of a local folder
+func isLocalFolder(dPath string) bool {
+	return !*fromStorDB && !utils.IsURL(dPath) &&
+		!strings.HasPrefix(dPath, utils.MetaGoogleAPI+utils.ConcatenatedKeySep)
+}
+
+// loadLookupTables loads the LookupTables.csv file out of the folder directly into DataDB,
+// as APIerSv1.LoadTariffPlanFromFolder does, since the tables are too large for the TpReader
+func loadLookupTables(cfg *config.CGRConfig, connMgr *engine.ConnManager,
+	fldrPath, tnt string, opts map[string]any) (err error) {
+	fPath := path.Join(fldrPath, utils.LookupTablesCsv)
+	var f *os.File
+	if f, err = os.Open(fPath); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return
+	}
+	defer f.Close()
+	dm := engine.NewDataManager(dataDB, cfg.CacheCfg(), connMgr)
+	var tntIDs []string
+	if tntIDs, err = engine.LoadLookupTablesCSV(dm, f, cfg.LoaderCgrCfg().FieldSeparator, tnt); err != nil {
+		return fmt.Errorf("%w, loading <%s>", err, fPath)
+	}
+	if *verbose {
+		log.Printf("Loaded %d lookup tables out of <%s>", len(tntIDs), fPath)
+	}
+	if err = dm.SetLoadIDs(map[string]int64{utils.CacheLookupTables: time.Now().UnixNano()}); err != nil {
+		return
+	}
+	if len(cfg.LoaderCgrCfg().CachesConns) == 0 {
+		return
+	}
+	return engine.CallCache(connMgr, cfg.LoaderCgrCfg().CachesConns, cfg.GeneralCfg().DefaultCaching,
+		map[string][]string{utils.CacheLookupTables: tntIDs}, nil, opts, *verbose, tnt)
+}
+
 func main() {
 	var err error
 	if err = cgrLoaderFlags.Parse(os.Args[1:]); err != nil {
@@ -359,7 +397,7 @@ func main() {
 
 	ldrCfg := loadConfig()
 	// we initialize connManager here with nil for InternalChannels
-	engine.NewConnManager(ldrCfg, nil)
+	connMgr := engine.NewConnManager(ldrCfg, nil)
 
 	if !*toStorDB {
 		if dataDB, err = engine.NewDataDBConn(ldrCfg.DataDbCfg().Type,
@@ -437,6 +475,15 @@ func main() {
 		log.Fatal("Could not reload cache: ", err)
 	}
 
+	if !*remove && isLocalFolder(*dataPath) {
+		if err = loadLookupTables(ldrCfg, connMgr, *dataPath, *tenant, map[string]any{
+			utils.OptsAPIKey:  *apiKey,
+			utils.OptsRouteID: *routeID,
+		}); err != nil {
+			log.Fatal("Could not load lookup tables: ", err)
+		}
+	}
+
 	if len(ldrCfg.LoaderCgrCfg().SchedulerConns) != 0 {
 		if err = tpReader.ReloadScheduler(*verbose); err != nil {
 			log.Fatal("Could not reload scheduler: ", err)
@@ -0,0 +1,75 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package main
+
+import (
+	"os"
+	"path"
+	"testing"
+
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/engine"
+	"github.com/cgrates/cgrates/utils"
+)
+
+func TestLoadLookupTables(t *testing.T) {
+	cfg := config.NewDefaultCGRConfig()
+	cfg.LoaderCgrCfg().CachesConns = nil
+	db, err := engine.NewInternalDB(nil, nil, true, nil, cfg.DataDbCfg().Items)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpDB := dataDB
+	dataDB = db
+	defer func() { dataDB = tmpDB }()
+
+	fldr := t.TempDir()
+	if err := loadLookupTables(cfg, nil, fldr, "cgrates.org", nil); err != nil {
+		t.Errorf("expected the missing file to be skipped, received %v", err)
+	}
+	if err := os.WriteFile(path.Join(fldr, utils.LookupTablesCsv), []byte(`#Tenant,ID,MatchType,Key,Value
+,LKT_CLI,*prefix,+49,DE
+,LKT_CLI,,*default,UNKNOWN
+`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := loadLookupTables(cfg, nil, fldr, "cgrates.org", nil); err != nil {
+		t.Fatal(err)
+	}
+	if lt, err := db.GetLookupTableDrv("cgrates.org", "LKT_CLI"); err != nil {
+		t.Fatal(err)
+	} else if lt.MatchType != utils.MetaPrefix || lt.Entries["+49"] != "DE" || lt.Default != "UNKNOWN" {
+		t.Errorf("unexpected lookup table: %s", utils.ToJSON(lt))
+	}
+	if loadIDs, err := db.GetItemLoadIDsDrv(utils.CacheLookupTables); err != nil {
+		t.Error(err)
+	} else if _, has := loadIDs[utils.CacheLookupTables]; !has {
+		t.Errorf("missing load ID: %v", loadIDs)
+	}
+
+	for dPath, exp := range map[string]bool{
+		fldr:                          true,
+		"http://example.com/tariffs/": false,
+		utils.MetaGoogleAPI + utils.ConcatenatedKeySep + "1a2b": false,
+	} {
+		if rcv := isLocalFolder(dPath); rcv != exp {
+			t.Errorf("for %q expected %v, received %v", dPath, exp, rcv)
+		}
+	}
+}
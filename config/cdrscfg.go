@@ -28,12 +28,14 @@ type CdrsCfg struct {
 	ExtraFields        RSRParsers // Extra fields to store in CDRs
 	StoreCdrs          bool       // store cdrs in storDb
 	CompressStoredCost bool       // compress cost details in cdrs
+	Taxes              bool       // apply the TaxProfiles to the rated cdrs
 	SMCostRetries      int
 	ChargerSConns      []string
 	RaterConns         []string
 	AttributeSConns    []string
 	ThresholdSConns    []string
 	StatSConns         []string
+	FraudSConns        []string
 	OnlineCDRExports   []string // list of CDRE templates to use for real-time CDR exports
 	SchedulerConns     []string
 	EEsConns           []string
@@ -58,6 +60,9 @@ func (cdrscfg *CdrsCfg) loadFromJSONCfg(jsnCdrsCfg *CdrsJsonCfg) (err error) {
 	if jsnCdrsCfg.Compress_stored_cost != nil {
 		cdrscfg.CompressStoredCost = *jsnCdrsCfg.Compress_stored_cost
 	}
+	if jsnCdrsCfg.Taxes != nil {
+		cdrscfg.Taxes = *jsnCdrsCfg.Taxes
+	}
 	if jsnCdrsCfg.Session_cost_retries != nil {
 		cdrscfg.SMCostRetries = *jsnCdrsCfg.Session_cost_retries
 	}
@@ -111,6 +116,16 @@ func (cdrscfg *CdrsCfg) loadFromJSONCfg(jsnCdrsCfg *CdrsJsonCfg) (err error) {
 			}
 		}
 	}
+	if jsnCdrsCfg.Frauds_conns != nil {
+		cdrscfg.FraudSConns = make([]string, len(*jsnCdrsCfg.Frauds_conns))
+		for idx, connID := range *jsnCdrsCfg.Frauds_conns {
+			// if we have the connection internal we change the name so we can have internal rpc for each subsystem
+			cdrscfg.FraudSConns[idx] = connID
+			if connID == utils.MetaInternal {
+				cdrscfg.FraudSConns[idx] = utils.ConcatenatedKey(utils.MetaInternal, utils.MetaFrauds)
+			}
+		}
+	}
 	if jsnCdrsCfg.Online_cdr_exports != nil {
 		cdrscfg.OnlineCDRExports = make([]string, len(*jsnCdrsCfg.Online_cdr_exports))
 		copy(cdrscfg.OnlineCDRExports, *jsnCdrsCfg.Online_cdr_exports)
@@ -145,6 +160,7 @@ func (cdrscfg *CdrsCfg) AsMapInterface() (initialMP map[string]any) {
 		utils.EnabledCfg:            cdrscfg.Enabled,
 		utils.StoreCdrsCfg:          cdrscfg.StoreCdrs,
 		utils.CompressStoredCostCfg: cdrscfg.CompressStoredCost,
+		utils.TaxesCfg:              cdrscfg.Taxes,
 		utils.SMCostRetriesCfg:      cdrscfg.SMCostRetries,
 	}
 
@@ -209,6 +225,16 @@ func (cdrscfg *CdrsCfg) AsMapInterface() (initialMP map[string]any) {
 		}
 		initialMP[utils.StatSConnsCfg] = statSConns
 	}
+	if cdrscfg.FraudSConns != nil {
+		fraudSConns := make([]string, len(cdrscfg.FraudSConns))
+		for i, item := range cdrscfg.FraudSConns {
+			fraudSConns[i] = item
+			if item == utils.ConcatenatedKey(utils.MetaInternal, utils.MetaFrauds) {
+				fraudSConns[i] = utils.MetaInternal
+			}
+		}
+		initialMP[utils.FraudSConnsCfg] = fraudSConns
+	}
 	if cdrscfg.SchedulerConns != nil {
 		schedulerConns := make([]string, len(cdrscfg.SchedulerConns))
 		for i, item := range cdrscfg.SchedulerConns {
@@ -243,6 +269,7 @@ func (cdrscfg *CdrsCfg) Clone() (cln *CdrsCfg) {
 		StoreCdrs:          cdrscfg.StoreCdrs,
 		SMCostRetries:      cdrscfg.SMCostRetries,
 		CompressStoredCost: cdrscfg.CompressStoredCost,
+		Taxes:              cdrscfg.Taxes,
 	}
 	if cdrscfg.ChargerSConns != nil {
 		cln.ChargerSConns = make([]string, len(cdrscfg.ChargerSConns))
@@ -264,6 +291,10 @@ func (cdrscfg *CdrsCfg) Clone() (cln *CdrsCfg) {
 		cln.StatSConns = make([]string, len(cdrscfg.StatSConns))
 		copy(cln.StatSConns, cdrscfg.StatSConns)
 	}
+	if cdrscfg.FraudSConns != nil {
+		cln.FraudSConns = make([]string, len(cdrscfg.FraudSConns))
+		copy(cln.FraudSConns, cdrscfg.FraudSConns)
+	}
 	if cdrscfg.OnlineCDRExports != nil {
 		cln.OnlineCDRExports = make([]string, len(cdrscfg.OnlineCDRExports))
 		copy(cln.OnlineCDRExports, cdrscfg.OnlineCDRExports)
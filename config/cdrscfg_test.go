@@ -34,6 +34,7 @@ func TestCdrsCfgloadFromJsonCfg(t *testing.T) {
 		Attributes_conns:     &[]string{utils.MetaInternal, "*conn1"},
 		Thresholds_conns:     &[]string{utils.MetaInternal, "*conn1"},
 		Stats_conns:          &[]string{utils.MetaInternal, "*conn1"},
+		Frauds_conns:         &[]string{utils.MetaInternal, "*conn1"},
 		Online_cdr_exports:   &[]string{"randomVal"},
 		Scheduler_conns:      &[]string{utils.MetaInternal, "*conn1"},
 		Ees_conns:            &[]string{utils.MetaInternal, "*conn1"},
@@ -47,6 +48,7 @@ func TestCdrsCfgloadFromJsonCfg(t *testing.T) {
 		AttributeSConns:  []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaAttributes), "*conn1"},
 		ThresholdSConns:  []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaThresholds), "*conn1"},
 		StatSConns:       []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaStats), "*conn1"},
+		FraudSConns:      []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaFrauds), "*conn1"},
 		OnlineCDRExports: []string{"randomVal"},
 		SchedulerConns:   []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaScheduler), "*conn1"},
 		EEsConns:         []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaEEs), "*conn1"},
@@ -83,6 +85,7 @@ func TestCdrsCfgAsMapInterface(t *testing.T) {
 		"attributes_conns": ["*internal:*attributes","*conn1"],					
 		"thresholds_conns": ["*internal:*thresholds","*conn1"],					
 		"stats_conns": ["*internal:*stats","*conn1"],						
+		"frauds_conns": ["*internal:*frauds","*conn1"],
 		"online_cdr_exports":["http_localhost", "amqp_localhost", "http_test_file"],
 		"scheduler_conns": ["*internal:*scheduler","*conn1"],		
         "ees_conns": ["*internal:*ees","*conn1"],
@@ -94,11 +97,13 @@ func TestCdrsCfgAsMapInterface(t *testing.T) {
 		utils.StoreCdrsCfg:          true,
 		utils.SessionCostRetires:    5,
 		utils.CompressStoredCostCfg: false,
+		utils.TaxesCfg:              false,
 		utils.ChargerSConnsCfg:      []string{utils.MetaInternal, "*conn1"},
 		utils.RALsConnsCfg:          []string{utils.MetaInternal, "*conn1"},
 		utils.AttributeSConnsCfg:    []string{utils.MetaInternal, "*conn1"},
 		utils.ThresholdSConnsCfg:    []string{utils.MetaInternal, "*conn1"},
 		utils.StatSConnsCfg:         []string{utils.MetaInternal, "*conn1"},
+		utils.FraudSConnsCfg:        []string{utils.MetaInternal, "*conn1"},
 		utils.OnlineCDRExportsCfg:   []string{"http_localhost", "amqp_localhost", "http_test_file"},
 		utils.SchedulerConnsCfg:     []string{utils.MetaInternal, "*conn1"},
 		utils.EEsConnsCfg:           []string{utils.MetaInternal, "*conn1"},
@@ -124,12 +129,14 @@ func TestCdrsCfgAsMapInterface2(t *testing.T) {
 		utils.ExtraFieldsCfg:        []string{},
 		utils.StoreCdrsCfg:          true,
 		utils.CompressStoredCostCfg: false,
+		utils.TaxesCfg:              false,
 		utils.SessionCostRetires:    5,
 		utils.ChargerSConnsCfg:      []string{"conn1", "conn2"},
 		utils.RALsConnsCfg:          []string{},
 		utils.AttributeSConnsCfg:    []string{"*internal"},
 		utils.ThresholdSConnsCfg:    []string{},
 		utils.StatSConnsCfg:         []string{},
+		utils.FraudSConnsCfg:        []string{},
 		utils.OnlineCDRExportsCfg:   []string{},
 		utils.SchedulerConnsCfg:     []string{},
 		utils.EEsConnsCfg:           []string{"conn1"},
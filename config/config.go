@@ -183,6 +183,7 @@ func newCGRConfig(config []byte) (cfg *CGRConfig, err error) {
 	cfg.sentryPeerCfg = new(SentryPeerCfg)
 	cfg.coreSCfg = new(CoreSCfg)
 	cfg.ipsCfg = &IPsCfg{Opts: &IPsOpts{}}
+	cfg.fraudSCfg = new(FraudSCfg)
 	cfg.dfltEvExp = &EventExporterCfg{Opts: &EventExporterOpts{
 		Els:   new(ElsOpts),
 		SQL:   new(SQLOpts),
@@ -337,6 +338,7 @@ type CGRConfig struct {
 	sentryPeerCfg      *SentryPeerCfg      //SentryPeer config
 	coreSCfg           *CoreSCfg           // CoreS config
 	ipsCfg             *IPsCfg             // IPs config
+	fraudSCfg          *FraudSCfg          // FraudS config
 
 	cacheDP    map[string]utils.MapStorage
 	cacheDPMux sync.RWMutex
@@ -376,7 +378,7 @@ func (cfg *CGRConfig) loadFromJSONCfg(jsnCfg *CgrJsonCfg) (err error) {
 		cfg.loadAnalyzerCgrCfg, cfg.loadApierCfg, cfg.loadErsCfg, cfg.loadEesCfg,
 		cfg.loadSIPAgentCfg, cfg.loadRegistrarCCfg, cfg.loadJanusAgentCfg,
 		cfg.loadConfigSCfg, cfg.loadAPIBanCgrCfg, cfg.loadSentryPeerCgrCfg,
-		cfg.loadCoreSCfg, cfg.loadIPsCfg,
+		cfg.loadCoreSCfg, cfg.loadIPsCfg, cfg.loadFraudSCfg,
 	} {
 		if err = loadFunc(jsnCfg); err != nil {
 			return
@@ -858,6 +860,15 @@ func (cfg *CGRConfig) loadIPsCfg(jsnCfg *CgrJsonCfg) error {
 	return cfg.ipsCfg.loadFromJSONCfg(jsnIPsCfg)
 }
 
+// loadFraudSCfg loads the FraudS section of the configuration.
+func (cfg *CGRConfig) loadFraudSCfg(jsnCfg *CgrJsonCfg) error {
+	jsnFraudSCfg, err := jsnCfg.FraudSJsonCfg()
+	if err != nil {
+		return err
+	}
+	return cfg.fraudSCfg.loadFromJSONCfg(jsnFraudSCfg)
+}
+
 // SureTaxCfg use locking to retrieve the configuration, possibility later for runtime reload
 func (cfg *CGRConfig) SureTaxCfg() *SureTaxCfg {
 	cfg.lks[SURETAX_JSON].Lock()
@@ -1183,6 +1194,13 @@ func (cfg *CGRConfig) CoreSCfg() *CoreSCfg {
 	return cfg.coreSCfg
 }
 
+// FraudSCfg returns the FraudS configuration.
+func (cfg *CGRConfig) FraudSCfg() *FraudSCfg {
+	cfg.lks[FraudSJSON].Lock()
+	defer cfg.lks[FraudSJSON].Unlock()
+	return cfg.fraudSCfg
+}
+
 // IPsCfg returns the IPs configuration.
 func (cfg *CGRConfig) IPsCfg() *IPsCfg {
 	cfg.lks[IPsJSON].Lock()
@@ -1300,6 +1318,7 @@ func (cfg *CGRConfig) getLoadFunctions() map[string]func(*CgrJsonCfg) error {
 		SentryPeerCfgJson:   cfg.loadSentryPeerCgrCfg,
 		CoreSCfgJson:        cfg.loadCoreSCfg,
 		IPsJSON:             cfg.loadIPsCfg,
+		FraudSJSON:          cfg.loadFraudSCfg,
 	}
 }
 
@@ -1458,7 +1477,7 @@ func (cfg *CGRConfig) reloadSections(sections ...string) {
 	subsystemsThatNeedDataDB := utils.NewStringSet([]string{DATADB_JSN, SCHEDULER_JSN,
 		RALS_JSN, CDRS_JSN, SessionSJson, ATTRIBUTE_JSN,
 		ChargerSCfgJson, RESOURCES_JSON, STATS_JSON, THRESHOLDS_JSON,
-		RouteSJson, DispatcherSJson, ApierS, IPsJSON,
+		RouteSJson, DispatcherSJson, ApierS, IPsJSON, FraudSJSON,
 	})
 	subsystemsThatNeedStorDB := utils.NewStringSet([]string{STORDB_JSN, RALS_JSN, CDRS_JSN, ApierS})
 	needsDataDB := false
@@ -1557,6 +1576,8 @@ func (cfg *CGRConfig) reloadSections(sections ...string) {
 			cfg.rldChans[RegistrarCJson] <- struct{}{}
 		case IPsJSON:
 			cfg.rldChans[IPsJSON] <- struct{}{}
+		case FraudSJSON:
+			cfg.rldChans[FraudSJSON] <- struct{}{}
 		}
 	}
 }
@@ -1610,6 +1631,7 @@ func (cfg *CGRConfig) AsMapInterface(separator string) (mp map[string]any) {
 		ConfigSJson:         cfg.configSCfg.AsMapInterface(),
 		CoreSCfgJson:        cfg.coreSCfg.AsMapInterface(),
 		IPsJSON:             cfg.ipsCfg.AsMapInterface(),
+		FraudSJSON:          cfg.fraudSCfg.AsMapInterface(),
 	}
 }
 
@@ -1778,6 +1800,8 @@ func (cfg *CGRConfig) V1GetConfig(ctx *context.Context, args *SectionWithAPIOpts
 		mp = cfg.CoreSCfg().AsMapInterface()
 	case IPsJSON:
 		mp = cfg.IPsCfg().AsMapInterface()
+	case FraudSJSON:
+		mp = cfg.FraudSCfg().AsMapInterface()
 	default:
 		return errors.New("Invalid section")
 	}
@@ -1949,6 +1973,8 @@ func (cfg *CGRConfig) V1GetConfigAsJSON(ctx *context.Context, args *SectionWithA
 		mp = cfg.CoreSCfg().AsMapInterface()
 	case IPsJSON:
 		mp = cfg.IPsCfg().AsMapInterface()
+	case FraudSJSON:
+		mp = cfg.FraudSCfg().AsMapInterface()
 	default:
 		return errors.New("Invalid section")
 	}
@@ -2050,6 +2076,7 @@ func (cfg *CGRConfig) Clone() (cln *CGRConfig) {
 		sentryPeerCfg:      cfg.sentryPeerCfg.Clone(),
 		coreSCfg:           cfg.coreSCfg.Clone(),
 		ipsCfg:             cfg.ipsCfg.Clone(),
+		fraudSCfg:          cfg.fraudSCfg.Clone(),
 
 		cacheDP: make(map[string]utils.MapStorage),
 	}
@@ -52,7 +52,9 @@ const CGRATES_CFG_JSON = `
 	"digest_separator": ",",				// separator to use in replies containing data digests
 	"digest_equal": ":",					// equal symbol used in case of digests
 	"rsr_separator": ";",					// separator used within RSR fields
-	"max_parallel_conns": 100				// the maximum number of connection used by the *parallel strategy
+	"max_parallel_conns": 100,				// the maximum number of connection used by the *parallel strategy
+	"wasm_memory_limit": 16,				// maximum memory of a *wasm module instance, in MiB
+	"wasm_timeout": "100ms"				// maximum duration of a *wasm module call, 0 to disable
 },
 
 
@@ -139,8 +141,15 @@ const CGRATES_CFG_JSON = `
 		"*attribute_filter_indexes" : {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false},
 		"*charger_filter_indexes" : {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false},
 		"*dispatcher_filter_indexes" : {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false},
+		"*fraud_filter_indexes" : {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false},
+		"*tax_filter_indexes" : {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false},
 		"*reverse_filter_indexes" : {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false},
 		"*sessions_backup": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false}, 
+		"*exchange_rates": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false},
+		"*tax_profiles": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false},
+		"*fraud_profiles": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false},
+		"*ported_numbers": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false},
+		"*lookup_tables": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false},
 	},
 	"opts":{
 		"internalDBDumpPath": "/var/lib/cgrates/internal_db/datadb",		// the path where datadb will be dumped
@@ -203,6 +212,8 @@ const CGRATES_CFG_JSON = `
 	},
 	"items":{
 		"*session_costs": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false},
+		"*balance_journal": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false},
+		"*invoices": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false},
 		"*cdrs": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false},
 		"*tp_timings": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false},
 		"*tp_destinations": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false},
@@ -293,7 +304,9 @@ const CGRATES_CFG_JSON = `
 	"thresholds_conns": [],		// connections to ThresholdS for *reset_threshold action <""|*internal|$rpc_conns_id>
 	"stats_conns": [],		// connections to StatS for *reset_stat_queue action: <""|*internal|$rpc_conns_id>
 	"filters": [],			// only execute actions matching these filters
-	"dynaprepaid_actionplans": []	// actionPlans to be executed in case of *dynaprepaid request type
+	"dynaprepaid_actionplans": [],	// actionPlans to be executed in case of *dynaprepaid request type
+	"leader_election": false,	// run the ActionPlans only on the Scheduler elected among the engines sharing the DataDB
+	"lease_ttl": "10s"		// time after which a standby Scheduler takes over from an unresponsive leader
 },
 
 
@@ -309,6 +322,8 @@ const CGRATES_CFG_JSON = `
 		"*action_triggers": {"limit": -1, "ttl": "", "static_ttl": false, "precache": false, "remote":false, "replicate": false},	// action triggers caching
 		"*shared_groups": {"limit": -1, "ttl": "", "static_ttl": false, "precache": false, "remote":false, "replicate": false},		// shared groups caching
 		"*timings": {"limit": -1, "ttl": "", "static_ttl": false, "precache": false, "remote":false, "replicate": false},		// timings caching
+		"*ported_numbers": {"limit": -1, "ttl": "", "static_ttl": false, "precache": false, "remote":false, "replicate": false},	// number portability caching
+		"*lookup_tables": {"limit": -1, "ttl": "", "static_ttl": false, "precache": false, "remote":false, "replicate": false},	// lookup tables caching
 		"*resource_profiles": {"limit": -1, "ttl": "", "static_ttl": false, "precache": false, "remote":false, "replicate": false},	// control resource profiles caching
 		"*resources": {"limit": -1, "ttl": "", "static_ttl": false, "precache": false, "remote":false, "replicate": false},		// control resources caching
 		"*event_resources": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate": false},				// matching resources to events
@@ -329,6 +344,8 @@ const CGRATES_CFG_JSON = `
 		"*charger_profiles": {"limit": -1, "ttl": "", "static_ttl": false, "precache": false, "remote":false, "replicate": false},	// control charger profile caching
 		"*dispatcher_profiles": {"limit": -1, "ttl": "", "static_ttl": false, "precache": false, "remote":false, "replicate": false},	// control dispatcher profile caching
 		"*dispatcher_hosts": {"limit": -1, "ttl": "", "static_ttl": false, "precache": false, "remote":false, "replicate": false},	// control dispatcher hosts caching
+		"*fraud_profiles": {"limit": -1, "ttl": "", "static_ttl": false, "precache": false, "remote":false, "replicate": false},	// control fraud profile caching
+		"*tax_profiles": {"limit": -1, "ttl": "", "static_ttl": false, "precache": false, "remote":false, "replicate": false},	// control tax profile caching
 		"*resource_filter_indexes" : {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate": false}, 		// control resource filter indexes caching
 		"*ip_filter_indexes" : {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate": false}, 		// control ip filter indexes caching
 		"*stat_filter_indexes" : {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate": false}, 			// control stat filter indexes caching
@@ -337,12 +354,15 @@ const CGRATES_CFG_JSON = `
 		"*attribute_filter_indexes" : {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate": false}, 		// control attribute filter indexes caching
 		"*charger_filter_indexes" : {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate": false}, 			// control charger filter indexes caching
 		"*dispatcher_filter_indexes" : {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate": false}, 		// control dispatcher filter indexes caching
+		"*fraud_filter_indexes" : {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate": false}, 			// control fraud filter indexes caching
+		"*tax_filter_indexes" : {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate": false}, 			// control tax filter indexes caching
 		"*reverse_filter_indexes" : {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate": false}, 			// control reverse filter indexes caching used only for set and remove filters 
 		"*dispatcher_routes": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate": false}, 			// control dispatcher routes caching
 		"*dispatcher_loads": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate": false},				// control dispatcher load( in case of *ratio ConnParams is present)
 		"*dispatchers": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate": false}, 				// control dispatcher interface
 		"*diameter_messages": {"limit": -1, "ttl": "3h", "static_ttl": false, "remote":false, "replicate": false},			// diameter messages caching
 		"*radius_packets": {"limit": -1, "ttl": "3h", "static_ttl": false, "remote":false, "replicate": false},				// radius packets caching
+		"*radius_eap_sessions": {"limit": -1, "ttl": "1m", "static_ttl": false, "remote":false, "replicate": false},			// radius EAP conversations in progress, indexed on State
 		"*rpc_responses": {"limit": 0, "ttl": "2s", "static_ttl": false, "remote":false, "replicate": false},				// RPC responses caching
 		"*closed_sessions": {"limit": -1, "ttl": "10s", "static_ttl": false, "remote":false, "replicate": false},			// closed sessions cached for CDRs
 		"*event_charges": {"limit": 0, "ttl": "10s", "static_ttl": false, "remote":false, "replicate": false},				// events proccessed by ChargerS
@@ -377,6 +397,7 @@ const CGRATES_CFG_JSON = `
 	"sessions_conns": [],			// connections to SessionS for actions requiring them: <""|*internal|$rpc_conns_id>
 	"rp_subject_prefix_matching": false,	// enables prefix matching for the rating profile subject
 	"remove_expired":true,			// enables automatic removal of expired balances
+	"balance_journal": false,		// record the balance changes in StorDB
 	"max_computed_usage": {			// do not compute usage higher than this, prevents memory overload
 		"*any": "189h",
 		"*voice": "72h",
@@ -399,12 +420,14 @@ const CGRATES_CFG_JSON = `
 	"extra_fields": [],		// extra fields to store in CDRs for non-generic CDRs (ie: FreeSWITCH JSON)
 	"store_cdrs": true,		// store cdrs in StorDB
 	"compress_stored_cost": false,	// compress CostDetails before storing
+	"taxes": false,			// apply the TaxProfiles to the rated CDRs, adding the taxes to their Cost
 	"session_cost_retries": 5,	// number of queries to session_costs before recalculating CDR
 	"chargers_conns": [],		// connection to ChargerS for CDR forking, empty to disable billing for CDRs: <""|*internal|$rpc_conns_id>
 	"rals_conns": [],		// connections to RALs for cost calculation: <""|*internal|$rpc_conns_id>
 	"attributes_conns": [],		// connection to AttributeS for altering *raw CDRs, empty to disable attributes functionality: <""|*internal|$rpc_conns_id>
 	"thresholds_conns": [],		// connection to ThresholdS for CDR reporting, empty to disable thresholds functionality: <""|*internal|$rpc_conns_id>
 	"stats_conns": [],		// connections to StatS for CDR reporting, empty to disable stats functionality: <""|*internal|$rpc_conns_id>
+	"frauds_conns": [],		// connections to FraudS for CDR risk scoring, empty to disable fraud detection: <""|*internal|$rpc_conns_id>
 	"online_cdr_exports":[],	// list of CDRE profiles to use for real-time CDR exports
 	"scheduler_conns": [],		// connections to SchedulerS in case of *dynaprepaid request
 	"ees_conns": []			// connections to EventExporter
@@ -674,6 +697,7 @@ const CGRATES_CFG_JSON = `
 	"cdrs_conns": [],			// connections to CDRs for CDR posting <""|*internal|$rpc_conns_id>
 	"ips_conns": [],			// connections to IPs for monitoring ip usage <""|*internal|$rpc_conns_id>
 	"resources_conns": [],			// connections to ResourceS for resources monitoring <""|*internal|$rpc_conns_id>
+	"frauds_conns": [],			// connections to FraudS for blocking risky sessions on authorization <""|*internal|$rpc_conns_id>
 	"thresholds_conns": [],			// connections to ThresholdS for reporting session events <""|*internal|$rpc_conns_id>
 	"stats_conns": [],			// connections to StatS for reporting session events <""|*internal|$rpc_conns_id>
 	"routes_conns": [],			// connections to RouteS for querying routes for event <""|*internal|$rpc_conns_id>
@@ -836,6 +860,12 @@ const CGRATES_CFG_JSON = `
 	"thresholds_conns": [],					// connections to ThresholdS, empty to disable: <""|*internal|$rpc_conns_id>
 	"dmr_template": "*dmr",					// template used to build the Disconnect-Request packet
 	"coa_template": "*coa",					// template used to build the CoA-Request packet
+	"require_message_authenticator": false,			// reject Access-Requests without a valid Message-Authenticator <true|false>
+	"eap_passthrough": {					// upstream server handling the EAP methods relayed with *eap_passthrough (EAP-TLS/PEAP)
+		"address": "",					// upstream address, empty to disable <""|$host:$port>
+		"transport": "udp",				// transport towards the upstream server <udp|tcp>
+		"secret": ""					// shared secret with the upstream server
+	},
 	"request_processors": []				// request processors to be applied to Radius messages
 },
 
@@ -849,12 +879,15 @@ const CGRATES_CFG_JSON = `
 	"listeners":[
 		{
 			"address": "127.0.0.1:53",	// address where to listen for DNS requests <x.y.z.y:1234>
-			"network": "udp"		// network to listen on <udp|tcp|tcp-tls>
+			"network": "udp"		// network to listen on, tcp-tls for DNS-over-TLS and https for DNS-over-HTTPS on /dns-query <udp|tcp|tcp-tls|https>
 		}
 	],
 	"sessions_conns": ["*internal"],
 	"stats_conns": [],				// connections to StatS, empty to disable: <""|*internal|$rpc_conns_id>
 	"thresholds_conns": [],				// connections to ThresholdS, empty to disable: <""|*internal|$rpc_conns_id>
+	"routes_conns": [],				// connections to RouteS answering the ENUM queries, empty to disable: <""|*internal|$rpc_conns_id>
+	"enum_domains": ["e164.arpa"],			// domains of the NAPTR/SRV queries translated into RouteS calls
+	"enum_service": "E2U+sip",			// service of the NAPTR records built out of routes
 	"timezone": "",					// timezone of the events if not specified  <UTC|Local|$IANA_TZ_DB>
 	"request_processors": []			// request processors to be applied to DNS messages
 },
@@ -969,6 +1002,7 @@ const CGRATES_CFG_JSON = `
 "thresholds": {					// ThresholdS
 	"enabled": false,			// starts ThresholdS service: <true|false>.
 	"store_interval": "",			// dump cache regularly to dataDB, 0 - dump at start/shutdown: <""|$dur>
+	"alert_interval": "",			// check regularly the alerts for escalation and auto-resolve, empty to disable: <""|$dur>
 	"indexed_selects": true,		// enable profile matching exclusively on indexes
 	"sessions_conns":[],			// connections to SessionS <*internal|$rpc_conns_id>
 	"apiers_conns":[],				// connections to ApierS <*internal|$rpc_conns_id>
@@ -1144,15 +1178,19 @@ const CGRATES_CFG_JSON = `
 },
 
 
-"sip_agent": {					// SIP Agents, only used for redirections
+"sip_agent": {					// SIP Agents, answering redirections or relaying the tracked dialogs
 	"enabled": false,			// enables the SIP agent: <true|false>
 	"listen": "127.0.0.1:5060",		// address where to listen for SIP requests <x.y.z.y:1234>
-	"listen_net": "udp",			// network to listen on <udp|tcp|tcp-tls>
-	"sessions_conns": ["*internal"],
+	"listen_net": "udp",			// network to listen on, secured ones using the tls certificates <udp|tcp|tcp-tls|ws|wss>
+	"sessions_conns": ["*internal"],	// connections to SessionS, *birpc_internal needed for disconnects: <*internal|*birpc_internal|$rpc_conns_id>
 	"stats_conns": [],			// connections to StatS, empty to disable: <""|*internal|$rpc_conns_id>
 	"thresholds_conns": [],			// connections to ThresholdS, empty to disable: <""|*internal|$rpc_conns_id>
 	"timezone": "",				// timezone of the events if not specified  <UTC|Local|$IANA_TZ_DB>
 	"retransmission_timer": "1s",		// the duration to wait to receive an ACK before resending the reply
+	"dialog_tracking": false,		// relay the authorized INVITEs to next_hop and track their dialogs until BYE, requires listen_net udp
+	"next_hop": "",				// address where the tracked INVITEs are relayed <x.y.z.y:1234>
+	"dialog_timeout": "3h",			// disconnect the tracked dialogs lasting longer than this, 0 for the maximum of 24h
+	"ws_origins": [],			// host patterns of the other origins allowed on ws and wss, the listen host only if empty: <""|*|example.com|*.example.com>
 	"request_processors": []		// request processors to be applied to SIP messages
 },
 
@@ -1365,6 +1403,18 @@ const CGRATES_CFG_JSON = `
 	    "*allocationID": "",
 	    "*ttl": "72h"
 	}
+},
+
+"frauds": {
+	"enabled": false,		// starts the FraudS service: <true|false>
+	"indexed_selects": true,	// enable profile matching exclusively on indexes
+	//"string_indexed_fields": [],	// query indexes based on these fields for faster processing
+	"prefix_indexed_fields": [],	// query indexes based on these fields for faster processing
+	"suffix_indexed_fields": [],	// query indexes based on these fields for faster processing
+	"exists_indexed_fields": [],	// query indexes based on these fields for faster processing
+	"nested_fields": false,		// determines which field is checked when matching indexed filters(true: all; false: only the one on the first level)
+	"ees_conns": [],		// connections to EEs for the fraud alerts: <""|*internal|$rpc_conns_id>
+	"ees_exporter_ids": []		// exporters the fraud alerts are sent to, all when empty
 }
 
 }`
@@ -70,6 +70,7 @@ const (
 	SentryPeerCfgJson   = "sentrypeer"
 	CoreSCfgJson        = "cores"
 	IPsJSON             = "ips"
+	FraudSJSON          = "frauds"
 )
 
 var (
@@ -77,7 +78,7 @@ var (
 		CACHE_JSN, FilterSjsn, RALS_JSN, CDRS_JSN, ERsJson, SessionSJson, AsteriskAgentJSN, FreeSWITCHAgentJSN, KamailioAgentJSN,
 		DA_JSN, RA_JSN, HttpAgentJson, DNSAgentJson, PrometheusAgentJSON, ATTRIBUTE_JSN, ChargerSCfgJson, RESOURCES_JSON, STATS_JSON, TRENDS_JSON, RANKINGS_JSON,
 		THRESHOLDS_JSON, RouteSJson, MAILER_JSN, SURETAX_JSON, CgrLoaderCfgJson, CgrMigratorCfgJson, DispatcherSJson, JanusAgentJson,
-		AnalyzerCfgJson, ApierS, EEsJson, SIPAgentJson, RegistrarCJson, TemplatesJson, ConfigSJson, APIBanCfgJson, SentryPeerCfgJson, CoreSCfgJson, IPsJSON}
+		AnalyzerCfgJson, ApierS, EEsJson, SIPAgentJson, RegistrarCJson, TemplatesJson, ConfigSJson, APIBanCfgJson, SentryPeerCfgJson, CoreSCfgJson, IPsJSON, FraudSJSON}
 )
 
 // Loads the json config out of io.Reader, eg other sources than file, maybe over http
@@ -635,3 +636,15 @@ func (jc CgrJsonCfg) IPsJsonCfg() (*IPsJsonCfg, error) {
 	}
 	return pa, nil
 }
+
+func (jc CgrJsonCfg) FraudSJsonCfg() (*FraudSJsonCfg, error) {
+	rawCfg, hasKey := jc[FraudSJSON]
+	if !hasKey {
+		return nil, nil
+	}
+	var fc *FraudSJsonCfg
+	if err := json.Unmarshal(*rawCfg, &fc); err != nil {
+		return nil, err
+	}
+	return fc, nil
+}
@@ -52,6 +52,8 @@ func TestDfGeneralJsonCfg(t *testing.T) {
 		Digest_equal:           utils.StringPointer(":"),
 		Rsr_separator:          utils.StringPointer(";"),
 		Max_parallel_conns:     utils.IntPointer(100),
+		Wasm_memory_limit:      utils.IntPointer(16),
+		Wasm_timeout:           utils.StringPointer("100ms"),
 	}
 	dfCgrJSONCfg, err := NewCgrJsonCfgFromBytes([]byte(CGRATES_CFG_JSON))
 	if err != nil {
@@ -115,6 +117,24 @@ func TestCacheJsonCfg(t *testing.T) {
 			utils.CacheTimings: {Limit: utils.IntPointer(-1),
 				Ttl: utils.StringPointer(""), Static_ttl: utils.BoolPointer(false),
 				Precache: utils.BoolPointer(false), Remote: utils.BoolPointer(false), Replicate: utils.BoolPointer(false)},
+			utils.CachePortedNumbers: {Limit: utils.IntPointer(-1),
+				Ttl: utils.StringPointer(""), Static_ttl: utils.BoolPointer(false),
+				Precache: utils.BoolPointer(false), Remote: utils.BoolPointer(false), Replicate: utils.BoolPointer(false)},
+			utils.CacheLookupTables: {Limit: utils.IntPointer(-1),
+				Ttl: utils.StringPointer(""), Static_ttl: utils.BoolPointer(false),
+				Precache: utils.BoolPointer(false), Remote: utils.BoolPointer(false), Replicate: utils.BoolPointer(false)},
+			utils.CacheFraudProfiles: {Limit: utils.IntPointer(-1),
+				Ttl: utils.StringPointer(""), Static_ttl: utils.BoolPointer(false),
+				Precache: utils.BoolPointer(false), Remote: utils.BoolPointer(false), Replicate: utils.BoolPointer(false)},
+			utils.CacheFraudFilterIndexes: {Limit: utils.IntPointer(-1),
+				Ttl: utils.StringPointer(""), Static_ttl: utils.BoolPointer(false),
+				Remote: utils.BoolPointer(false), Replicate: utils.BoolPointer(false)},
+			utils.CacheTaxProfiles: {Limit: utils.IntPointer(-1),
+				Ttl: utils.StringPointer(""), Static_ttl: utils.BoolPointer(false),
+				Precache: utils.BoolPointer(false), Remote: utils.BoolPointer(false), Replicate: utils.BoolPointer(false)},
+			utils.CacheTaxFilterIndexes: {Limit: utils.IntPointer(-1),
+				Ttl: utils.StringPointer(""), Static_ttl: utils.BoolPointer(false),
+				Remote: utils.BoolPointer(false), Replicate: utils.BoolPointer(false)},
 			utils.CacheResourceProfiles: {Limit: utils.IntPointer(-1),
 				Ttl: utils.StringPointer(""), Static_ttl: utils.BoolPointer(false),
 				Precache: utils.BoolPointer(false), Remote: utils.BoolPointer(false), Replicate: utils.BoolPointer(false)},
@@ -217,6 +237,9 @@ func TestCacheJsonCfg(t *testing.T) {
 			utils.CacheRadiusPackets: {Limit: utils.IntPointer(-1),
 				Ttl: utils.StringPointer("3h"), Static_ttl: utils.BoolPointer(false),
 				Remote: utils.BoolPointer(false), Replicate: utils.BoolPointer(false)},
+			utils.CacheRadiusEAPSessions: {Limit: utils.IntPointer(-1),
+				Ttl: utils.StringPointer("1m"), Static_ttl: utils.BoolPointer(false),
+				Remote: utils.BoolPointer(false), Replicate: utils.BoolPointer(false)},
 			utils.CacheRPCResponses: {Limit: utils.IntPointer(0),
 				Ttl: utils.StringPointer("2s"), Static_ttl: utils.BoolPointer(false),
 				Remote: utils.BoolPointer(false), Replicate: utils.BoolPointer(false)},
@@ -620,6 +643,55 @@ func TestDfDataDbJsonCfg(t *testing.T) {
 				Ttl:        utils.StringPointer(utils.EmptyString),
 				Static_ttl: utils.BoolPointer(false),
 			},
+			utils.MetaExchangeRates: {
+				Replicate:  utils.BoolPointer(false),
+				Remote:     utils.BoolPointer(false),
+				Limit:      utils.IntPointer(-1),
+				Ttl:        utils.StringPointer(utils.EmptyString),
+				Static_ttl: utils.BoolPointer(false),
+			},
+			utils.MetaTaxProfiles: {
+				Replicate:  utils.BoolPointer(false),
+				Remote:     utils.BoolPointer(false),
+				Limit:      utils.IntPointer(-1),
+				Ttl:        utils.StringPointer(utils.EmptyString),
+				Static_ttl: utils.BoolPointer(false),
+			},
+			utils.MetaFraudProfiles: {
+				Replicate:  utils.BoolPointer(false),
+				Remote:     utils.BoolPointer(false),
+				Limit:      utils.IntPointer(-1),
+				Ttl:        utils.StringPointer(utils.EmptyString),
+				Static_ttl: utils.BoolPointer(false),
+			},
+			utils.MetaPortedNumbers: {
+				Replicate:  utils.BoolPointer(false),
+				Remote:     utils.BoolPointer(false),
+				Limit:      utils.IntPointer(-1),
+				Ttl:        utils.StringPointer(utils.EmptyString),
+				Static_ttl: utils.BoolPointer(false),
+			},
+			utils.MetaLookupTables: {
+				Replicate:  utils.BoolPointer(false),
+				Remote:     utils.BoolPointer(false),
+				Limit:      utils.IntPointer(-1),
+				Ttl:        utils.StringPointer(utils.EmptyString),
+				Static_ttl: utils.BoolPointer(false),
+			},
+			utils.CacheFraudFilterIndexes: {
+				Replicate:  utils.BoolPointer(false),
+				Remote:     utils.BoolPointer(false),
+				Limit:      utils.IntPointer(-1),
+				Ttl:        utils.StringPointer(utils.EmptyString),
+				Static_ttl: utils.BoolPointer(false),
+			},
+			utils.CacheTaxFilterIndexes: {
+				Replicate:  utils.BoolPointer(false),
+				Remote:     utils.BoolPointer(false),
+				Limit:      utils.IntPointer(-1),
+				Ttl:        utils.StringPointer(utils.EmptyString),
+				Static_ttl: utils.BoolPointer(false),
+			},
 		},
 	}
 	dfCgrJSONCfg, err := NewCgrJsonCfgFromBytes([]byte(CGRATES_CFG_JSON))
@@ -788,6 +860,20 @@ func TestDfStorDBJsonCfg(t *testing.T) {
 				Ttl:        utils.StringPointer(utils.EmptyString),
 				Static_ttl: utils.BoolPointer(false),
 			},
+			utils.CacheBalanceJournalTBL: {
+				Replicate:  utils.BoolPointer(false),
+				Remote:     utils.BoolPointer(false),
+				Limit:      utils.IntPointer(-1),
+				Ttl:        utils.StringPointer(utils.EmptyString),
+				Static_ttl: utils.BoolPointer(false),
+			},
+			utils.CacheInvoicesTBL: {
+				Replicate:  utils.BoolPointer(false),
+				Remote:     utils.BoolPointer(false),
+				Limit:      utils.IntPointer(-1),
+				Ttl:        utils.StringPointer(utils.EmptyString),
+				Static_ttl: utils.BoolPointer(false),
+			},
 			utils.CacheTBLTPActionPlans: {
 				Replicate:  utils.BoolPointer(false),
 				Remote:     utils.BoolPointer(false),
@@ -865,6 +951,7 @@ func TestDfRalsJsonCfg(t *testing.T) {
 		Stats_conns:                &[]string{},
 		Rp_subject_prefix_matching: utils.BoolPointer(false),
 		Remove_expired:             utils.BoolPointer(true),
+		Balance_journal:            utils.BoolPointer(false),
 		Max_computed_usage: &map[string]string{
 			utils.MetaAny:   "189h",
 			utils.MetaVoice: "72h",
@@ -898,6 +985,8 @@ func TestDfSchedulerJsonCfg(t *testing.T) {
 		Stats_conns:             &[]string{},
 		Filters:                 &[]string{},
 		Dynaprepaid_actionplans: &[]string{},
+		Leader_election:         utils.BoolPointer(false),
+		Lease_ttl:               utils.StringPointer("10s"),
 	}
 	dfCgrJSONCfg, err := NewCgrJsonCfgFromBytes([]byte(CGRATES_CFG_JSON))
 	if err != nil {
@@ -916,12 +1005,14 @@ func TestDfCdrsJsonCfg(t *testing.T) {
 		Extra_fields:         &[]string{},
 		Store_cdrs:           utils.BoolPointer(true),
 		Compress_stored_cost: utils.BoolPointer(false),
+		Taxes:                utils.BoolPointer(false),
 		Session_cost_retries: utils.IntPointer(5),
 		Chargers_conns:       &[]string{},
 		Rals_conns:           &[]string{},
 		Attributes_conns:     &[]string{},
 		Thresholds_conns:     &[]string{},
 		Stats_conns:          &[]string{},
+		Frauds_conns:         &[]string{},
 		Online_cdr_exports:   &[]string{},
 		Scheduler_conns:      &[]string{},
 		Ees_conns:            &[]string{},
@@ -946,6 +1037,7 @@ func TestSmgJsonCfg(t *testing.T) {
 		CDRsConns:              &[]string{},
 		IPsConns:               &[]string{},
 		ResourceSConns:         &[]string{},
+		FraudSConns:            &[]string{},
 		ThresholdSConns:        &[]string{},
 		StatSConns:             &[]string{},
 		RouteSConns:            &[]string{},
@@ -1143,6 +1235,13 @@ func TestRadiusAgentJsonCfg(t *testing.T) {
 		CoATemplate:       utils.StringPointer("*coa"),
 		RequestsCacheKey:  utils.StringPointer(""),
 		ClientDaAddresses: map[string]DAClientOptsJson{},
+
+		RequireMessageAuthenticator: utils.BoolPointer(false),
+		EAPPassthrough: &RadiusEAPServerJsonCfg{
+			Address:   utils.StringPointer(""),
+			Transport: utils.StringPointer(utils.UDP),
+			Secret:    utils.StringPointer(""),
+		},
 	}
 	dfCgrJSONCfg, err := NewCgrJsonCfgFromBytes([]byte(CGRATES_CFG_JSON))
 	if err != nil {
@@ -1180,6 +1279,9 @@ func TestDNSAgentJsonCfg(t *testing.T) {
 		SessionSConns:     &[]string{utils.ConcatenatedKey(utils.MetaInternal)},
 		StatSConns:        &[]string{},
 		ThresholdSConns:   &[]string{},
+		RouteSConns:       &[]string{},
+		EnumDomains:       &[]string{"e164.arpa"},
+		EnumService:       utils.StringPointer("E2U+sip"),
 		Timezone:          utils.StringPointer(""),
 		RequestProcessors: &[]*ReqProcessorJsnCfg{},
 	}
@@ -1327,6 +1429,7 @@ func TestDfThresholdSJsonCfg(t *testing.T) {
 	eCfg := &ThresholdSJsonCfg{
 		Enabled:               utils.BoolPointer(false),
 		Indexed_selects:       utils.BoolPointer(true),
+		Alert_interval:        utils.StringPointer(""),
 		Store_interval:        utils.StringPointer(""),
 		Sessions_conns:        []string{},
 		Apiers_conns:          []string{},
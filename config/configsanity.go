@@ -101,6 +101,14 @@ func (cfg *CGRConfig) checkConfigSanity() error {
 				return fmt.Errorf("<%s> connection with id: <%s> not defined", utils.CDRs, connID)
 			}
 		}
+		for _, connID := range cfg.cdrsCfg.FraudSConns {
+			if strings.HasPrefix(connID, utils.MetaInternal) && !cfg.fraudSCfg.Enabled {
+				return fmt.Errorf("<%s> not enabled but requested by <%s> component", utils.FraudS, utils.CDRs)
+			}
+			if _, has := cfg.rpcConns[connID]; !has && !strings.HasPrefix(connID, utils.MetaInternal) {
+				return fmt.Errorf("<%s> connection with id: <%s> not defined", utils.CDRs, connID)
+			}
+		}
 		for _, connID := range cfg.cdrsCfg.ThresholdSConns {
 			if strings.HasPrefix(connID, utils.MetaInternal) && !cfg.thresholdSCfg.Enabled {
 				return fmt.Errorf("<%s> not enabled but requested by <%s> component", utils.ThresholdS, utils.CDRs)
@@ -173,6 +181,14 @@ func (cfg *CGRConfig) checkConfigSanity() error {
 				return fmt.Errorf("<%s> connection with id: <%s> not defined", utils.SessionS, connID)
 			}
 		}
+		for _, connID := range cfg.sessionSCfg.FraudSConns {
+			if strings.HasPrefix(connID, utils.MetaInternal) && !cfg.fraudSCfg.Enabled {
+				return fmt.Errorf("<%s> not enabled but requested by <%s> component", utils.FraudS, utils.SessionS)
+			}
+			if _, has := cfg.rpcConns[connID]; !has && !strings.HasPrefix(connID, utils.MetaInternal) {
+				return fmt.Errorf("<%s> connection with id: <%s> not defined", utils.SessionS, connID)
+			}
+		}
 		for _, connID := range cfg.sessionSCfg.ThresholdSConns {
 			isInternal := strings.HasPrefix(connID, utils.MetaInternal) || strings.HasPrefix(connID, rpcclient.BiRPCInternal)
 			if isInternal && !cfg.thresholdSCfg.Enabled {
@@ -425,6 +441,9 @@ func (cfg *CGRConfig) checkConfigSanity() error {
 			}
 		}
 		for _, req := range cfg.radiusAgentCfg.RequestProcessors {
+			if req.Flags.Has(utils.MetaEAPPassthrough) && cfg.radiusAgentCfg.EAPPassthrough.Address == utils.EmptyString {
+				return fmt.Errorf("<%s> %s flag requires %s address for %s", utils.RadiusAgent, utils.MetaEAPPassthrough, utils.EAPPassthroughCfg, req.ID)
+			}
 			for _, field := range req.RequestFields {
 				if field.Type != utils.MetaNone && field.Path == utils.EmptyString {
 					return fmt.Errorf("<%s> %s for %s at %s", utils.RadiusAgent, utils.NewErrMandatoryIeMissing(utils.Path), req.ID, field.Tag)
@@ -474,6 +493,13 @@ func (cfg *CGRConfig) checkConfigSanity() error {
 			return fmt.Errorf("<%s> no %s connections defined",
 				utils.DNSAgent, utils.SessionS)
 		}
+		for _, lstn := range cfg.dnsAgentCfg.Listeners {
+			if (lstn.Network == utils.HTTPS || strings.HasSuffix(lstn.Network, utils.TLSNoCaps)) &&
+				(cfg.tlsCfg.ServerCerificate == utils.EmptyString || cfg.tlsCfg.ServerKey == utils.EmptyString) {
+				return fmt.Errorf("<%s> %s and %s needed for listener network: <%s>", utils.DNSAgent,
+					utils.ServerCerificateCfg, utils.ServerKeyCfg, lstn.Network)
+			}
+		}
 		for _, connID := range cfg.dnsAgentCfg.SessionSConns {
 			if strings.HasPrefix(connID, utils.MetaInternal) && !cfg.sessionSCfg.Enabled {
 				return fmt.Errorf("<%s> not enabled but requested by <%s> component", utils.SessionS, utils.DNSAgent)
@@ -498,6 +524,14 @@ func (cfg *CGRConfig) checkConfigSanity() error {
 				return fmt.Errorf("<%s> connection with id: <%s> not defined", utils.DNSAgent, connID)
 			}
 		}
+		for _, connID := range cfg.dnsAgentCfg.RouteSConns {
+			if strings.HasPrefix(connID, utils.MetaInternal) && !cfg.routeSCfg.Enabled {
+				return fmt.Errorf("<%s> not enabled but requested by <%s> component", utils.RouteS, utils.DNSAgent)
+			}
+			if _, has := cfg.rpcConns[connID]; !has && !strings.HasPrefix(connID, utils.MetaInternal) {
+				return fmt.Errorf("<%s> connection with id: <%s> not defined", utils.DNSAgent, connID)
+			}
+		}
 		for _, req := range cfg.dnsAgentCfg.RequestProcessors {
 			for _, field := range req.RequestFields {
 				if field.Type != utils.MetaNone && field.Path == utils.EmptyString {
@@ -630,13 +664,28 @@ func (cfg *CGRConfig) checkConfigSanity() error {
 				utils.SIPAgent, utils.SessionS)
 		}
 		for _, connID := range cfg.sipAgentCfg.SessionSConns {
-			if strings.HasPrefix(connID, utils.MetaInternal) && !cfg.sessionSCfg.Enabled {
+			isInternal := strings.HasPrefix(connID, utils.MetaInternal) || strings.HasPrefix(connID, rpcclient.BiRPCInternal)
+			if isInternal && !cfg.sessionSCfg.Enabled {
 				return fmt.Errorf("<%s> not enabled but requested by <%s> component", utils.SessionS, utils.SIPAgent)
 			}
-			if _, has := cfg.rpcConns[connID]; !has && !strings.HasPrefix(connID, utils.MetaInternal) {
+			if _, has := cfg.rpcConns[connID]; !has && !isInternal {
 				return fmt.Errorf("<%s> connection with id: <%s> not defined", utils.SIPAgent, connID)
 			}
 		}
+		if (cfg.sipAgentCfg.ListenNet == utils.TCPTLS || cfg.sipAgentCfg.ListenNet == utils.WSS) &&
+			(cfg.tlsCfg.ServerCerificate == utils.EmptyString || cfg.tlsCfg.ServerKey == utils.EmptyString) {
+			return fmt.Errorf("<%s> %s and %s needed for listen_net: <%s>", utils.SIPAgent,
+				utils.ServerCerificateCfg, utils.ServerKeyCfg, cfg.sipAgentCfg.ListenNet)
+		}
+		if cfg.sipAgentCfg.DialogTracking {
+			if cfg.sipAgentCfg.ListenNet != utils.UDP {
+				return fmt.Errorf("<%s> dialog tracking not supported for listen_net: <%s>",
+					utils.SIPAgent, cfg.sipAgentCfg.ListenNet)
+			}
+			if cfg.sipAgentCfg.NextHop == utils.EmptyString {
+				return fmt.Errorf("<%s> %s needed for dialog tracking", utils.SIPAgent, utils.NextHopCfg)
+			}
+		}
 		for _, connID := range cfg.sipAgentCfg.StatSConns {
 			if strings.HasPrefix(connID, utils.MetaInternal) && !cfg.statsCfg.Enabled {
 				return fmt.Errorf("<%s> not enabled but requested by <%s> component", utils.StatS, utils.SIPAgent)
@@ -757,6 +806,17 @@ func (cfg *CGRConfig) checkConfigSanity() error {
 			}
 		}
 	}
+	// FraudS checks
+	if cfg.fraudSCfg.Enabled {
+		for _, connID := range cfg.fraudSCfg.EEsConns {
+			if strings.HasPrefix(connID, utils.MetaInternal) && !cfg.eesCfg.Enabled {
+				return fmt.Errorf("<%s> not enabled but requested by <%s> component", utils.EEs, utils.FraudS)
+			}
+			if _, has := cfg.rpcConns[connID]; !has && !strings.HasPrefix(connID, utils.MetaInternal) {
+				return fmt.Errorf("<%s> connection with id: <%s> not defined", utils.FraudS, connID)
+			}
+		}
+	}
 	// RouteS checks
 	if cfg.routeSCfg.Enabled {
 		for _, connID := range cfg.routeSCfg.AttributeSConns {
@@ -821,7 +881,9 @@ func (cfg *CGRConfig) checkConfigSanity() error {
 		if err := utils.CheckInLineFilter(cfg.schedulerCfg.Filters); err != nil {
 			return fmt.Errorf("<%s> got %s in %s", utils.SchedulerS, err, utils.Filters)
 		}
-
+		if cfg.schedulerCfg.LeaderElection && cfg.schedulerCfg.LeaseTTL <= 0 {
+			return fmt.Errorf("<%s> the lease_ttl needs to be positive for leader_election", utils.SchedulerS)
+		}
 	}
 	// EventReader sanity checks
 	if cfg.ersCfg.Enabled {
@@ -119,6 +119,18 @@ func TestConfigSanityCDRServer(t *testing.T) {
 	}
 	cfg.cdrsCfg.StatSConns = []string{}
 
+	cfg.cdrsCfg.FraudSConns = []string{utils.MetaInternal}
+	expected = "<FraudS> not enabled but requested by <CDRs> component"
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("Expecting: %+q  received: %+q", expected, err)
+	}
+	cfg.cdrsCfg.FraudSConns = []string{"test"}
+	expected = "<CDRs> connection with id: <test> not defined"
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("Expecting: %+q  received: %+q", expected, err)
+	}
+	cfg.cdrsCfg.FraudSConns = []string{}
+
 	cfg.cdrsCfg.ThresholdSConns = []string{utils.MetaInternal}
 	expected = "<ThresholdS> not enabled but requested by <CDRs> component"
 	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
@@ -801,6 +813,14 @@ func TestConfigSanityDNSAgent(t *testing.T) {
 		t.Errorf("Expecting: %+q  received: %+q", expected, err)
 	}
 
+	cfg.dnsAgentCfg.SessionSConns = []string{"test"}
+	cfg.dnsAgentCfg.Listeners = []DnsListener{{Address: "127.0.0.1:443", Network: utils.HTTPS}}
+	expected = "<DNSAgent> server_certificate and server_key needed for listener network: <https>"
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("Expecting: %+q  received: %+q", expected, err)
+	}
+	cfg.dnsAgentCfg.Listeners = nil
+
 	cfg.dnsAgentCfg.SessionSConns = []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaSessionS)}
 	expected = "<SessionS> not enabled but requested by <DNSAgent> component"
 	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
@@ -822,6 +842,18 @@ func TestConfigSanityDNSAgent(t *testing.T) {
 
 	}
 	cfg.dnsAgentCfg.ThresholdSConns = []string{}
+
+	cfg.dnsAgentCfg.RouteSConns = []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaRoutes)}
+	expected = "<RouteS> not enabled but requested by <DNSAgent> component"
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("Expecting: %+q  received: %+q", expected, err)
+	}
+	cfg.dnsAgentCfg.RouteSConns = []string{"test"}
+	expected = "<DNSAgent> connection with id: <test> not defined"
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("Expecting: %+q  received: %+q", expected, err)
+	}
+	cfg.dnsAgentCfg.RouteSConns = []string{}
 	cfg.sessionSCfg.Enabled = false
 
 	cfg.dnsAgentCfg.SessionSConns = []string{"test"}
@@ -1188,8 +1220,21 @@ func TestConfigSanitySipAgent(t *testing.T) {
 		t.Errorf("Expecting: %+q  received: %+q", expected, err)
 	}
 
-	//Request fields
 	cfg.rpcConns["test"] = nil
+	cfg.sipAgentCfg.DialogTracking = true
+	cfg.sipAgentCfg.ListenNet = utils.TCP
+	expected = "<SIPAgent> dialog tracking not supported for listen_net: <tcp>"
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("Expecting: %+q  received: %+q", expected, err)
+	}
+	cfg.sipAgentCfg.ListenNet = utils.UDP
+	expected = "<SIPAgent> next_hop needed for dialog tracking"
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("Expecting: %+q  received: %+q", expected, err)
+	}
+	cfg.sipAgentCfg.DialogTracking = false
+
+	//Request fields
 	expected = "<SIPAgent> MANDATORY_IE_MISSING: [Path] for cgrates at SessionId"
 	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
 		t.Errorf("Expecting: %+q  received: %+q", expected, err)
@@ -1334,6 +1379,12 @@ func TestConfigSanitySipAgent(t *testing.T) {
 	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
 		t.Errorf("Execting %+q recieved %+q", expected, err)
 	}
+
+	cfg.sipAgentCfg.ListenNet = utils.WSS
+	expected = "<SIPAgent> server_certificate and server_key needed for listen_net: <wss>"
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("Execting %+q recieved %+q", expected, err)
+	}
 }
 
 func TestConfigSanityAttributesCfg(t *testing.T) {
@@ -36,6 +36,9 @@ type DNSAgentCfg struct {
 	SessionSConns     []string
 	StatSConns        []string
 	ThresholdSConns   []string
+	RouteSConns       []string
+	EnumDomains       []string // domains of the ENUM queries answered out of RouteS
+	EnumService       string   // service of the synthesized NAPTR records
 	Timezone          string
 	RequestProcessors []*RequestProcessor
 }
@@ -80,6 +83,15 @@ func (da *DNSAgentCfg) loadFromJSONCfg(jsnCfg *DNSAgentJsonCfg, sep string) (err
 	if jsnCfg.ThresholdSConns != nil {
 		da.ThresholdSConns = tagInternalConns(*jsnCfg.ThresholdSConns, utils.MetaThresholds)
 	}
+	if jsnCfg.RouteSConns != nil {
+		da.RouteSConns = tagInternalConns(*jsnCfg.RouteSConns, utils.MetaRoutes)
+	}
+	if jsnCfg.EnumDomains != nil {
+		da.EnumDomains = slices.Clone(*jsnCfg.EnumDomains)
+	}
+	if jsnCfg.EnumService != nil {
+		da.EnumService = *jsnCfg.EnumService
+	}
 	if jsnCfg.RequestProcessors != nil {
 		for _, reqProcJsn := range *jsnCfg.RequestProcessors {
 			rp := new(RequestProcessor)
@@ -127,6 +139,9 @@ func (da *DNSAgentCfg) AsMapInterface(sep string) map[string]any {
 		utils.TimezoneCfg:          da.Timezone,
 		utils.StatSConnsCfg:        stripInternalConns(da.StatSConns),
 		utils.ThresholdSConnsCfg:   stripInternalConns(da.ThresholdSConns),
+		utils.RouteSConnsCfg:       stripInternalConns(da.RouteSConns),
+		utils.EnumDomainsCfg:       slices.Clone(da.EnumDomains),
+		utils.EnumServiceCfg:       da.EnumService,
 		utils.RequestProcessorsCfg: requestProcessors,
 	}
 	if da.SessionSConns != nil {
@@ -154,6 +169,9 @@ func (da *DNSAgentCfg) Clone() *DNSAgentCfg {
 		SessionSConns:   slices.Clone(da.SessionSConns),
 		StatSConns:      slices.Clone(da.StatSConns),
 		ThresholdSConns: slices.Clone(da.ThresholdSConns),
+		RouteSConns:     slices.Clone(da.RouteSConns),
+		EnumDomains:     slices.Clone(da.EnumDomains),
+		EnumService:     da.EnumService,
 	}
 	if da.RequestProcessors != nil {
 		clone.RequestProcessors = make([]*RequestProcessor, len(da.RequestProcessors))
@@ -36,6 +36,9 @@ func TestDNSAgentCfgloadFromJsonCfg(t *testing.T) {
 		SessionSConns:   &[]string{utils.MetaInternal, "*conn1"},
 		StatSConns:      &[]string{utils.MetaInternal, "*conn1"},
 		ThresholdSConns: &[]string{utils.MetaInternal, "*conn1"},
+		RouteSConns:     &[]string{utils.MetaInternal, "*conn1"},
+		EnumDomains:     &[]string{"e164.arpa", "e164.example.com"},
+		EnumService:     utils.StringPointer("E2U+sip"),
 		Timezone:        utils.StringPointer("UTC"),
 		RequestProcessors: &[]*ReqProcessorJsnCfg{
 			{
@@ -62,6 +65,9 @@ func TestDNSAgentCfgloadFromJsonCfg(t *testing.T) {
 		SessionSConns:   []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaSessionS), "*conn1"},
 		StatSConns:      []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaStats), "*conn1"},
 		ThresholdSConns: []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaThresholds), "*conn1"},
+		RouteSConns:     []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaRoutes), "*conn1"},
+		EnumDomains:     []string{"e164.arpa", "e164.example.com"},
+		EnumService:     "E2U+sip",
 		Timezone:        "UTC",
 		RequestProcessors: []*RequestProcessor{
 			{
@@ -222,6 +228,9 @@ func TestDNSAgentCfgAsMapInterface(t *testing.T) {
 		utils.SessionSConnsCfg:     []string{"*internal"},
 		utils.StatSConnsCfg:        []string{},
 		utils.ThresholdSConnsCfg:   []string{},
+		utils.RouteSConnsCfg:       []string{},
+		utils.EnumDomainsCfg:       []string{"e164.arpa"},
+		utils.EnumServiceCfg:       "E2U+sip",
 		utils.TimezoneCfg:          "",
 		utils.RequestProcessorsCfg: []map[string]any{},
 	}
@@ -245,6 +254,9 @@ func TestDNSAgentCfgAsMapInterface1(t *testing.T) {
 			"sessions_conns": ["*internal:*sessions", "*conn1"],
 			"stats_conns": ["*internal:*stats", "*conn1"],
 			"thresholds_conns": ["*internal:*thresholds", "*conn1"],
+			"routes_conns": ["*internal:*routes", "*conn1"],
+			"enum_domains": ["e164.example.com"],
+			"enum_service": "E2U+pstn:sip",
 			"timezone": "UTC",
 			"request_processors": [
 			{
@@ -281,6 +293,9 @@ func TestDNSAgentCfgAsMapInterface1(t *testing.T) {
 		utils.SessionSConnsCfg:   []string{utils.MetaInternal, "*conn1"},
 		utils.StatSConnsCfg:      []string{utils.MetaInternal, "*conn1"},
 		utils.ThresholdSConnsCfg: []string{utils.MetaInternal, "*conn1"},
+		utils.RouteSConnsCfg:     []string{utils.MetaInternal, "*conn1"},
+		utils.EnumDomainsCfg:     []string{"e164.example.com"},
+		utils.EnumServiceCfg:     "E2U+pstn:sip",
 		utils.TimezoneCfg:        "UTC",
 		utils.RequestProcessorsCfg: []map[string]any{
 			{
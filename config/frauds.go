@@ -0,0 +1,122 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package config
+
+import (
+	"slices"
+
+	"github.com/cgrates/cgrates/utils"
+)
+
+// FraudSCfg represents the configuration of the FraudS module.
+type FraudSCfg struct {
+	Enabled             bool
+	IndexedSelects      bool
+	StringIndexedFields *[]string
+	PrefixIndexedFields *[]string
+	SuffixIndexedFields *[]string
+	ExistsIndexedFields *[]string
+	NestedFields        bool
+	EEsConns            []string
+	EEsExporterIDs      []string
+}
+
+func (c *FraudSCfg) loadFromJSONCfg(jc *FraudSJsonCfg) error {
+	if jc == nil {
+		return nil
+	}
+	if jc.Enabled != nil {
+		c.Enabled = *jc.Enabled
+	}
+	if jc.IndexedSelects != nil {
+		c.IndexedSelects = *jc.IndexedSelects
+	}
+	if jc.StringIndexedFields != nil {
+		sif := slices.Clone(*jc.StringIndexedFields)
+		c.StringIndexedFields = &sif
+	}
+	if jc.PrefixIndexedFields != nil {
+		pif := slices.Clone(*jc.PrefixIndexedFields)
+		c.PrefixIndexedFields = &pif
+	}
+	if jc.SuffixIndexedFields != nil {
+		sif := slices.Clone(*jc.SuffixIndexedFields)
+		c.SuffixIndexedFields = &sif
+	}
+	if jc.ExistsIndexedFields != nil {
+		eif := slices.Clone(*jc.ExistsIndexedFields)
+		c.ExistsIndexedFields = &eif
+	}
+	if jc.NestedFields != nil {
+		c.NestedFields = *jc.NestedFields
+	}
+	if jc.EEsConns != nil {
+		c.EEsConns = tagInternalConns(*jc.EEsConns, utils.MetaEEs)
+	}
+	if jc.EEsExporterIDs != nil {
+		c.EEsExporterIDs = slices.Clone(*jc.EEsExporterIDs)
+	}
+	return nil
+}
+
+// Clone returns a deep copy of FraudSCfg.
+func (c *FraudSCfg) Clone() *FraudSCfg {
+	if c == nil {
+		return nil
+	}
+	clone := &FraudSCfg{
+		Enabled:        c.Enabled,
+		IndexedSelects: c.IndexedSelects,
+		NestedFields:   c.NestedFields,
+		EEsConns:       slices.Clone(c.EEsConns),
+		EEsExporterIDs: slices.Clone(c.EEsExporterIDs),
+	}
+	if c.StringIndexedFields != nil {
+		idx := slices.Clone(*c.StringIndexedFields)
+		clone.StringIndexedFields = &idx
+	}
+	if c.PrefixIndexedFields != nil {
+		idx := slices.Clone(*c.PrefixIndexedFields)
+		clone.PrefixIndexedFields = &idx
+	}
+	if c.SuffixIndexedFields != nil {
+		idx := slices.Clone(*c.SuffixIndexedFields)
+		clone.SuffixIndexedFields = &idx
+	}
+	if c.ExistsIndexedFields != nil {
+		idx := slices.Clone(*c.ExistsIndexedFields)
+		clone.ExistsIndexedFields = &idx
+	}
+	return clone
+}
+
+// AsMapInterface returns the frauds config as a map[string]any.
+func (c FraudSCfg) AsMapInterface() any {
+	return map[string]any{
+		utils.EnabledCfg:             c.Enabled,
+		utils.IndexedSelectsCfg:      c.IndexedSelects,
+		utils.NestedFieldsCfg:        c.NestedFields,
+		utils.StringIndexedFieldsCfg: c.StringIndexedFields,
+		utils.PrefixIndexedFieldsCfg: c.PrefixIndexedFields,
+		utils.SuffixIndexedFieldsCfg: c.SuffixIndexedFields,
+		utils.ExistsIndexedFieldsCfg: c.ExistsIndexedFields,
+		utils.EEsConnsCfg:            stripInternalConns(c.EEsConns),
+		utils.EEsExporterIDsCfg:      slices.Clone(c.EEsExporterIDs),
+	}
+}
@@ -0,0 +1,103 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+package config
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/cgrates/cgrates/utils"
+)
+
+func TestFraudSCfgLoadFromJsonCfg(t *testing.T) {
+	jsonCfg := &FraudSJsonCfg{
+		Enabled:             utils.BoolPointer(true),
+		IndexedSelects:      utils.BoolPointer(false),
+		StringIndexedFields: utils.SliceStringPointer([]string{"*req.Account"}),
+		PrefixIndexedFields: utils.SliceStringPointer([]string{"*req.Destination"}),
+		NestedFields:        utils.BoolPointer(true),
+		EEsConns:            utils.SliceStringPointer([]string{utils.MetaInternal, "*conn1"}),
+		EEsExporterIDs:      utils.SliceStringPointer([]string{"exporter1"}),
+	}
+	expected := &FraudSCfg{
+		Enabled:             true,
+		StringIndexedFields: utils.SliceStringPointer([]string{"*req.Account"}),
+		PrefixIndexedFields: utils.SliceStringPointer([]string{"*req.Destination"}),
+		SuffixIndexedFields: utils.SliceStringPointer([]string{}),
+		ExistsIndexedFields: utils.SliceStringPointer([]string{}),
+		NestedFields:        true,
+		EEsConns:            []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaEEs), "*conn1"},
+		EEsExporterIDs:      []string{"exporter1"},
+	}
+	jsnCfg := NewDefaultCGRConfig()
+	if err := jsnCfg.fraudSCfg.loadFromJSONCfg(jsonCfg); err != nil {
+		t.Error(err)
+	} else if !reflect.DeepEqual(expected, jsnCfg.fraudSCfg) {
+		t.Errorf("Expected %+v, received %+v", utils.ToJSON(expected), utils.ToJSON(jsnCfg.fraudSCfg))
+	}
+}
+
+func TestFraudSCfgAsMapInterface(t *testing.T) {
+	cfgJSONStr := `{
+		"frauds": {
+			"enabled": true,
+			"string_indexed_fields": ["*req.Account"],
+			"ees_conns": ["*internal:*ees", "*conn1"],
+			"ees_exporter_ids": ["exporter1"]
+		}
+	}`
+	eMap := map[string]any{
+		utils.EnabledCfg:             true,
+		utils.IndexedSelectsCfg:      true,
+		utils.NestedFieldsCfg:        false,
+		utils.StringIndexedFieldsCfg: utils.SliceStringPointer([]string{"*req.Account"}),
+		utils.PrefixIndexedFieldsCfg: utils.SliceStringPointer([]string{}),
+		utils.SuffixIndexedFieldsCfg: utils.SliceStringPointer([]string{}),
+		utils.ExistsIndexedFieldsCfg: utils.SliceStringPointer([]string{}),
+		utils.EEsConnsCfg:            []string{utils.MetaInternal, "*conn1"},
+		utils.EEsExporterIDsCfg:      []string{"exporter1"},
+	}
+	if cgrCfg, err := NewCGRConfigFromJSONStringWithDefaults(cfgJSONStr); err != nil {
+		t.Error(err)
+	} else if rcv := cgrCfg.fraudSCfg.AsMapInterface(); !reflect.DeepEqual(eMap, rcv) {
+		t.Errorf("Expected: %+v\n Received: %+v", eMap, rcv)
+	}
+}
+
+func TestFraudSCfgClone(t *testing.T) {
+	ban := &FraudSCfg{
+		Enabled:             true,
+		IndexedSelects:      true,
+		StringIndexedFields: utils.SliceStringPointer([]string{"*req.Account"}),
+		EEsConns:            []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaEEs)},
+		EEsExporterIDs:      []string{"exporter1"},
+	}
+	rcv := ban.Clone()
+	if !reflect.DeepEqual(ban, rcv) {
+		t.Errorf("Expected: %+v\nReceived: %+v", utils.ToJSON(ban), utils.ToJSON(rcv))
+	}
+	if rcv.EEsConns[0] = ""; ban.EEsConns[0] != utils.ConcatenatedKey(utils.MetaInternal, utils.MetaEEs) {
+		t.Errorf("Expected clone to not modify the cloned")
+	}
+	if rcv.EEsExporterIDs[0] = ""; ban.EEsExporterIDs[0] != "exporter1" {
+		t.Errorf("Expected clone to not modify the cloned")
+	}
+	if (*rcv.StringIndexedFields)[0] = ""; (*ban.StringIndexedFields)[0] != "*req.Account" {
+		t.Errorf("Expected clone to not modify the cloned")
+	}
+}
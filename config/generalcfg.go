@@ -50,6 +50,8 @@ type GeneralCfg struct {
 	DigestEqual          string        //
 	RSRSep               string        // separator used to split RSRParser (by default is used ";")
 	MaxParallelConns     int           // the maximum number of connections used by the *parallel strategy
+	WasmMemoryLimit      int           // maximum memory of a *wasm module instance, in MiB
+	WasmTimeout          time.Duration // maximum duration of a *wasm module call, 0 to disable
 }
 
 // loadFromJSONCfg loads General config from JsonCfg
@@ -137,6 +139,14 @@ func (gencfg *GeneralCfg) loadFromJSONCfg(jsnGeneralCfg *GeneralJsonCfg) (err er
 	if jsnGeneralCfg.Max_parallel_conns != nil {
 		gencfg.MaxParallelConns = *jsnGeneralCfg.Max_parallel_conns
 	}
+	if jsnGeneralCfg.Wasm_memory_limit != nil {
+		gencfg.WasmMemoryLimit = *jsnGeneralCfg.Wasm_memory_limit
+	}
+	if jsnGeneralCfg.Wasm_timeout != nil {
+		if gencfg.WasmTimeout, err = utils.ParseDurationWithNanosecs(*jsnGeneralCfg.Wasm_timeout); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
@@ -164,6 +174,8 @@ func (gencfg *GeneralCfg) AsMapInterface() (initialMP map[string]any) {
 		utils.DigestEqualCfg:          gencfg.DigestEqual,
 		utils.RSRSepCfg:               gencfg.RSRSep,
 		utils.MaxParallelConnsCfg:     gencfg.MaxParallelConns,
+		utils.WasmMemoryLimitCfg:      gencfg.WasmMemoryLimit,
+		utils.WasmTimeoutCfg:          "0",
 		utils.LockingTimeoutCfg:       "0",
 		utils.ConnectTimeoutCfg:       "0",
 		utils.ReplyTimeoutCfg:         "0",
@@ -188,6 +200,10 @@ func (gencfg *GeneralCfg) AsMapInterface() (initialMP map[string]any) {
 	if gencfg.CachingDelay != 0 {
 		initialMP[utils.CachingDlayCfg] = gencfg.CachingDelay.String()
 	}
+
+	if gencfg.WasmTimeout != 0 {
+		initialMP[utils.WasmTimeoutCfg] = gencfg.WasmTimeout.String()
+	}
 	return
 }
 
@@ -219,5 +235,7 @@ func (gencfg *GeneralCfg) Clone() *GeneralCfg {
 		DigestEqual:          gencfg.DigestEqual,
 		RSRSep:               gencfg.RSRSep,
 		MaxParallelConns:     gencfg.MaxParallelConns,
+		WasmMemoryLimit:      gencfg.WasmMemoryLimit,
+		WasmTimeout:          gencfg.WasmTimeout,
 	}
 }
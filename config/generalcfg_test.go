@@ -44,6 +44,8 @@ func TestGeneralCfgloadFromJsonCfg(t *testing.T) {
 		Digest_separator:     utils.StringPointer(","),
 		Digest_equal:         utils.StringPointer(":"),
 		Caching_delay:        utils.StringPointer("5s"),
+		Wasm_memory_limit:    utils.IntPointer(32),
+		Wasm_timeout:         utils.StringPointer("1s"),
 	}
 
 	expected := &GeneralCfg{
@@ -68,6 +70,8 @@ func TestGeneralCfgloadFromJsonCfg(t *testing.T) {
 		RSRSep:           ";",
 		DefaultCaching:   utils.MetaReload,
 		CachingDelay:     5 * time.Second,
+		WasmMemoryLimit:  32,
+		WasmTimeout:      time.Second,
 	}
 	jsnCfg := NewDefaultCGRConfig()
 	if err := jsnCfg.generalCfg.loadFromJSONCfg(cfgJSON); err != nil {
@@ -143,7 +147,9 @@ func TestGeneralCfgAsMapInterface(t *testing.T) {
 			"digest_equal": ":",									
 			"rsr_separator": ";",									
 			"max_parallel_conns": 100,
-			"max_reconnect_interval":"1s"
+			"max_reconnect_interval":"1s",
+			"wasm_memory_limit": 32,
+			"wasm_timeout": "1s"
 		},
 	}`
 
@@ -171,6 +177,8 @@ func TestGeneralCfgAsMapInterface(t *testing.T) {
 		utils.DigestEqualCfg:          ":",
 		utils.RSRSepCfg:               ";",
 		utils.MaxParallelConnsCfg:     100,
+		utils.WasmMemoryLimitCfg:      32,
+		utils.WasmTimeoutCfg:          "1s",
 	}
 	if cgrCfg, err := NewCGRConfigFromJSONStringWithDefaults(cfgJSONStr); err != nil {
 		t.Error(err)
@@ -213,6 +221,8 @@ func TestGeneralCfgAsMapInterface1(t *testing.T) {
 		utils.DigestEqualCfg:          ":",
 		utils.RSRSepCfg:               ";",
 		utils.MaxParallelConnsCfg:     100,
+		utils.WasmMemoryLimitCfg:      16,
+		utils.WasmTimeoutCfg:          "100ms",
 	}
 	if cgrCfg, err := NewCGRConfigFromJSONStringWithDefaults(cfgJSONStr); err != nil {
 		t.Error(err)
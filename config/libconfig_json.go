@@ -50,6 +50,8 @@ type GeneralJsonCfg struct {
 	Digest_equal           *string
 	Rsr_separator          *string
 	Max_parallel_conns     *int
+	Wasm_memory_limit      *int
+	Wasm_timeout           *string
 }
 
 // Listen config section
@@ -191,6 +193,7 @@ type RalsJsonCfg struct {
 	Sessions_conns             *[]string
 	Rp_subject_prefix_matching *bool
 	Remove_expired             *bool
+	Balance_journal            *bool
 	Max_computed_usage         *map[string]string
 	Max_increments             *int
 	Fallback_depth             *int
@@ -205,6 +208,8 @@ type SchedulerJsonCfg struct {
 	Stats_conns             *[]string
 	Filters                 *[]string
 	Dynaprepaid_actionplans *[]string
+	Leader_election         *bool
+	Lease_ttl               *string
 }
 
 // Cdrs config section
@@ -213,12 +218,14 @@ type CdrsJsonCfg struct {
 	Extra_fields         *[]string
 	Store_cdrs           *bool
 	Compress_stored_cost *bool
+	Taxes                *bool
 	Session_cost_retries *int
 	Chargers_conns       *[]string
 	Rals_conns           *[]string
 	Attributes_conns     *[]string
 	Thresholds_conns     *[]string
 	Stats_conns          *[]string
+	Frauds_conns         *[]string
 	Online_cdr_exports   *[]string
 	Scheduler_conns      *[]string
 	Ees_conns            *[]string
@@ -424,6 +431,7 @@ type SessionSJsonCfg struct {
 	RALsConns              *[]string          `json:"rals_conns"`
 	IPsConns               *[]string          `json:"ips_conns"`
 	ResourceSConns         *[]string          `json:"resources_conns"`
+	FraudSConns            *[]string          `json:"frauds_conns"`
 	ThresholdSConns        *[]string          `json:"thresholds_conns"`
 	StatSConns             *[]string          `json:"stats_conns"`
 	RouteSConns            *[]string          `json:"routes_conns"`
@@ -618,6 +626,16 @@ type RadiusAgentJsonCfg struct {
 	DMRTemplate        *string                     `json:"dmr_template"`
 	CoATemplate        *string                     `json:"coa_template"`
 	RequestProcessors  *[]*ReqProcessorJsnCfg      `json:"request_processors"`
+
+	RequireMessageAuthenticator *bool                   `json:"require_message_authenticator"`
+	EAPPassthrough              *RadiusEAPServerJsonCfg `json:"eap_passthrough"`
+}
+
+// RadiusEAPServerJsonCfg is the upstream EAP server used by RadiusAgent
+type RadiusEAPServerJsonCfg struct {
+	Address   *string `json:"address"`
+	Transport *string `json:"transport"`
+	Secret    *string `json:"secret"`
 }
 
 // Conecto Agent configuration section
@@ -644,6 +662,9 @@ type DNSAgentJsonCfg struct {
 	SessionSConns     *[]string              `json:"sessions_conns"`
 	StatSConns        *[]string              `json:"stats_conns"`
 	ThresholdSConns   *[]string              `json:"thresholds_conns"`
+	RouteSConns       *[]string              `json:"routes_conns"`
+	EnumDomains       *[]string              `json:"enum_domains"`
+	EnumService       *string                `json:"enum_service"`
 	Timezone          *string                `json:"timezone"`
 	RequestProcessors *[]*ReqProcessorJsnCfg `json:"request_processors"`
 }
@@ -767,6 +788,7 @@ type ThresholdSJsonCfg struct {
 	Enabled               *bool
 	Indexed_selects       *bool
 	Store_interval        *string
+	Alert_interval        *string
 	Sessions_conns        []string
 	Apiers_conns          []string
 	Ees_conns             *[]string
@@ -953,6 +975,10 @@ type SIPAgentJsonCfg struct {
 	ThresholdSConns     *[]string              `json:"thresholds_conns"`
 	Timezone            *string                `json:"timezone"`
 	RetransmissionTimer *string                `json:"retransmission_timer"`
+	DialogTracking      *bool                  `json:"dialog_tracking"`
+	NextHop             *string                `json:"next_hop"`
+	DialogTimeout       *string                `json:"dialog_timeout"`
+	WSOrigins           *[]string              `json:"ws_origins"`
 	RequestProcessors   *[]*ReqProcessorJsnCfg `json:"request_processors"`
 }
 
@@ -1018,6 +1044,20 @@ type IPsJsonCfg struct {
 	Opts                *IPsOptsJson `json:"opts"`
 }
 
+// FraudSJsonCfg holds the unparsed frauds section configuration as found in the
+// config file.
+type FraudSJsonCfg struct {
+	Enabled             *bool     `json:"enabled"`
+	IndexedSelects      *bool     `json:"indexed_selects"`
+	StringIndexedFields *[]string `json:"string_indexed_fields"`
+	PrefixIndexedFields *[]string `json:"prefix_indexed_fields"`
+	SuffixIndexedFields *[]string `json:"suffix_indexed_fields"`
+	ExistsIndexedFields *[]string `json:"exists_indexed_fields"`
+	NestedFields        *bool     `json:"nested_fields"`
+	EEsConns            *[]string `json:"ees_conns"`
+	EEsExporterIDs      *[]string `json:"ees_exporter_ids"`
+}
+
 // tagInternalConns adds subsystem to internal connections.
 func tagInternalConns(conns []string, subsystem string) []string {
 	suffix := utils.ConcatenatedKeySep + subsystem
@@ -46,7 +46,44 @@ type RadiusAgentCfg struct {
 	RequestsCacheKey   RSRParsers
 	DMRTemplate        string
 	CoATemplate        string
-	RequestProcessors  []*RequestProcessor
+
+	// RequireMessageAuthenticator rejects Access-Requests missing a valid
+	// Message-Authenticator, not only the ones carrying EAP-Message.
+	RequireMessageAuthenticator bool
+	EAPPassthrough              RadiusEAPServer
+	RequestProcessors           []*RequestProcessor
+}
+
+// RadiusEAPServer describes the upstream RADIUS server where EAP conversations
+// are relayed for methods not handled locally (ie. EAP-TLS or PEAP).
+type RadiusEAPServer struct {
+	Address   string // host:port of the upstream server, empty disables the pass-through
+	Transport string // udp or tcp
+	Secret    string // shared secret with the upstream server
+}
+
+func (es *RadiusEAPServer) loadFromJSONCfg(jsnCfg *RadiusEAPServerJsonCfg) {
+	if jsnCfg == nil {
+		return
+	}
+	if jsnCfg.Address != nil {
+		es.Address = *jsnCfg.Address
+	}
+	if jsnCfg.Transport != nil {
+		es.Transport = *jsnCfg.Transport
+	}
+	if jsnCfg.Secret != nil {
+		es.Secret = *jsnCfg.Secret
+	}
+}
+
+// AsMapInterface returns the config as a map[string]any
+func (es RadiusEAPServer) AsMapInterface() map[string]any {
+	return map[string]any{
+		utils.AddressCfg:   es.Address,
+		utils.TransportCfg: es.Transport,
+		utils.SecretCfg:    es.Secret,
+	}
 }
 
 func (ra *RadiusAgentCfg) loadFromJSONCfg(jsnCfg *RadiusAgentJsonCfg, separator string) (err error) {
@@ -126,6 +163,10 @@ func (ra *RadiusAgentCfg) loadFromJSONCfg(jsnCfg *RadiusAgentJsonCfg, separator
 	if jsnCfg.CoATemplate != nil {
 		ra.CoATemplate = *jsnCfg.CoATemplate
 	}
+	if jsnCfg.RequireMessageAuthenticator != nil {
+		ra.RequireMessageAuthenticator = *jsnCfg.RequireMessageAuthenticator
+	}
+	ra.EAPPassthrough.loadFromJSONCfg(jsnCfg.EAPPassthrough)
 	if jsnCfg.RequestProcessors != nil {
 		for _, reqProcJsn := range *jsnCfg.RequestProcessors {
 			rp := new(RequestProcessor)
@@ -179,6 +220,9 @@ func (ra *RadiusAgentCfg) AsMapInterface(separator string) map[string]any {
 		utils.StatSConnsCfg:         stripInternalConns(ra.StatSConns),
 		utils.ThresholdSConnsCfg:    stripInternalConns(ra.ThresholdSConns),
 		utils.RequestProcessorsCfg:  requestProcessors,
+
+		utils.RequireMessageAuthenticatorCfg: ra.RequireMessageAuthenticator,
+		utils.EAPPassthroughCfg:              ra.EAPPassthrough.AsMapInterface(),
 	}
 	if ra.SessionSConns != nil {
 		sessionSConns := make([]string, len(ra.SessionSConns))
@@ -219,6 +263,9 @@ func (ra *RadiusAgentCfg) Clone() *RadiusAgentCfg {
 		RequestsCacheKey: ra.RequestsCacheKey.Clone(),
 		DMRTemplate:      ra.DMRTemplate,
 		CoATemplate:      ra.CoATemplate,
+
+		RequireMessageAuthenticator: ra.RequireMessageAuthenticator,
+		EAPPassthrough:              ra.EAPPassthrough,
 	}
 
 	if len(ra.ClientDaAddresses) != 0 {
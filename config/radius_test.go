@@ -38,9 +38,14 @@ func TestRadiusAgentCfgloadFromJsonCfgCase1(t *testing.T) {
 				Acct_Address: utils.StringPointer("127.0.0.1:1813"),
 			},
 		},
-		ClientSecrets:      &map[string]string{utils.MetaDefault: "CGRateS.org"},
-		ClientDictionaries: &map[string][]string{utils.MetaDefault: {"/usr/share/cgrates/radius/dict/"}},
-		SessionSConns:      &[]string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaSessionS)},
+		ClientSecrets:               &map[string]string{utils.MetaDefault: "CGRateS.org"},
+		ClientDictionaries:          &map[string][]string{utils.MetaDefault: {"/usr/share/cgrates/radius/dict/"}},
+		SessionSConns:               &[]string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaSessionS)},
+		RequireMessageAuthenticator: utils.BoolPointer(true),
+		EAPPassthrough: &RadiusEAPServerJsonCfg{
+			Address: utils.StringPointer("127.0.0.1:11812"),
+			Secret:  utils.StringPointer("CGRateS.org"),
+		},
 		RequestProcessors: &[]*ReqProcessorJsnCfg{
 			{
 				ID:             utils.StringPointer("OutboundAUTHDryRun"),
@@ -79,13 +84,19 @@ func TestRadiusAgentCfgloadFromJsonCfgCase1(t *testing.T) {
 				AcctAddr: "127.0.0.1:1813",
 			},
 		},
-		ClientSecrets:      map[string]string{utils.MetaDefault: "CGRateS.org"},
-		ClientDictionaries: map[string][]string{utils.MetaDefault: {"/usr/share/cgrates/radius/dict/"}},
-		SessionSConns:      []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaSessionS)},
-		StatSConns:         []string{},
-		ThresholdSConns:    []string{},
-		DMRTemplate:        "*dmr",
-		CoATemplate:        "*coa",
+		ClientSecrets:               map[string]string{utils.MetaDefault: "CGRateS.org"},
+		ClientDictionaries:          map[string][]string{utils.MetaDefault: {"/usr/share/cgrates/radius/dict/"}},
+		SessionSConns:               []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaSessionS)},
+		StatSConns:                  []string{},
+		ThresholdSConns:             []string{},
+		DMRTemplate:                 "*dmr",
+		CoATemplate:                 "*coa",
+		RequireMessageAuthenticator: true,
+		EAPPassthrough: RadiusEAPServer{
+			Address:   "127.0.0.1:11812",
+			Transport: utils.UDP,
+			Secret:    "CGRateS.org",
+		},
 		ClientDaAddresses: map[string]DAClientOpts{
 			"fsfdsz": {
 				Transport: "http",
@@ -219,6 +230,7 @@ func TestLoadFromJsonCfgInvalidRequestsCacheKey(t *testing.T) {
 		ThresholdSConns:    []string{},
 		DMRTemplate:        "*dmr",
 		CoATemplate:        "*coa",
+		EAPPassthrough:     RadiusEAPServer{Transport: utils.UDP},
 		ClientDaAddresses: map[string]DAClientOpts{
 			"fsfdsz": {
 				Transport: "http",
@@ -271,6 +283,12 @@ func TestRadiusAgentCfgAsMapInterface(t *testing.T) {
 		 "dmr_template": "*dmr",
 		 "coa_template": "*coa",
 		 "requests_cache_key": "~*req.Acc-Session-Id",
+		 "require_message_authenticator": true,
+		 "eap_passthrough": {
+			"address": "127.0.0.1:11812",
+			"transport": "tcp",
+			"secret": "CGRateS.org",
+		 },
          "request_processors": [
 			{
 				"id": "OutboundAUTHDryRun",
@@ -308,12 +326,18 @@ func TestRadiusAgentCfgAsMapInterface(t *testing.T) {
 				utils.FlagsCfg:     *utils.SliceStringPointer([]string{""}),
 			},
 		},
-		utils.SessionSConnsCfg:    []string{rpcclient.BiRPCInternal, "*conn1", "*conn2"},
-		utils.StatSConnsCfg:       []string{rpcclient.InternalRPC, "*conn1", "*conn2"},
-		utils.ThresholdSConnsCfg:  []string{rpcclient.InternalRPC, "*conn1", "*conn2"},
-		utils.DMRTemplateCfg:      "*dmr",
-		utils.CoATemplateCfg:      "*coa",
-		utils.RequestsCacheKeyCfg: "~*req.Acc-Session-Id",
+		utils.SessionSConnsCfg:               []string{rpcclient.BiRPCInternal, "*conn1", "*conn2"},
+		utils.StatSConnsCfg:                  []string{rpcclient.InternalRPC, "*conn1", "*conn2"},
+		utils.ThresholdSConnsCfg:             []string{rpcclient.InternalRPC, "*conn1", "*conn2"},
+		utils.DMRTemplateCfg:                 "*dmr",
+		utils.CoATemplateCfg:                 "*coa",
+		utils.RequestsCacheKeyCfg:            "~*req.Acc-Session-Id",
+		utils.RequireMessageAuthenticatorCfg: true,
+		utils.EAPPassthroughCfg: map[string]any{
+			utils.AddressCfg:   "127.0.0.1:11812",
+			utils.TransportCfg: utils.TCP,
+			utils.SecretCfg:    "CGRateS.org",
+		},
 		utils.RequestProcessorsCfg: []map[string]any{
 			{
 				utils.IDCfg:            "OutboundAUTHDryRun",
@@ -361,6 +385,13 @@ func TestRadiusAgentCfgAsMapInterface1(t *testing.T) {
 		utils.CoATemplateCfg:       "*coa",
 		utils.RequestsCacheKeyCfg:  "",
 		utils.RequestProcessorsCfg: []map[string]any{},
+
+		utils.RequireMessageAuthenticatorCfg: false,
+		utils.EAPPassthroughCfg: map[string]any{
+			utils.AddressCfg:   "",
+			utils.TransportCfg: utils.UDP,
+			utils.SecretCfg:    "",
+		},
 	}
 	if cgrCfg, err := NewCGRConfigFromJSONStringWithDefaults(cfgJSONStr); err != nil {
 		t.Error(err)
@@ -379,10 +410,16 @@ func TestRadiusAgentCfgClone(t *testing.T) {
 				AcctAddr: "127.0.0.1:1813",
 			},
 		},
-		ClientSecrets:      map[string]string{utils.MetaDefault: "CGRateS.org"},
-		ClientDictionaries: map[string][]string{utils.MetaDefault: {"/usr/share/cgrates/radius/dict/"}},
-		ClientDaAddresses:  map[string]DAClientOpts{"allowed.address": {}},
-		SessionSConns:      []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaSessionS), "*conn1"},
+		ClientSecrets:               map[string]string{utils.MetaDefault: "CGRateS.org"},
+		ClientDictionaries:          map[string][]string{utils.MetaDefault: {"/usr/share/cgrates/radius/dict/"}},
+		ClientDaAddresses:           map[string]DAClientOpts{"allowed.address": {}},
+		SessionSConns:               []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaSessionS), "*conn1"},
+		RequireMessageAuthenticator: true,
+		EAPPassthrough: RadiusEAPServer{
+			Address:   "127.0.0.1:11812",
+			Transport: utils.TCP,
+			Secret:    "CGRateS.org",
+		},
 		RequestProcessors: []*RequestProcessor{
 			{
 				ID:            "OutboundAUTHDryRun",
@@ -33,6 +33,7 @@ type RalsCfg struct {
 	SessionSConns           []string
 	RpSubjectPrefixMatching bool // enables prefix matching for the rating profile subject
 	RemoveExpired           bool
+	BalanceJournal          bool // record the balance changes in StorDB
 	MaxComputedUsage        map[string]time.Duration
 	BalanceRatingSubject    map[string]string
 	MaxIncrements           int
@@ -83,6 +84,9 @@ func (ralsCfg *RalsCfg) loadFromJSONCfg(jsnRALsCfg *RalsJsonCfg) (err error) {
 	if jsnRALsCfg.Remove_expired != nil {
 		ralsCfg.RemoveExpired = *jsnRALsCfg.Remove_expired
 	}
+	if jsnRALsCfg.Balance_journal != nil {
+		ralsCfg.BalanceJournal = *jsnRALsCfg.Balance_journal
+	}
 	if jsnRALsCfg.Max_computed_usage != nil {
 		for k, v := range *jsnRALsCfg.Max_computed_usage {
 			if ralsCfg.MaxComputedUsage[k], err = utils.ParseDurationWithNanosecs(v); err != nil {
@@ -111,6 +115,7 @@ func (ralsCfg *RalsCfg) AsMapInterface() (initialMP map[string]any) {
 		utils.EnabledCfg:                 ralsCfg.Enabled,
 		utils.RpSubjectPrefixMatchingCfg: ralsCfg.RpSubjectPrefixMatching,
 		utils.RemoveExpiredCfg:           ralsCfg.RemoveExpired,
+		utils.BalanceJournalCfg:          ralsCfg.BalanceJournal,
 		utils.MaxIncrementsCfg:           ralsCfg.MaxIncrements,
 		utils.FallbackDepthCfg:           ralsCfg.FallbackDepth,
 	}
@@ -170,6 +175,7 @@ func (ralsCfg *RalsCfg) Clone() (cln *RalsCfg) {
 		Enabled:                 ralsCfg.Enabled,
 		RpSubjectPrefixMatching: ralsCfg.RpSubjectPrefixMatching,
 		RemoveExpired:           ralsCfg.RemoveExpired,
+		BalanceJournal:          ralsCfg.BalanceJournal,
 		MaxIncrements:           ralsCfg.MaxIncrements,
 		FallbackDepth:           ralsCfg.FallbackDepth,
 
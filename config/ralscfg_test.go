@@ -110,6 +110,7 @@ func TestRalsCfgAsMapInterfaceCase1(t *testing.T) {
 		utils.SessionSConnsCfg:           []string{utils.MetaInternal, "*conn1"},
 		utils.RpSubjectPrefixMatchingCfg: true,
 		utils.RemoveExpiredCfg:           true,
+		utils.BalanceJournalCfg:          false,
 		utils.MaxComputedUsageCfg: map[string]any{
 			"*any":   "189h0m0s",
 			"*voice": "48h0m0s",
@@ -145,6 +146,7 @@ func TestRalsCfgAsMapInterfaceCase2(t *testing.T) {
 		utils.SessionSConnsCfg:           []string{},
 		utils.RpSubjectPrefixMatchingCfg: false,
 		utils.RemoveExpiredCfg:           true,
+		utils.BalanceJournalCfg:          false,
 		utils.MaxComputedUsageCfg: map[string]any{
 			"*any":   "189h0m0s",
 			"*voice": "72h0m0s",
@@ -19,6 +19,8 @@ along with this program.  If not, see <https://www.gnu.org/licenses/>
 package config
 
 import (
+	"time"
+
 	"github.com/cgrates/cgrates/utils"
 )
 
@@ -30,9 +32,11 @@ type SchedulerCfg struct {
 	StatSConns             []string
 	Filters                []string
 	DynaprepaidActionPlans []string
+	LeaderElection         bool
+	LeaseTTL               time.Duration
 }
 
-func (schdcfg *SchedulerCfg) loadFromJSONCfg(jsnCfg *SchedulerJsonCfg) error {
+func (schdcfg *SchedulerCfg) loadFromJSONCfg(jsnCfg *SchedulerJsonCfg) (err error) {
 	if jsnCfg == nil {
 		return nil
 	}
@@ -77,6 +81,14 @@ func (schdcfg *SchedulerCfg) loadFromJSONCfg(jsnCfg *SchedulerJsonCfg) error {
 		schdcfg.DynaprepaidActionPlans = make([]string, len(*jsnCfg.Dynaprepaid_actionplans))
 		copy(schdcfg.DynaprepaidActionPlans, *jsnCfg.Dynaprepaid_actionplans)
 	}
+	if jsnCfg.Leader_election != nil {
+		schdcfg.LeaderElection = *jsnCfg.Leader_election
+	}
+	if jsnCfg.Lease_ttl != nil {
+		if schdcfg.LeaseTTL, err = utils.ParseDurationWithNanosecs(*jsnCfg.Lease_ttl); err != nil {
+			return
+		}
+	}
 	return nil
 }
 
@@ -86,6 +98,8 @@ func (schdcfg *SchedulerCfg) AsMapInterface() (initialMP map[string]any) {
 		utils.EnabledCfg:                schdcfg.Enabled,
 		utils.FiltersCfg:                schdcfg.Filters,
 		utils.DynaprepaidActionplansCfg: schdcfg.DynaprepaidActionPlans,
+		utils.LeaderElectionCfg:         schdcfg.LeaderElection,
+		utils.LeaseTTLCfg:               schdcfg.LeaseTTL.String(),
 	}
 	if schdcfg.CDRsConns != nil {
 		cdrsConns := make([]string, len(schdcfg.CDRsConns))
@@ -126,7 +140,9 @@ func (schdcfg *SchedulerCfg) Clone() (cln *SchedulerCfg) {
 		return nil
 	}
 	cln = &SchedulerCfg{
-		Enabled: schdcfg.Enabled,
+		Enabled:        schdcfg.Enabled,
+		LeaderElection: schdcfg.LeaderElection,
+		LeaseTTL:       schdcfg.LeaseTTL,
 	}
 	if schdcfg.CDRsConns != nil {
 		cln.CDRsConns = make([]string, len(schdcfg.CDRsConns))
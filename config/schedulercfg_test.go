@@ -20,6 +20,7 @@ package config
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/cgrates/cgrates/utils"
 )
@@ -40,6 +41,7 @@ func TestSchedulerCfgloadFromJsonCfg(t *testing.T) {
 		StatSConns:             []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaStats), "*conn1"},
 		Filters:                []string{"randomFilter"},
 		DynaprepaidActionPlans: []string{"randomPlan"},
+		LeaseTTL:               10 * time.Second,
 	}
 	jsonCfg := NewDefaultCGRConfig()
 	if err := jsonCfg.schedulerCfg.loadFromJSONCfg(cfgJSONS); err != nil {
@@ -60,6 +62,8 @@ func TestSchedulerCfgAsMapInterface(t *testing.T) {
 		utils.StatSConnsCfg:             []string{},
 		utils.FiltersCfg:                []string{},
 		utils.DynaprepaidActionplansCfg: []string{},
+		utils.LeaderElectionCfg:         false,
+		utils.LeaseTTLCfg:               "10s",
 	}
 	if cgrCfg, err := NewCGRConfigFromJSONStringWithDefaults(cfgJSONStr); err != nil {
 		t.Error(err)
@@ -78,6 +82,8 @@ func TestSchedulerCfgAsMapInterface1(t *testing.T) {
 	   "stats_conns": ["*internal", "*conn1"],
        "filters": ["randomFilter"],
 		"dynaprepaid_actionplans":["randomPlan"],
+		"leader_election": true,
+		"lease_ttl": "5s",
     },
 }`
 	eMap := map[string]any{
@@ -87,6 +93,8 @@ func TestSchedulerCfgAsMapInterface1(t *testing.T) {
 		utils.StatSConnsCfg:             []string{utils.MetaInternal, "*conn1"},
 		utils.FiltersCfg:                []string{"randomFilter"},
 		utils.DynaprepaidActionplansCfg: []string{"randomPlan"},
+		utils.LeaderElectionCfg:         true,
+		utils.LeaseTTLCfg:               "5s",
 	}
 	if cgrCfg, err := NewCGRConfigFromJSONStringWithDefaults(cfgJSONStr); err != nil {
 		t.Error(err)
@@ -104,6 +112,8 @@ func TestSchedulerCfgClone(t *testing.T) {
 		StatSConns:             []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaStats), "*conn1"},
 		Filters:                []string{"randomFilter"},
 		DynaprepaidActionPlans: []string{"plan"},
+		LeaderElection:         true,
+		LeaseTTL:               time.Second,
 	}
 	rcv := ban.Clone()
 	if !reflect.DeepEqual(ban, rcv) {
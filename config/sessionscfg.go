@@ -110,6 +110,7 @@ type SessionSCfg struct {
 	RALsConns              []string
 	IPsConns               []string
 	ResourceSConns         []string
+	FraudSConns            []string
 	ThresholdSConns        []string
 	StatSConns             []string
 	RouteSConns            []string
@@ -180,6 +181,9 @@ func (scfg *SessionSCfg) loadFromJSONCfg(jsnCfg *SessionSJsonCfg) (err error) {
 	if jsnCfg.ResourceSConns != nil {
 		scfg.ResourceSConns = tagInternalConns(*jsnCfg.ResourceSConns, utils.MetaResources)
 	}
+	if jsnCfg.FraudSConns != nil {
+		scfg.FraudSConns = tagInternalConns(*jsnCfg.FraudSConns, utils.MetaFrauds)
+	}
 	if jsnCfg.ThresholdSConns != nil {
 		scfg.ThresholdSConns = make([]string, len(*jsnCfg.ThresholdSConns))
 		for idx, attrConn := range *jsnCfg.ThresholdSConns {
@@ -326,6 +330,7 @@ func (scfg *SessionSCfg) AsMapInterface() (initialMP map[string]any) {
 		utils.RALsConnsCfg:              stripInternalConns(scfg.RALsConns),
 		utils.IPsConnsCfg:               stripInternalConns(scfg.IPsConns),
 		utils.ResourceSConnsCfg:         stripInternalConns(scfg.ResourceSConns),
+		utils.FraudSConnsCfg:            stripInternalConns(scfg.FraudSConns),
 		utils.ThresholdSConnsCfg:        stripInternalConns(scfg.ThresholdSConns),
 		utils.StatSConnsCfg:             stripInternalConns(scfg.StatSConns),
 		utils.RouteSConnsCfg:            stripInternalConns(scfg.RouteSConns),
@@ -393,6 +398,7 @@ func (scfg *SessionSCfg) Clone() (cln *SessionSCfg) {
 	cln = &SessionSCfg{
 		Enabled:                scfg.Enabled,
 		IPsConns:               slices.Clone(scfg.IPsConns),
+		FraudSConns:            slices.Clone(scfg.FraudSConns),
 		DebitInterval:          scfg.DebitInterval,
 		StoreSCosts:            scfg.StoreSCosts,
 		SessionTTL:             scfg.SessionTTL,
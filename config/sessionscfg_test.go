@@ -73,6 +73,7 @@ func TestSessionSCfgloadFromJsonCfgCase1(t *testing.T) {
 		RALsConns:           &[]string{utils.MetaInternal, "*conn1"},
 		IPsConns:            &[]string{utils.MetaInternal, "*conn1"},
 		ResourceSConns:      &[]string{utils.MetaInternal, "*conn1"},
+		FraudSConns:         &[]string{utils.MetaInternal, "*conn1"},
 		ThresholdSConns:     &[]string{utils.MetaInternal, "*conn1"},
 		StatSConns:          &[]string{utils.MetaInternal, "*conn1"},
 		RouteSConns:         &[]string{utils.MetaInternal, "*conn1"},
@@ -104,6 +105,7 @@ func TestSessionSCfgloadFromJsonCfgCase1(t *testing.T) {
 		RALsConns:           []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaResponder), "*conn1"},
 		IPsConns:            []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaIPs), "*conn1"},
 		ResourceSConns:      []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaResources), "*conn1"},
+		FraudSConns:         []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaFrauds), "*conn1"},
 		ThresholdSConns:     []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaThresholds), "*conn1"},
 		StatSConns:          []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaStats), "*conn1"},
 		RouteSConns:         []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaRoutes), "*conn1"},
@@ -266,6 +268,7 @@ func TestSessionSCfgloadFromJsonCfgCase10(t *testing.T) {
 		RALsConns:           []string{},
 		IPsConns:            []string{},
 		ResourceSConns:      []string{},
+		FraudSConns:         []string{},
 		ThresholdSConns:     []string{},
 		StatSConns:          []string{},
 		RouteSConns:         []string{},
@@ -379,6 +382,7 @@ func TestSessionSCfgAsMapInterfaceCase1(t *testing.T) {
 		utils.CDRsConnsCfg:              []string{},
 		utils.IPsConnsCfg:               []string{},
 		utils.ResourceSConnsCfg:         []string{},
+		utils.FraudSConnsCfg:            []string{},
 		utils.ThresholdSConnsCfg:        []string{},
 		utils.StatSConnsCfg:             []string{},
 		utils.RouteSConnsCfg:            []string{},
@@ -432,6 +436,7 @@ func TestSessionSCfgAsMapInterfaceCase2(t *testing.T) {
 			"cdrs_conns": ["*internal:*cdrs", "*conn1"],
 			"ips_conns": ["*internal:*ips", "*conn1"],
 			"resources_conns": ["*internal:*resources", "*conn1"],
+			"frauds_conns": ["*internal:*frauds", "*conn1"],
 			"thresholds_conns": ["*internal:*thresholds", "*conn1"],
 			"stats_conns": ["*internal:*stats", "*conn1"],
 			"routes_conns": ["*internal:*routes", "*conn1"],
@@ -462,6 +467,7 @@ func TestSessionSCfgAsMapInterfaceCase2(t *testing.T) {
 		utils.CDRsConnsCfg:              []string{utils.MetaInternal, "*conn1"},
 		utils.IPsConnsCfg:               []string{utils.MetaInternal, "*conn1"},
 		utils.ResourceSConnsCfg:         []string{utils.MetaInternal, "*conn1"},
+		utils.FraudSConnsCfg:            []string{utils.MetaInternal, "*conn1"},
 		utils.ThresholdSConnsCfg:        []string{utils.MetaInternal, "*conn1"},
 		utils.StatSConnsCfg:             []string{utils.MetaInternal, "*conn1"},
 		utils.RouteSConnsCfg:            []string{utils.MetaInternal, "*conn1"},
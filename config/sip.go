@@ -23,6 +23,7 @@ import (
 	"time"
 
 	"github.com/cgrates/cgrates/utils"
+	"github.com/cgrates/rpcclient"
 )
 
 // SIPAgentCfg the config for the SIPAgent
@@ -35,6 +36,10 @@ type SIPAgentCfg struct {
 	ThresholdSConns     []string
 	Timezone            string
 	RetransmissionTimer time.Duration // timeout replies if not reaching back
+	DialogTracking      bool          // relay INVITEs to NextHop and track their dialogs
+	NextHop             string        // address where the tracked INVITEs are relayed
+	DialogTimeout       time.Duration // maximum duration of a tracked dialog, 0 for the maximum of 24h
+	WSOrigins           []string      // host patterns of the origins allowed to open WebSocket connections
 	RequestProcessors   []*RequestProcessor
 }
 
@@ -59,8 +64,9 @@ func (sa *SIPAgentCfg) loadFromJSONCfg(jsnCfg *SIPAgentJsonCfg, sep string) (err
 		for idx, connID := range *jsnCfg.SessionSConns {
 			// if we have the connection internal we change the name so we can have internal rpc for each subsystem
 			sa.SessionSConns[idx] = connID
-			if connID == utils.MetaInternal {
-				sa.SessionSConns[idx] = utils.ConcatenatedKey(utils.MetaInternal, utils.MetaSessionS)
+			if connID == utils.MetaInternal ||
+				connID == rpcclient.BiRPCInternal {
+				sa.SessionSConns[idx] = utils.ConcatenatedKey(connID, utils.MetaSessionS)
 			}
 		}
 	}
@@ -75,6 +81,20 @@ func (sa *SIPAgentCfg) loadFromJSONCfg(jsnCfg *SIPAgentJsonCfg, sep string) (err
 			return err
 		}
 	}
+	if jsnCfg.DialogTracking != nil {
+		sa.DialogTracking = *jsnCfg.DialogTracking
+	}
+	if jsnCfg.NextHop != nil {
+		sa.NextHop = *jsnCfg.NextHop
+	}
+	if jsnCfg.DialogTimeout != nil {
+		if sa.DialogTimeout, err = utils.ParseDurationWithNanosecs(*jsnCfg.DialogTimeout); err != nil {
+			return err
+		}
+	}
+	if jsnCfg.WSOrigins != nil {
+		sa.WSOrigins = slices.Clone(*jsnCfg.WSOrigins)
+	}
 	if jsnCfg.RequestProcessors != nil {
 		for _, reqProcJsn := range *jsnCfg.RequestProcessors {
 			rp := new(RequestProcessor)
@@ -111,6 +131,10 @@ func (sa *SIPAgentCfg) AsMapInterface(separator string) map[string]any {
 		utils.ThresholdSConnsCfg:     stripInternalConns(sa.ThresholdSConns),
 		utils.TimezoneCfg:            sa.Timezone,
 		utils.RetransmissionTimerCfg: sa.RetransmissionTimer,
+		utils.DialogTrackingCfg:      sa.DialogTracking,
+		utils.NextHopCfg:             sa.NextHop,
+		utils.DialogTimeoutCfg:       sa.DialogTimeout,
+		utils.WSOriginsCfg:           slices.Clone(sa.WSOrigins),
 		utils.RequestProcessorsCfg:   requestProcessors,
 	}
 	if sa.SessionSConns != nil {
@@ -119,6 +143,8 @@ func (sa *SIPAgentCfg) AsMapInterface(separator string) map[string]any {
 			sessionSConns[i] = item
 			if item == utils.ConcatenatedKey(utils.MetaInternal, utils.MetaSessionS) {
 				sessionSConns[i] = utils.MetaInternal
+			} else if item == utils.ConcatenatedKey(rpcclient.BiRPCInternal, utils.MetaSessionS) {
+				sessionSConns[i] = rpcclient.BiRPCInternal
 			}
 		}
 		m[utils.SessionSConnsCfg] = sessionSConns
@@ -140,6 +166,10 @@ func (sa *SIPAgentCfg) Clone() *SIPAgentCfg {
 		ThresholdSConns:     slices.Clone(sa.ThresholdSConns),
 		Timezone:            sa.Timezone,
 		RetransmissionTimer: sa.RetransmissionTimer,
+		DialogTracking:      sa.DialogTracking,
+		NextHop:             sa.NextHop,
+		DialogTimeout:       sa.DialogTimeout,
+		WSOrigins:           slices.Clone(sa.WSOrigins),
 	}
 	if sa.RequestProcessors != nil {
 		clone.RequestProcessors = make([]*RequestProcessor, len(sa.RequestProcessors))
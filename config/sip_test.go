@@ -35,6 +35,10 @@ func TestSIPAgentCfgloadFromJsonCfgCase1(t *testing.T) {
 		ThresholdSConns:     &[]string{utils.MetaInternal},
 		Timezone:            utils.StringPointer("local"),
 		RetransmissionTimer: utils.StringPointer("1"),
+		DialogTracking:      utils.BoolPointer(true),
+		NextHop:             utils.StringPointer("127.0.0.1:5070"),
+		DialogTimeout:       utils.StringPointer("1h"),
+		WSOrigins:           &[]string{"*.example.com"},
 		RequestProcessors: &[]*ReqProcessorJsnCfg{
 			{
 				ID:             utils.StringPointer("OutboundAUTHDryRun"),
@@ -62,6 +66,10 @@ func TestSIPAgentCfgloadFromJsonCfgCase1(t *testing.T) {
 		ThresholdSConns:     []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaThresholds)},
 		Timezone:            "local",
 		RetransmissionTimer: 1,
+		DialogTracking:      true,
+		NextHop:             "127.0.0.1:5070",
+		DialogTimeout:       time.Hour,
+		WSOrigins:           []string{"*.example.com"},
 		RequestProcessors: []*RequestProcessor{
 			{
 				ID:            "OutboundAUTHDryRun",
@@ -153,11 +161,15 @@ func TestSIPAgentCfgAsMapInterface(t *testing.T) {
 		"enabled": false,
 		"listen": "127.0.0.1:5060",
 		"listen_net": "udp",
-		"sessions_conns": ["*internal"],
+		"sessions_conns": ["*birpc_internal"],
 		"stats_conns": ["*internal"],
 		"thresholds_conns": ["*internal"],
 		"timezone": "",
         "retransmission_timer": "2s",
+		"dialog_tracking": true,
+		"next_hop": "127.0.0.1:5070",
+		"dialog_timeout": "1h",
+		"ws_origins": ["*.example.com"],
 		"request_processors": [
 		],
 	},
@@ -166,11 +178,15 @@ func TestSIPAgentCfgAsMapInterface(t *testing.T) {
 		utils.EnabledCfg:             false,
 		utils.ListenCfg:              "127.0.0.1:5060",
 		utils.ListenNetCfg:           "udp",
-		utils.SessionSConnsCfg:       []string{"*internal"},
+		utils.SessionSConnsCfg:       []string{"*birpc_internal"},
 		utils.StatSConnsCfg:          []string{"*internal"},
 		utils.ThresholdSConnsCfg:     []string{"*internal"},
 		utils.TimezoneCfg:            "",
 		utils.RetransmissionTimerCfg: 2 * time.Second,
+		utils.DialogTrackingCfg:      true,
+		utils.NextHopCfg:             "127.0.0.1:5070",
+		utils.DialogTimeoutCfg:       time.Hour,
+		utils.WSOriginsCfg:           []string{"*.example.com"},
 		utils.RequestProcessorsCfg:   []map[string]any{},
 	}
 	if cgrCfg, err := NewCGRConfigFromJSONStringWithDefaults(cfgJSONStr); err != nil {
@@ -225,6 +241,10 @@ func TestSIPAgentCfgAsMapInterface1(t *testing.T) {
 		utils.ThresholdSConnsCfg:     []string{"*internal"},
 		utils.TimezoneCfg:            "UTC",
 		utils.RetransmissionTimerCfg: 5 * time.Second,
+		utils.DialogTrackingCfg:      false,
+		utils.NextHopCfg:             "",
+		utils.DialogTimeoutCfg:       3 * time.Hour,
+		utils.WSOriginsCfg:           []string{},
 		utils.RequestProcessorsCfg: []map[string]any{
 			{
 				utils.IDCfg:            "OutboundAUTHDryRun",
@@ -283,6 +303,10 @@ func TestSIPAgentCfgAsMapInterface2(t *testing.T) {
 		utils.ThresholdSConnsCfg:     []string{"*conn1", "*conn2"},
 		utils.TimezoneCfg:            "",
 		utils.RetransmissionTimerCfg: time.Second,
+		utils.DialogTrackingCfg:      false,
+		utils.NextHopCfg:             "",
+		utils.DialogTimeoutCfg:       3 * time.Hour,
+		utils.WSOriginsCfg:           []string{},
 		utils.RequestProcessorsCfg: []map[string]any{
 			{
 				utils.IDCfg:            "Register",
@@ -314,6 +338,10 @@ func TestSIPAgentCfgClone(t *testing.T) {
 		ThresholdSConns:     []string{},
 		Timezone:            "UTC",
 		RetransmissionTimer: 1,
+		DialogTracking:      true,
+		NextHop:             "127.0.0.1:5070",
+		DialogTimeout:       time.Hour,
+		WSOrigins:           []string{"*.example.com"},
 		RequestProcessors: []*RequestProcessor{
 			{
 				ID:            "OutboundAUTHDryRun",
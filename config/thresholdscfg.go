@@ -35,6 +35,7 @@ type ThresholdSCfg struct {
 	Enabled             bool
 	IndexedSelects      bool
 	StoreInterval       time.Duration // Dump regularly from cache into dataDB
+	AlertInterval       time.Duration // Check regularly the alerts for escalation and auto-resolve
 	SessionSConns       []string
 	ApierSConns         []string
 	EEsExporterIDs      []string
@@ -74,6 +75,11 @@ func (t *ThresholdSCfg) loadFromJSONCfg(jsnCfg *ThresholdSJsonCfg) (err error) {
 			return err
 		}
 	}
+	if jsnCfg.Alert_interval != nil {
+		if t.AlertInterval, err = utils.ParseDurationWithNanosecs(*jsnCfg.Alert_interval); err != nil {
+			return err
+		}
+	}
 	if len(jsnCfg.Sessions_conns) != 0 {
 		t.SessionSConns = make([]string, len(jsnCfg.Sessions_conns))
 		for idx, conn := range jsnCfg.Sessions_conns {
@@ -146,11 +152,15 @@ func (t *ThresholdSCfg) AsMapInterface() (initialMP map[string]any) {
 		utils.IndexedSelectsCfg: t.IndexedSelects,
 		utils.NestedFieldsCfg:   t.NestedFields,
 		utils.StoreIntervalCfg:  utils.EmptyString,
+		utils.AlertIntervalCfg:  utils.EmptyString,
 		utils.OptsCfg:           opts,
 	}
 	if t.StoreInterval != 0 {
 		initialMP[utils.StoreIntervalCfg] = t.StoreInterval.String()
 	}
+	if t.AlertInterval != 0 {
+		initialMP[utils.AlertIntervalCfg] = t.AlertInterval.String()
+	}
 	if t.SessionSConns != nil {
 		sessionConns := make([]string, len(t.SessionSConns))
 		for i, item := range t.SessionSConns {
@@ -225,6 +235,7 @@ func (t *ThresholdSCfg) Clone() (cln *ThresholdSCfg) {
 		Enabled:        t.Enabled,
 		IndexedSelects: t.IndexedSelects,
 		StoreInterval:  t.StoreInterval,
+		AlertInterval:  t.AlertInterval,
 		NestedFields:   t.NestedFields,
 		Opts:           t.Opts.Clone(),
 	}
@@ -37,6 +37,7 @@ func TestThresholdSCfgloadFromJsonCfg(t *testing.T) {
 				Enabled:               utils.BoolPointer(true),
 				Indexed_selects:       utils.BoolPointer(true),
 				Store_interval:        utils.StringPointer("2"),
+				Alert_interval:        utils.StringPointer("1m"),
 				String_indexed_fields: &[]string{"*req.prefix"},
 				Prefix_indexed_fields: &[]string{"*req.index1"},
 				Suffix_indexed_fields: &[]string{"*req.index1"},
@@ -51,6 +52,7 @@ func TestThresholdSCfgloadFromJsonCfg(t *testing.T) {
 				Enabled:             true,
 				IndexedSelects:      true,
 				StoreInterval:       2,
+				AlertInterval:       60000000000,
 				StringIndexedFields: &[]string{"*req.prefix"},
 				PrefixIndexedFields: &[]string{"*req.index1"},
 				SuffixIndexedFields: &[]string{"*req.index1"},
@@ -157,6 +159,7 @@ func TestThresholdSCfgAsMapInterface(t *testing.T) {
 			eMap: map[string]any{
 				utils.EnabledCfg:             false,
 				utils.StoreIntervalCfg:       "",
+				utils.AlertIntervalCfg:       "",
 				utils.IndexedSelectsCfg:      true,
 				utils.PrefixIndexedFieldsCfg: []string{},
 				utils.SuffixIndexedFieldsCfg: []string{},
@@ -176,6 +179,7 @@ func TestThresholdSCfgAsMapInterface(t *testing.T) {
 				"thresholds": {								
 					"enabled": true,						
 					"store_interval": "96h",					
+					"alert_interval": "5m",
 					"indexed_selects": false,	
 					"string_indexed_fields": ["*req.string"],
 					"prefix_indexed_fields": ["*req.prefix","*req.indexed","*req.fields"],	
@@ -187,6 +191,7 @@ func TestThresholdSCfgAsMapInterface(t *testing.T) {
 			eMap: map[string]any{
 				utils.EnabledCfg:             true,
 				utils.StoreIntervalCfg:       "96h0m0s",
+				utils.AlertIntervalCfg:       "5m0s",
 				utils.IndexedSelectsCfg:      false,
 				utils.StringIndexedFieldsCfg: []string{"*req.string"},
 				utils.PrefixIndexedFieldsCfg: []string{"*req.prefix", "*req.indexed", "*req.fields"},
@@ -401,6 +406,7 @@ func TestThresholdSCfgAsMapInterfaceSessionSConns(t *testing.T) {
 				utils.IndexedSelectsCfg: true,
 				utils.NestedFieldsCfg:   true,
 				utils.StoreIntervalCfg:  "",
+				utils.AlertIntervalCfg:  "",
 				utils.OptsCfg: map[string]any{
 					utils.MetaProfileIDs:              nil,
 					utils.MetaProfileIgnoreFiltersCfg: false,
@@ -427,6 +433,7 @@ func TestThresholdSCfgAsMapInterfaceSessionSConns(t *testing.T) {
 				utils.IndexedSelectsCfg: true,
 				utils.NestedFieldsCfg:   true,
 				utils.StoreIntervalCfg:  "",
+				utils.AlertIntervalCfg:  "",
 				utils.OptsCfg: map[string]any{
 					utils.MetaProfileIDs:              nil,
 					utils.MetaProfileIgnoreFiltersCfg: false,
@@ -454,6 +461,7 @@ func TestThresholdSCfgAsMapInterfaceSessionSConns(t *testing.T) {
 				utils.IndexedSelectsCfg: true,
 				utils.NestedFieldsCfg:   true,
 				utils.StoreIntervalCfg:  "",
+				utils.AlertIntervalCfg:  "",
 				utils.OptsCfg: map[string]any{
 					utils.MetaProfileIDs:              nil,
 					utils.MetaProfileIgnoreFiltersCfg: false,
@@ -481,6 +489,7 @@ func TestThresholdSCfgAsMapInterfaceSessionSConns(t *testing.T) {
 				utils.IndexedSelectsCfg: true,
 				utils.NestedFieldsCfg:   true,
 				utils.StoreIntervalCfg:  "",
+				utils.AlertIntervalCfg:  "",
 				utils.OptsCfg: map[string]any{
 					utils.MetaProfileIDs:              nil,
 					utils.MetaProfileIgnoreFiltersCfg: false,
@@ -572,6 +581,7 @@ func TestThresholdSCfgAsMapInterfaceEEsConns(t *testing.T) {
 				utils.IndexedSelectsCfg: true,
 				utils.NestedFieldsCfg:   true,
 				utils.StoreIntervalCfg:  "",
+				utils.AlertIntervalCfg:  "",
 				utils.OptsCfg: map[string]any{
 					utils.MetaProfileIDs:              []string(nil),
 					utils.MetaProfileIgnoreFiltersCfg: false,
@@ -596,6 +606,7 @@ func TestThresholdSCfgAsMapInterfaceEEsConns(t *testing.T) {
 				utils.IndexedSelectsCfg: true,
 				utils.NestedFieldsCfg:   true,
 				utils.StoreIntervalCfg:  "",
+				utils.AlertIntervalCfg:  "",
 				utils.OptsCfg: map[string]any{
 					utils.MetaProfileIDs:              []string(nil),
 					utils.MetaProfileIgnoreFiltersCfg: false,
@@ -623,6 +634,7 @@ func TestThresholdSCfgAsMapInterfaceEEsConns(t *testing.T) {
 				utils.IndexedSelectsCfg: true,
 				utils.NestedFieldsCfg:   true,
 				utils.StoreIntervalCfg:  "",
+				utils.AlertIntervalCfg:  "",
 				utils.OptsCfg: map[string]any{
 					utils.MetaProfileIDs:              []string(nil),
 					utils.MetaProfileIgnoreFiltersCfg: false,
@@ -652,6 +664,7 @@ func TestThresholdSCfgAsMapInterfaceEEsConns(t *testing.T) {
 				utils.IndexedSelectsCfg: true,
 				utils.NestedFieldsCfg:   true,
 				utils.StoreIntervalCfg:  "",
+				utils.AlertIntervalCfg:  "",
 				utils.OptsCfg: map[string]any{
 					utils.MetaProfileIDs:              []string(nil),
 					utils.MetaProfileIgnoreFiltersCfg: false,
@@ -681,6 +694,7 @@ func TestThresholdSCfgAsMapInterfaceEEsConns(t *testing.T) {
 				utils.IndexedSelectsCfg: true,
 				utils.NestedFieldsCfg:   true,
 				utils.StoreIntervalCfg:  "",
+				utils.AlertIntervalCfg:  "",
 				utils.OptsCfg: map[string]any{
 					utils.MetaProfileIDs:              []string(nil),
 					utils.MetaProfileIgnoreFiltersCfg: false,
@@ -726,6 +740,7 @@ func TestThresholdSCfgAsMapInterfaceApierSConns(t *testing.T) {
 				utils.IndexedSelectsCfg: true,
 				utils.NestedFieldsCfg:   true,
 				utils.StoreIntervalCfg:  "",
+				utils.AlertIntervalCfg:  "",
 				utils.OptsCfg: map[string]any{
 					utils.MetaProfileIDs:              []string(nil),
 					utils.MetaProfileIgnoreFiltersCfg: false,
@@ -752,6 +767,7 @@ func TestThresholdSCfgAsMapInterfaceApierSConns(t *testing.T) {
 				utils.IndexedSelectsCfg: true,
 				utils.NestedFieldsCfg:   true,
 				utils.StoreIntervalCfg:  "",
+				utils.AlertIntervalCfg:  "",
 				utils.OptsCfg: map[string]any{
 					utils.MetaProfileIDs:              []string(nil),
 					utils.MetaProfileIgnoreFiltersCfg: false,
@@ -779,6 +795,7 @@ func TestThresholdSCfgAsMapInterfaceApierSConns(t *testing.T) {
 				utils.IndexedSelectsCfg: true,
 				utils.NestedFieldsCfg:   true,
 				utils.StoreIntervalCfg:  "",
+				utils.AlertIntervalCfg:  "",
 				utils.OptsCfg: map[string]any{
 					utils.MetaProfileIDs:              []string(nil),
 					utils.MetaProfileIgnoreFiltersCfg: false,
@@ -806,6 +823,7 @@ func TestThresholdSCfgAsMapInterfaceApierSConns(t *testing.T) {
 				utils.IndexedSelectsCfg: true,
 				utils.NestedFieldsCfg:   true,
 				utils.StoreIntervalCfg:  "",
+				utils.AlertIntervalCfg:  "",
 				utils.OptsCfg: map[string]any{
 					utils.MetaProfileIDs:              []string(nil),
 					utils.MetaProfileIgnoreFiltersCfg: false,
@@ -833,6 +851,7 @@ func TestThresholdSCfgAsMapInterfaceApierSConns(t *testing.T) {
 				utils.IndexedSelectsCfg: true,
 				utils.NestedFieldsCfg:   true,
 				utils.StoreIntervalCfg:  "",
+				utils.AlertIntervalCfg:  "",
 				utils.OptsCfg: map[string]any{
 					utils.MetaProfileIDs:              []string(nil),
 					utils.MetaProfileIgnoreFiltersCfg: false,
@@ -57,3 +57,39 @@ DROP INDEX IF EXISTS run_origin_sessionscost_idx;
 CREATE INDEX run_origin_sessionscost_idx ON session_costs (run_id, origin_id);
 DROP INDEX IF EXISTS deleted_at_sessionscost_idx;
 CREATE INDEX deleted_at_sessionscost_idx ON session_costs (deleted_at);
+
+DROP TABLE IF EXISTS balance_journal;
+CREATE TABLE balance_journal (
+  id SERIAL PRIMARY KEY,
+  tenant VARCHAR(64) NOT NULL,
+  account VARCHAR(128) NOT NULL,
+  balance_type VARCHAR(24) NOT NULL,
+  balance_uuid VARCHAR(64) NOT NULL,
+  balance_id VARCHAR(128) NOT NULL,
+  before NUMERIC(20,8) NOT NULL,
+  after NUMERIC(20,8) NOT NULL,
+  delta NUMERIC(20,8) NOT NULL,
+  source VARCHAR(64) NOT NULL,
+  actions_id VARCHAR(64) NOT NULL,
+  action_id VARCHAR(64) NOT NULL,
+  cgrid VARCHAR(40) NOT NULL,
+  created_at TIMESTAMP WITH TIME ZONE
+);
+DROP INDEX IF EXISTS account_time_balancejournal_idx;
+CREATE INDEX account_time_balancejournal_idx ON balance_journal (tenant, account, created_at);
+
+DROP TABLE IF EXISTS invoices;
+CREATE TABLE invoices (
+  id SERIAL PRIMARY KEY,
+  tenant VARCHAR(64) NOT NULL,
+  account VARCHAR(128) NOT NULL,
+  invoice_id VARCHAR(160) NOT NULL,
+  period_start TIMESTAMP WITH TIME ZONE NOT NULL,
+  period_end TIMESTAMP WITH TIME ZONE NOT NULL,
+  final BOOLEAN NOT NULL,
+  content jsonb NOT NULL,
+  created_at TIMESTAMP WITH TIME ZONE,
+  UNIQUE (tenant, account, invoice_id)
+);
+DROP INDEX IF EXISTS account_period_invoices_idx;
+CREATE INDEX account_period_invoices_idx ON invoices (tenant, account, period_start);
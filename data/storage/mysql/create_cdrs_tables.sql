@@ -50,3 +50,39 @@ CREATE TABLE session_costs (
   KEY run_origin_idx (run_id, origin_id),
   KEY deleted_at_idx (deleted_at)
 );
+
+DROP TABLE IF EXISTS balance_journal;
+CREATE TABLE balance_journal (
+  id int(11) NOT NULL AUTO_INCREMENT,
+  tenant varchar(64) NOT NULL,
+  account varchar(128) NOT NULL,
+  balance_type varchar(24) NOT NULL,
+  balance_uuid varchar(64) NOT NULL,
+  balance_id varchar(128) NOT NULL,
+  `before` DECIMAL(20,8) NOT NULL,
+  `after` DECIMAL(20,8) NOT NULL,
+  delta DECIMAL(20,8) NOT NULL,
+  source varchar(64) NOT NULL,
+  actions_id varchar(64) NOT NULL,
+  action_id varchar(64) NOT NULL,
+  cgrid varchar(40) NOT NULL,
+  created_at TIMESTAMP(6) NULL,
+  PRIMARY KEY (`id`),
+  KEY account_time_idx (tenant, account, created_at)
+);
+
+DROP TABLE IF EXISTS invoices;
+CREATE TABLE invoices (
+  id int(11) NOT NULL AUTO_INCREMENT,
+  tenant varchar(64) NOT NULL,
+  account varchar(128) NOT NULL,
+  invoice_id varchar(160) NOT NULL,
+  period_start TIMESTAMP(6) NOT NULL,
+  period_end TIMESTAMP(6) NOT NULL,
+  final BOOLEAN NOT NULL,
+  content MEDIUMTEXT NOT NULL,
+  created_at TIMESTAMP(6) NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY invoice_idx (tenant, account, invoice_id),
+  KEY account_period_idx (tenant, account, period_start)
+);
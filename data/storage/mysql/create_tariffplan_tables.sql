@@ -90,6 +90,7 @@ CREATE TABLE `tp_rating_plans` (
   `destrates_tag` varchar(64) NOT NULL,
   `timing_tag` varchar(64) NOT NULL,
   `weight` DECIMAL(8,2) NOT NULL,
+  `currency` varchar(3) DEFAULT NULL,
   `created_at` TIMESTAMP,
   PRIMARY KEY (`id`),
   KEY `tpid` (`tpid`),
@@ -490,6 +491,8 @@ CREATE TABLE tp_chargers (
   `run_id` varchar(64) NOT NULL,
   `attribute_ids` varchar(64) NOT NULL,
   `weight` decimal(8,2) NOT NULL,
+  `share_type` varchar(64) DEFAULT NULL,
+  `share` decimal(8,2) DEFAULT NULL,
   `created_at` TIMESTAMP,
   PRIMARY KEY (`pk`),
   KEY `tpid` (`tpid`),
@@ -86,6 +86,7 @@ CREATE TABLE tp_rating_plans (
   destrates_tag VARCHAR(64) NOT NULL,
   timing_tag VARCHAR(64) NOT NULL,
   weight NUMERIC(8,2) NOT NULL,
+  currency VARCHAR(3) DEFAULT NULL,
   created_at TIMESTAMP WITH TIME ZONE,
   UNIQUE (tpid, tag, destrates_tag, timing_tag)
 );
@@ -476,6 +477,8 @@ CREATE INDEX tp_routes_unique ON tp_routes  ("tpid",  "tenant", "id",
     "run_id" varchar(64) NOT NULL,
     "attribute_ids" varchar(64) NOT NULL,
     "weight" decimal(8,2) NOT NULL,
+    "share_type" varchar(64) DEFAULT NULL,
+    "share" decimal(8,2) DEFAULT NULL,
     "created_at" TIMESTAMP WITH TIME ZONE
   );
   CREATE INDEX tp_chargers_ids ON tp_chargers (tpid);
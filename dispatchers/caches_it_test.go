@@ -220,6 +220,7 @@ func testDspChcPrecacheStatus(t *testing.T) {
 		utils.MetaSentryPeer:               utils.MetaReady,
 		utils.MetaAPIBan:                   utils.MetaReady,
 		utils.CacheRadiusPackets:           utils.MetaReady,
+		utils.CacheRadiusEAPSessions:       utils.MetaReady,
 		utils.CacheReplicationHosts:        utils.MetaReady,
 		utils.CacheRankingProfiles:         utils.MetaReady,
 		utils.CacheRankings:                utils.MetaReady,
@@ -161,6 +161,8 @@ func (dS *DispatcherService) dispatcherProfilesForEvent(tnt string, ev *utils.CG
 		}
 		err = nil // make sure we ignore the error from *any subsystem matching
 	}
+	expl := engine.ExplanationFromOpts(ev.APIOpts)
+	expl.SetCandidates(prflIDs.AsSlice())
 	dPrlfs = make(engine.DispatcherProfiles, 0, len(prflIDs))
 	for prflID := range prflIDs {
 		prfl, err := dS.dm.GetDispatcherProfile(tnt, prflID, true, true, utils.NonTransactional)
@@ -168,16 +170,21 @@ func (dS *DispatcherService) dispatcherProfilesForEvent(tnt string, ev *utils.CG
 			if err != utils.ErrDSPProfileNotFound {
 				return nil, err
 			}
+			expl.Skip(prflID, engine.ExplainReasonNotFound)
 			continue
 		}
 
-		if ((len(prfl.Subsystems) != 1 || prfl.Subsystems[0] != utils.MetaAny) &&
-			!slices.Contains(prfl.Subsystems, subsys)) ||
-			(prfl.ActivationInterval != nil && ev.Time != nil &&
-				!prfl.ActivationInterval.IsActiveAtTime(*ev.Time)) { // not active
+		if (len(prfl.Subsystems) != 1 || prfl.Subsystems[0] != utils.MetaAny) &&
+			!slices.Contains(prfl.Subsystems, subsys) {
+			expl.Skip(prflID, engine.ExplainReasonSubsystem)
 			continue
 		}
-		if pass, err := dS.fltrS.Pass(tnt, prfl.FilterIDs,
+		if prfl.ActivationInterval != nil && ev.Time != nil &&
+			!prfl.ActivationInterval.IsActiveAtTime(*ev.Time) { // not active
+			expl.Skip(prflID, engine.ExplainReasonInactive)
+			continue
+		}
+		if pass, err := dS.fltrS.ExplainPass(expl, prflID, tnt, prfl.FilterIDs,
 			evNm); err != nil {
 			return nil, err
 		} else if !pass {
@@ -334,6 +341,40 @@ func (dS *DispatcherService) DispatcherSv1GetProfilesForEvent(ctx *context.Conte
 	return
 }
 
+// DispatcherSv1ExplainEvent details why the DispatcherProfiles were matched or not for the event
+func (dS *DispatcherService) DispatcherSv1ExplainEvent(ctx *context.Context, ev *utils.CGREvent,
+	reply *engine.MatchExplanation) (err error) {
+	if ev == nil {
+		return utils.NewErrMandatoryIeMissing(utils.CGREventString)
+	}
+	expl := new(engine.MatchExplanation)
+	ev = ev.Clone()
+	ev.APIOpts[utils.OptsExplain] = expl
+	tnt := ev.Tenant
+	if tnt == utils.EmptyString {
+		tnt = dS.cfg.GeneralCfg().DefaultTenant
+	}
+	dPrfls, err := dS.dispatcherProfilesForEvent(tnt, ev, utils.MapStorage{
+		utils.MetaReq:  ev.Event,
+		utils.MetaOpts: ev.APIOpts,
+		utils.MetaVars: utils.MapStorage{
+			utils.MetaMethod: ev.APIOpts[utils.MetaMethod],
+		},
+	}, utils.IfaceAsString(ev.APIOpts[utils.MetaSubsys]))
+	if err != nil {
+		if err != utils.ErrNotFound {
+			return utils.NewErrDispatcherS(err)
+		}
+		err = nil
+	}
+	for _, dPrfl := range dPrfls {
+		expl.Matched = append(expl.Matched, dPrfl.ID)
+	}
+	expl.Sort()
+	*reply = *expl
+	return
+}
+
 /*
 // V1Apier is a generic way to cover all APIer methods
 func (dS *DispatcherService) V1Apier(ctx *context.Context,apier any, args *utils.MethodParameters, reply *any) (err error) {
@@ -53,8 +53,12 @@ type Account struct {
 	ActionTriggers    ActionTriggers
 	AllowNegative     bool
 	Disabled          bool
+	Holds             map[string]*AccountHold         // credit reservations indexed on their ID
+	Subscriptions     map[string]*AccountSubscription // recurring fees indexed on their ID
 	UpdateTime        time.Time
 	executingTriggers bool
+	balanceSnapshot   *balanceSnapshot   // last snapshot taken for the balance journal
+	simulation        *ActionsSimulation // collects the effects of a dry-run, the account not being persisted
 }
 
 type AccountWithAPIOpts struct {
@@ -226,7 +230,7 @@ func (acc *Account) setBalanceAction(a *Action, fltrS *FilterS) error {
 		a.Balance.ModifyBalance(balance)
 	}
 	// modify if necessary the shared groups here
-	if !found || !previousSharedGroups.Equal(balance.SharedGroups) {
+	if acc.simulation == nil && (!found || !previousSharedGroups.Equal(balance.SharedGroups)) {
 		err := guardian.Guardian.Guard(func() error {
 			i := 0
 			for sgID := range balance.SharedGroups {
@@ -318,6 +322,9 @@ func (acc *Account) debitBalanceAction(a *Action, reset, resetIfNegative bool, f
 		}
 		acc.BalanceMap[balanceType] = append(acc.BalanceMap[balanceType], bClone)
 		err := guardian.Guardian.Guard(func() error {
+			if acc.simulation != nil { // shared groups are not touched by simulations
+				return nil
+			}
 			sgs := make([]string, len(bClone.SharedGroups))
 			i := 0
 			for sgID := range bClone.SharedGroups {
@@ -449,6 +456,8 @@ func (acc *Account) debitCreditBalance(cd *CallDescriptor, count bool, dryRun bo
 	usefulMoneyBalances := acc.getAlldBalancesForPrefix(cd.Destination, cd.Category, utils.MetaMonetary, cd.TimeStart)
 	// initValues map[UUID]float64 and pass them to publish updating initial value
 	initUnitBal, initMoneyBal := balancesValues(usefulUnitBalances), balancesValues(usefulMoneyBalances)
+	restoreHolds := acc.reserveHolds()
+	defer restoreHolds()
 
 	var leftCC *CallCost
 	cc = cd.CreateCallCost()
@@ -569,7 +578,9 @@ func (acc *Account) debitCreditBalance(cd *CallDescriptor, count bool, dryRun bo
 
 		if initialLength == 0 {
 			// this is the first add, debit the connect fee
-			ok, debitedConnectFeeBalance = acc.DebitConnectionFee(cc, usefulMoneyBalances, count, true, fltrS)
+			if ok, debitedConnectFeeBalance, err = acc.DebitConnectionFee(cc, usefulMoneyBalances, count, true, fltrS); err != nil {
+				return nil, err
+			}
 		}
 		// get the default money balance
 		// and go negative on it with the amount still unpaid
@@ -589,9 +600,10 @@ func (acc *Account) debitCreditBalance(cd *CallDescriptor, count bool, dryRun bo
 					Cost:     ts.RateInterval.Rating.ConnectFee,
 					BalanceInfo: &DebitInfo{
 						Monetary: &MonetaryInfo{
-							UUID:  debitedConnectFeeBalance.Uuid,
-							ID:    debitedConnectFeeBalance.ID,
-							Value: debitedConnectFeeBalance.Value,
+							UUID:         debitedConnectFeeBalance.Uuid,
+							ID:           debitedConnectFeeBalance.ID,
+							Value:        debitedConnectFeeBalance.Value,
+							ExchangeRate: cc.connectFeeExRate,
 						},
 						AccountID: acc.ID,
 					},
@@ -608,12 +620,17 @@ func (acc *Account) debitCreditBalance(cd *CallDescriptor, count bool, dryRun bo
 				}
 				cost := increment.Cost
 				defaultBalance := acc.GetDefaultMoneyBalance()
-				defaultBalance.SubtractValue(cost)
+				debitCost, exRate, errCnv := defaultBalance.convertCost(cost, ts.Currency, ts.TimeStart)
+				if errCnv != nil {
+					return nil, errCnv
+				}
+				defaultBalance.SubtractValue(debitCost)
 
 				increment.BalanceInfo.Monetary = &MonetaryInfo{
-					UUID:  defaultBalance.Uuid,
-					ID:    defaultBalance.ID,
-					Value: defaultBalance.Value,
+					UUID:         defaultBalance.Uuid,
+					ID:           defaultBalance.ID,
+					Value:        defaultBalance.Value,
+					ExchangeRate: exRate,
 				}
 				increment.BalanceInfo.AccountID = acc.ID
 				if count {
@@ -631,6 +648,7 @@ func (acc *Account) debitCreditBalance(cd *CallDescriptor, count bool, dryRun bo
 	}
 
 COMMIT:
+	restoreHolds()
 	if !dryRun {
 		// save dirty shared balances
 		usefulMoneyBalances.SaveDirtyBalances(acc, initMoneyBal)
@@ -644,6 +662,8 @@ func (acc *Account) debitMonetaryBalance(cd *CallDescriptor, count bool, dryRun
 	usefulMoneyBalances := acc.getAlldBalancesForPrefix(cd.Destination, cd.Category, utils.MetaMonetary, cd.TimeStart)
 	// initValues map[UUID]float64 and pass them to publish updating initial value
 	initMoneyBal := balancesValues(usefulMoneyBalances)
+	restoreHolds := acc.reserveHolds()
+	defer restoreHolds()
 
 	var leftCC *CallCost
 	cc = cd.CreateCallCost()
@@ -710,7 +730,9 @@ func (acc *Account) debitMonetaryBalance(cd *CallDescriptor, count bool, dryRun
 
 		if initialLength == 0 {
 			// this is the first add, debit the connect fee
-			ok, debitedConnectFeeBalance = acc.DebitConnectionFee(cc, usefulMoneyBalances, count, true, fltrS)
+			if ok, debitedConnectFeeBalance, err = acc.DebitConnectionFee(cc, usefulMoneyBalances, count, true, fltrS); err != nil {
+				return nil, err
+			}
 		}
 		// get the default money balance
 		// and go negative on it with the amount still unpaid
@@ -730,9 +752,10 @@ func (acc *Account) debitMonetaryBalance(cd *CallDescriptor, count bool, dryRun
 					Cost:     ts.RateInterval.Rating.ConnectFee,
 					BalanceInfo: &DebitInfo{
 						Monetary: &MonetaryInfo{
-							UUID:  debitedConnectFeeBalance.Uuid,
-							ID:    debitedConnectFeeBalance.ID,
-							Value: debitedConnectFeeBalance.Value,
+							UUID:         debitedConnectFeeBalance.Uuid,
+							ID:           debitedConnectFeeBalance.ID,
+							Value:        debitedConnectFeeBalance.Value,
+							ExchangeRate: cc.connectFeeExRate,
 						},
 						AccountID: acc.ID,
 					},
@@ -749,12 +772,17 @@ func (acc *Account) debitMonetaryBalance(cd *CallDescriptor, count bool, dryRun
 				}
 				cost := increment.Cost
 				defaultBalance := acc.GetDefaultMoneyBalance()
-				defaultBalance.SubtractValue(cost)
+				debitCost, exRate, errCnv := defaultBalance.convertCost(cost, ts.Currency, ts.TimeStart)
+				if errCnv != nil {
+					return nil, errCnv
+				}
+				defaultBalance.SubtractValue(debitCost)
 
 				increment.BalanceInfo.Monetary = &MonetaryInfo{
-					UUID:  defaultBalance.Uuid,
-					ID:    defaultBalance.ID,
-					Value: defaultBalance.Value,
+					UUID:         defaultBalance.Uuid,
+					ID:           defaultBalance.ID,
+					Value:        defaultBalance.Value,
+					ExchangeRate: exRate,
 				}
 				increment.BalanceInfo.AccountID = acc.ID
 				if count {
@@ -772,6 +800,7 @@ func (acc *Account) debitMonetaryBalance(cd *CallDescriptor, count bool, dryRun
 	}
 
 COMMIT:
+	restoreHolds()
 	if !dryRun {
 		// save dirty shared balances
 		usefulMoneyBalances.SaveDirtyBalances(acc, initMoneyBal)
@@ -972,6 +1001,7 @@ func (acc *Account) CleanExpiredStuff() {
 			acc.ActionTriggers = append(acc.ActionTriggers[:i], acc.ActionTriggers[i+1:]...)
 		}
 	}
+	acc.cleanExpiredHolds(time.Now())
 }
 
 func (acc *Account) allBalancesExpired() bool {
@@ -1043,50 +1073,75 @@ func (acc *Account) Clone() *Account {
 			newAcc.ActionTriggers[key] = actionTrigger.Clone()
 		}
 	}
+	if acc.Holds != nil {
+		newAcc.Holds = make(map[string]*AccountHold, len(acc.Holds))
+		for hID, h := range acc.Holds {
+			newAcc.Holds[hID] = h.Clone()
+		}
+	}
+	if acc.Subscriptions != nil {
+		newAcc.Subscriptions = make(map[string]*AccountSubscription, len(acc.Subscriptions))
+		for sID, sub := range acc.Subscriptions {
+			newAcc.Subscriptions[sID] = sub.Clone()
+		}
+	}
 	return newAcc
 }
 
-// DebitConnectionFee debits the connection fee
-func (acc *Account) DebitConnectionFee(cc *CallCost, ufMoneyBalances Balances, count bool, block bool, fltrS *FilterS) (bool, Balance) {
+// DebitConnectionFee debits the connection fee, failing when the default balance
+// it goes negative on has no exchange rate from the currency of the fee
+func (acc *Account) DebitConnectionFee(cc *CallCost, ufMoneyBalances Balances, count bool, block bool, fltrS *FilterS) (bool, Balance, error) {
 	var debitedBalance Balance
 	if !cc.deductConnectFee {
-		return true, debitedBalance
+		return true, debitedBalance, nil
 	}
 	connectFee := cc.GetConnectFee()
+	ccy := cc.getConnectFeeCurrency()
 	var connectFeePaid bool
 	for _, b := range ufMoneyBalances {
 		if !b.IsActiveAt(cc.GetStartTime()) {
 			continue
 		}
-		if b.GetValue() >= connectFee {
-			b.SubtractValue(connectFee)
+		fee, exRate, err := b.convertCost(connectFee, ccy, cc.GetStartTime())
+		if err != nil {
+			utils.Logger.Warning(fmt.Sprintf("<%s> skipping balance <%s> for the connect fee: %s", utils.RALs, b.ID, err))
+			continue
+		}
+		if b.availableValue() >= fee {
+			b.SubtractValue(fee)
 			// the conect fee is not refundable!
 			if count {
 				acc.countUnits(1, utils.MetaEventConnect, cc, b, fltrS)
-				acc.countUnits(connectFee, utils.MetaMonetary, cc, b, fltrS)
+				acc.countUnits(fee, utils.MetaMonetary, cc, b, fltrS)
 			}
 			connectFeePaid = true
 			debitedBalance = *b
+			cc.connectFeeExRate = exRate
 			break
 		}
 		if b.Blocker && block { // stop here
-			return false, debitedBalance
+			return false, debitedBalance, nil
 		}
 	}
 	// debit connect fee
 	if connectFee > 0 && !connectFeePaid {
+		b := acc.GetDefaultMoneyBalance()
+		fee, exRate, err := b.convertCost(connectFee, ccy, cc.GetStartTime())
+		if err != nil {
+			return false, debitedBalance, err
+		}
 		cc.negativeConnectFee = true
 		// there are no money for the connect fee; go negative
-		b := acc.GetDefaultMoneyBalance()
-		b.SubtractValue(connectFee)
+		b.SubtractValue(fee)
 		debitedBalance = *b
+		cc.connectFeeExRate = exRate
 		// the conect fee is not refundable!
 		if count {
 			acc.countUnits(1, utils.MetaEventConnect, cc, b, fltrS)
-			acc.countUnits(connectFee, utils.MetaMonetary, cc, b, fltrS)
+			acc.countUnits(fee, utils.MetaMonetary, cc, b, fltrS)
 		}
 	}
-	return true, debitedBalance
+	return true, debitedBalance, nil
 }
 
 // SimulateConnectionFee simulates DebitConnectionFee without debiting
@@ -1096,19 +1151,25 @@ func (acc *Account) SimulateConnectionFee(cc *CallCost, ufMoneyBalances Balances
 		return true, debitedBalance
 	}
 	connectFee := cc.GetConnectFee()
+	ccy := cc.getConnectFeeCurrency()
 	var connectFeePaid bool
 	for _, b := range ufMoneyBalances {
 		if !b.IsActiveAt(cc.GetStartTime()) {
 			continue
 		}
-		if b.GetValue() >= connectFee {
+		fee, exRate, err := b.convertCost(connectFee, ccy, cc.GetStartTime())
+		if err != nil {
+			continue
+		}
+		if b.availableValue() >= fee {
 			// the conect fee is not refundable!
 			if count {
 				acc.countUnits(1, utils.MetaEventConnect, cc, b, fltrS)
-				acc.countUnits(connectFee, utils.MetaMonetary, cc, b, fltrS)
+				acc.countUnits(fee, utils.MetaMonetary, cc, b, fltrS)
 			}
 			connectFeePaid = true
 			debitedBalance = *b
+			cc.connectFeeExRate = exRate
 			break
 		}
 		if b.Blocker && block { // stop here
@@ -1298,6 +1359,7 @@ func (acc *Account) AsAccountSummary() *AccountSummary {
 			ad.BalanceSummaries = append(ad.BalanceSummaries, balance.AsBalanceSummary(balanceType))
 		}
 	}
+	ad.HoldSummaries = acc.holdSummaries()
 	return ad
 }
 
@@ -1316,6 +1378,10 @@ func (acc *Account) Publish(initBal map[string]float64) {
 			utils.MetaEventType: utils.AccountUpdate,
 		},
 	}
+	if acc.simulation != nil {
+		acc.simulation.Events = append(acc.simulation.Events, cgrEv)
+		return
+	}
 	if len(config.CgrConfig().RalsCfg().ThresholdSConns) != 0 {
 		var tIDs []string
 		if err := connMgr.Call(context.TODO(), config.CgrConfig().RalsCfg().ThresholdSConns,
@@ -1357,6 +1423,7 @@ type AccountSummary struct {
 	Tenant           string
 	AccountID        string
 	BalanceSummaries BalanceSummaries
+	HoldSummaries    []*HoldSummary `json:",omitempty"` // available versus total for the balance types with holds
 	AllowNegative    bool
 	Disabled         bool
 }
@@ -1375,6 +1442,13 @@ func (as *AccountSummary) Clone() (cln *AccountSummary) {
 			*cln.BalanceSummaries[i] = *bs
 		}
 	}
+	if as.HoldSummaries != nil {
+		cln.HoldSummaries = make([]*HoldSummary, len(as.HoldSummaries))
+		for i, hs := range as.HoldSummaries {
+			cln.HoldSummaries[i] = new(HoldSummary)
+			*cln.HoldSummaries[i] = *hs
+		}
+	}
 	return
 }
 
@@ -1464,6 +1538,19 @@ func (as *AccountSummary) FieldAsInterface(fldPath []string) (val any, err error
 			}
 		}
 		return nil, utils.ErrNotFound
+	case utils.HoldSummaries:
+		if len(fldPath) == 1 {
+			return as.HoldSummaries, nil
+		}
+		for _, hs := range as.HoldSummaries {
+			if hs.Type == fldPath[1] {
+				if len(fldPath) == 2 {
+					return hs, nil
+				}
+				return hs.FieldAsInterface(fldPath[2:])
+			}
+		}
+		return nil, utils.ErrNotFound
 	case utils.AllowNegative:
 		if len(fldPath) != 1 {
 			return nil, utils.ErrNotFound
@@ -1491,14 +1578,18 @@ func (as *AccountSummary) String() string {
 	return utils.ToIJSON(as)
 }
 
-func (as *AccountSummary) AsMapInterface() map[string]any {
-	return map[string]any{
+func (as *AccountSummary) AsMapInterface() (mp map[string]any) {
+	mp = map[string]any{
 		utils.Tenant:           as.Tenant,
 		utils.AccountID:        as.AccountID,
 		utils.AllowNegative:    as.AllowNegative,
 		utils.Disabled:         as.Disabled,
 		utils.BalanceSummaries: as.BalanceSummaries,
 	}
+	if len(as.HoldSummaries) != 0 {
+		mp[utils.HoldSummaries] = as.HoldSummaries
+	}
+	return
 }
 
 // processAccountSummaryField ensures accSummary is an AccountSummary and calls FieldAsInterface on it.
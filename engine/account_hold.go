@@ -0,0 +1,268 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/cgrates/cgrates/utils"
+)
+
+// AccountHold is a credit reservation on the account (similar to a card pre-authorization):
+// it reduces the available value of the balance type without debiting it
+type AccountHold struct {
+	ID          string // reference of the hold
+	BalanceType string
+	Value       float64
+	ExpiryTime  time.Time // zero for holds never expiring
+}
+
+// IsExpiredAt checks if the hold is expired at the given time
+func (h *AccountHold) IsExpiredAt(t time.Time) bool {
+	return !h.ExpiryTime.IsZero() && !h.ExpiryTime.After(t)
+}
+
+// Clone creates a copy of the hold
+func (h *AccountHold) Clone() *AccountHold {
+	if h == nil {
+		return nil
+	}
+	cln := *h
+	return &cln
+}
+
+// HoldSummary shows the total and the available value of a balance type with holds on it
+type HoldSummary struct {
+	Type      string
+	Total     float64
+	Held      float64
+	Available float64
+}
+
+// FieldAsInterface func to help AccountSummary FieldAsInterface
+func (hs *HoldSummary) FieldAsInterface(fldPath []string) (val any, err error) {
+	if hs == nil || len(fldPath) != 1 {
+		return nil, utils.ErrNotFound
+	}
+	switch fldPath[0] {
+	default:
+		return nil, fmt.Errorf("unsupported field prefix: <%s>", fldPath[0])
+	case utils.Type:
+		return hs.Type, nil
+	case utils.Total:
+		return hs.Total, nil
+	case utils.Held:
+		return hs.Held, nil
+	case utils.Available:
+		return hs.Available, nil
+	}
+}
+
+// heldValue returns the value held out of the balance type by the active holds
+func (acc *Account) heldValue(blcType string) (held float64) {
+	now := time.Now()
+	for _, h := range acc.Holds {
+		if h.BalanceType == blcType && !h.IsExpiredAt(now) {
+			held += h.Value
+		}
+	}
+	return
+}
+
+// AvailableValue returns the value of the balance type which is not held
+func (acc *Account) AvailableValue(blcType string) float64 {
+	return acc.BalanceMap[blcType].GetTotalValue() - acc.heldValue(blcType)
+}
+
+// cleanExpiredHolds removes the holds expired at the given time
+func (acc *Account) cleanExpiredHolds(t time.Time) {
+	for hID, h := range acc.Holds {
+		if h.IsExpiredAt(t) {
+			delete(acc.Holds, hID)
+		}
+	}
+	if len(acc.Holds) == 0 {
+		acc.Holds = nil // leave it nil if empty
+	}
+}
+
+// holdBalances returns the active balances of the type in the order they are debited
+func (acc *Account) holdBalances(blcType string) (blcs Balances) {
+	now := time.Now()
+	for _, b := range acc.BalanceMap[blcType] {
+		if !b.IsExpiredAt(now) && b.IsActive() {
+			blcs = append(blcs, b)
+		}
+	}
+	blcs.Sort()
+	return
+}
+
+// CreateHold reserves value out of the balance type for the ttl (zero for no expiry)
+func (acc *Account) CreateHold(holdID, blcType string, value float64, ttl time.Duration) (err error) {
+	if value < 0 {
+		return utils.ErrNegative
+	}
+	now := time.Now()
+	acc.cleanExpiredHolds(now)
+	if _, has := acc.Holds[holdID]; has {
+		return utils.ErrExists
+	}
+	if !acc.AllowNegative && acc.AvailableValue(blcType) < value {
+		return utils.ErrInsufficientCredit
+	}
+	h := &AccountHold{
+		ID:          holdID,
+		BalanceType: blcType,
+		Value:       value,
+	}
+	if ttl > 0 {
+		h.ExpiryTime = now.Add(ttl)
+	}
+	if acc.Holds == nil {
+		acc.Holds = make(map[string]*AccountHold)
+	}
+	acc.Holds[holdID] = h
+	return
+}
+
+// CaptureHold debits value out of the hold, releasing the rest of it
+func (acc *Account) CaptureHold(holdID string, value float64, fltrS *FilterS) (err error) {
+	acc.cleanExpiredHolds(time.Now())
+	h, has := acc.Holds[holdID]
+	if !has {
+		return utils.ErrNotFound
+	}
+	if value < 0 {
+		return utils.ErrNegative
+	}
+	if value > h.Value {
+		return fmt.Errorf("capture value: %v over the held one: %v", value, h.Value)
+	}
+	blcs := acc.holdBalances(h.BalanceType)
+	if len(blcs) == 0 {
+		return utils.ErrNotFound
+	}
+	delete(acc.Holds, holdID)
+	if len(acc.Holds) == 0 {
+		acc.Holds = nil
+	}
+	for i, b := range blcs {
+		debit := value
+		if i != len(blcs)-1 { // last balance covers what is left
+			debit = min(value, max(b.GetValue(), 0))
+		}
+		if debit == 0 {
+			continue
+		}
+		// same as the *debit action so the counters and the triggers are updated
+		if err = acc.debitBalanceAction(&Action{
+			Id:         holdID,
+			ActionType: utils.MetaDebit,
+			Balance: &BalanceFilter{
+				Uuid:  utils.StringPointer(b.Uuid),
+				Type:  utils.StringPointer(h.BalanceType),
+				Value: &utils.ValueFormula{Static: debit},
+			},
+		}, false, false, fltrS); err != nil {
+			return
+		}
+		if value -= debit; value == 0 {
+			break
+		}
+	}
+	return
+}
+
+// ReleaseHold removes the hold without debiting anything
+func (acc *Account) ReleaseHold(holdID string) (err error) {
+	acc.cleanExpiredHolds(time.Now())
+	if _, has := acc.Holds[holdID]; !has {
+		return utils.ErrNotFound
+	}
+	delete(acc.Holds, holdID)
+	if len(acc.Holds) == 0 {
+		acc.Holds = nil
+	}
+	return
+}
+
+// reserveHolds marks the held values on the balances so the debits cannot consume them,
+// without changing their Value, returning the function which removes the marks
+func (acc *Account) reserveHolds() (restore func()) {
+	reserved := make(map[*Balance]float64)
+	restore = func() {
+		for b, val := range reserved {
+			b.held -= val
+		}
+		clear(reserved) // only restore once
+	}
+	if len(acc.Holds) == 0 {
+		return
+	}
+	held := make(map[string]float64)
+	now := time.Now()
+	for _, h := range acc.Holds {
+		if !h.IsExpiredAt(now) {
+			held[h.BalanceType] += h.Value
+		}
+	}
+	for blcType, val := range held {
+		blcs := acc.holdBalances(blcType)
+		for i, b := range blcs {
+			rsv := val
+			if i != len(blcs)-1 { // last balance covers what is left
+				rsv = min(val, max(b.Value, 0))
+			}
+			if rsv == 0 {
+				continue
+			}
+			b.held += rsv
+			reserved[b] += rsv
+			if val -= rsv; val == 0 {
+				break
+			}
+		}
+	}
+	return
+}
+
+// holdSummaries returns the summaries of the balance types with active holds
+func (acc *Account) holdSummaries() (hss []*HoldSummary) {
+	now := time.Now()
+	var blcTypes []string
+	for _, h := range acc.Holds {
+		if !h.IsExpiredAt(now) && !slices.Contains(blcTypes, h.BalanceType) {
+			blcTypes = append(blcTypes, h.BalanceType)
+		}
+	}
+	slices.Sort(blcTypes)
+	for _, blcType := range blcTypes {
+		hs := &HoldSummary{
+			Type:  blcType,
+			Total: acc.BalanceMap[blcType].GetTotalValue(),
+			Held:  acc.heldValue(blcType),
+		}
+		hs.Available = hs.Total - hs.Held
+		hss = append(hss, hs)
+	}
+	return
+}
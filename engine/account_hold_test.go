@@ -0,0 +1,140 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cgrates/cgrates/utils"
+)
+
+func TestAccountHolds(t *testing.T) {
+	acc := &Account{
+		ID: "cgrates.org:1001",
+		BalanceMap: map[string]Balances{
+			utils.MetaMonetary: {
+				&Balance{Uuid: "bonus", ID: "Bonus", Value: 3, Weight: 20},
+				&Balance{Uuid: "main", ID: utils.MetaDefault, Value: 7, Weight: 10},
+			},
+		},
+	}
+	if err := acc.CreateHold("SMS1", utils.MetaMonetary, 5, time.Hour); err != nil {
+		t.Fatal(err)
+	}
+	if err := acc.CreateHold("SMS1", utils.MetaMonetary, 1, 0); err != utils.ErrExists {
+		t.Errorf("expected %v, received %v", utils.ErrExists, err)
+	}
+	if err := acc.CreateHold("SMS2", utils.MetaMonetary, 6, 0); err != utils.ErrInsufficientCredit {
+		t.Errorf("expected %v, received %v", utils.ErrInsufficientCredit, err)
+	}
+	if err := acc.CreateHold("SMS2", utils.MetaMonetary, 4, 0); err != nil {
+		t.Fatal(err)
+	}
+	if av := acc.AvailableValue(utils.MetaMonetary); av != 1 {
+		t.Errorf("expected 1 available, received %v", av)
+	}
+	exp := []*HoldSummary{{Type: utils.MetaMonetary, Total: 10, Held: 9, Available: 1}}
+	if rcv := acc.AsAccountSummary().HoldSummaries; !reflect.DeepEqual(exp, rcv) {
+		t.Errorf("expected %s, received %s", utils.ToJSON(exp), utils.ToJSON(rcv))
+	}
+
+	// the debits only see the value which is not held, the balance values are not changed
+	restore := acc.reserveHolds()
+	if bonus, main := acc.BalanceMap[utils.MetaMonetary][0].availableValue(),
+		acc.BalanceMap[utils.MetaMonetary][1].availableValue(); bonus != 0 || main != 1 {
+		t.Errorf("unexpected available values: %v, %v", bonus, main)
+	}
+	if bonus, main := acc.BalanceMap[utils.MetaMonetary][0].Value,
+		acc.BalanceMap[utils.MetaMonetary][1].Value; bonus != 3 || main != 7 {
+		t.Errorf("unexpected reserved balances: %v, %v", bonus, main)
+	}
+	cc := &CallCost{deductConnectFee: true, Timespans: TimeSpans{{
+		RateInterval: &RateInterval{Rating: &RIRate{ConnectFee: 1}}}}}
+	if paid, dbtd, err := acc.DebitConnectionFee(cc, acc.BalanceMap[utils.MetaMonetary],
+		false, true, nil); err != nil || !paid || dbtd.Uuid != "main" || cc.negativeConnectFee {
+		t.Errorf("unexpected connect fee debit out of %q, paid: %v, err: %v", dbtd.Uuid, paid, err)
+	}
+	restore()
+	restore()
+	if bonus, main := acc.BalanceMap[utils.MetaMonetary][0],
+		acc.BalanceMap[utils.MetaMonetary][1]; bonus.Value != 3 || main.Value != 6 ||
+		bonus.held != 0 || main.held != 0 {
+		t.Errorf("unexpected restored balances: %s, %s", utils.ToJSON(bonus), utils.ToJSON(main))
+	}
+
+	// debits the same as the *debit action, executing the triggers
+	if err := dm.SetActions("ACT_HOLD_LOW", Actions{{Id: "low", ActionType: utils.MetaLog}}); err != nil {
+		t.Fatal(err)
+	}
+	acc.ActionTriggers = ActionTriggers{{
+		ID:             "HOLD_LOW",
+		UniqueID:       "hold_low",
+		ThresholdType:  utils.TriggerMinBalance,
+		ThresholdValue: 1,
+		Balance:        &BalanceFilter{Type: utils.StringPointer(utils.MetaMonetary)},
+		ActionsID:      "ACT_HOLD_LOW",
+	}}
+
+	// capture part of the hold, the rest is released
+	if err := acc.CaptureHold("SMS1", 6, nil); err == nil {
+		t.Error("expected error capturing over the held value")
+	}
+	if err := acc.CaptureHold("SMS1", 4, nil); err != nil {
+		t.Fatal(err)
+	}
+	if bonus, main := acc.BalanceMap[utils.MetaMonetary][0].Value,
+		acc.BalanceMap[utils.MetaMonetary][1].Value; bonus != 0 || main != 5 {
+		t.Errorf("unexpected captured balances: %v, %v", bonus, main)
+	}
+	if _, has := acc.Holds["SMS1"]; has {
+		t.Error("captured hold not removed")
+	}
+	if !acc.ActionTriggers[0].Executed {
+		t.Error("trigger not executed on capture")
+	}
+	if err := acc.CaptureHold("SMS1", 1, nil); err != utils.ErrNotFound {
+		t.Errorf("expected %v, received %v", utils.ErrNotFound, err)
+	}
+	if cln := acc.Clone(); !reflect.DeepEqual(acc.Holds, cln.Holds) {
+		t.Errorf("expected %s, received %s", utils.ToJSON(acc.Holds), utils.ToJSON(cln.Holds))
+	}
+	if err := acc.ReleaseHold("SMS2"); err != nil {
+		t.Fatal(err)
+	}
+	if acc.Holds != nil || acc.AsAccountSummary().HoldSummaries != nil {
+		t.Errorf("holds not released: %s", utils.ToJSON(acc.Holds))
+	}
+	if av := acc.AvailableValue(utils.MetaMonetary); av != 5 {
+		t.Errorf("expected 5 available, received %v", av)
+	}
+
+	// expired holds are not counted anymore
+	if err := acc.CreateHold("SMS3", utils.MetaMonetary, 5, time.Hour); err != nil {
+		t.Fatal(err)
+	}
+	acc.Holds["SMS3"].ExpiryTime = time.Now().Add(-time.Second)
+	if av := acc.AvailableValue(utils.MetaMonetary); av != 5 {
+		t.Errorf("expected 5 available, received %v", av)
+	}
+	if err := acc.ReleaseHold("SMS3"); err != utils.ErrNotFound {
+		t.Errorf("expected %v, received %v", utils.ErrNotFound, err)
+	}
+}
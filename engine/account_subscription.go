@@ -0,0 +1,251 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"slices"
+	"time"
+
+	"github.com/cgrates/cgrates/utils"
+)
+
+// AccountSubscription is a recurring fee on the account, charged in advance for each monthly
+// billing cycle and prorated when started, stopped or changed within the cycle
+type AccountSubscription struct {
+	ID             string
+	Fee            float64       // charged for a full billing cycle
+	BalanceID      string        // *monetary balance charged, *default if empty
+	Anchor         time.Time     // start of a billing cycle, the others starting on the same day of the other months
+	GracePeriod    time.Duration // unpaid time tolerated before suspending the account
+	ActivationTime time.Time
+	PaidUntil      time.Time // end of the period already charged
+	DueSince       time.Time // start of the unpaid period, zero when paid up
+	Suspended      bool      // the account is disabled for insufficient funds
+}
+
+// Clone creates a copy of the subscription
+func (s *AccountSubscription) Clone() *AccountSubscription {
+	if s == nil {
+		return nil
+	}
+	cln := *s
+	return &cln
+}
+
+// addMonths adds n months to t, clamping the day to the end of the resulting month
+func addMonths(t time.Time, n int) time.Time {
+	first := time.Date(t.Year(), t.Month()+time.Month(n), 1,
+		t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+	day := min(t.Day(), utils.GetEndOfMonth(first).Day())
+	return first.AddDate(0, 0, day-1)
+}
+
+// cycleAt returns the bounds of the billing cycle containing t
+func (s *AccountSubscription) cycleAt(t time.Time) (start, end time.Time) {
+	n := (t.Year()-s.Anchor.Year())*12 + int(t.Month()-s.Anchor.Month())
+	for start = addMonths(s.Anchor, n); start.After(t); start = addMonths(s.Anchor, n) {
+		n--
+	}
+	for end = addMonths(s.Anchor, n+1); !end.After(t); end = addMonths(s.Anchor, n+1) {
+		n++
+		start = end
+	}
+	return
+}
+
+// feeFor returns the fee of the period, prorated on the billing cycles it spans
+func (s *AccountSubscription) feeFor(from, to time.Time) (fee float64) {
+	for from.Before(to) {
+		start, end := s.cycleAt(from)
+		spanEnd := end
+		if to.Before(spanEnd) {
+			spanEnd = to
+		}
+		fee += s.Fee * float64(spanEnd.Sub(from)) / float64(end.Sub(start))
+		from = spanEnd
+	}
+	return utils.Round(fee, globalRoundingDecimals, utils.MetaRoundingMiddle)
+}
+
+// chargeSubscription debits the value out of the subscription balance (crediting it for negative values),
+// reporting false if the funds are insufficient
+func (acc *Account) chargeSubscription(s *AccountSubscription, value float64, fltrS *FilterS) (charged bool, err error) {
+	if value == 0 {
+		return true, nil
+	}
+	blcID := s.BalanceID
+	if blcID == utils.EmptyString {
+		blcID = utils.MetaDefault
+	}
+	if value > 0 && !acc.AllowNegative {
+		var available float64
+		if b := acc.GetBalanceWithID(utils.MetaMonetary, blcID); b != nil && !b.IsExpiredAt(time.Now()) {
+			available = b.GetValue()
+		}
+		if available < value {
+			return
+		}
+	}
+	if err = acc.debitBalanceAction(&Action{
+		Id:         s.ID,
+		ActionType: utils.MetaDebit,
+		Balance: &BalanceFilter{
+			ID:    utils.StringPointer(blcID),
+			Type:  utils.StringPointer(utils.MetaMonetary),
+			Value: &utils.ValueFormula{Static: value},
+		},
+	}, false, false, fltrS); err != nil {
+		return
+	}
+	return true, nil
+}
+
+// billSubscription charges the billing cycles started until now, suspending the account
+// once the unpaid period exceeds the grace period and reactivating it when paid up
+func (acc *Account) billSubscription(s *AccountSubscription, now time.Time, fltrS *FilterS) (err error) {
+	for !s.PaidUntil.After(now) {
+		_, end := s.cycleAt(s.PaidUntil)
+		var charged bool
+		if charged, err = acc.chargeSubscription(s, s.feeFor(s.PaidUntil, end), fltrS); err != nil {
+			return
+		}
+		if !charged {
+			if s.DueSince.IsZero() {
+				s.DueSince = s.PaidUntil
+			}
+			if !s.Suspended && now.Sub(s.DueSince) >= s.GracePeriod {
+				s.Suspended = true
+				acc.Disabled = true // same as *disable_account
+			}
+			return
+		}
+		s.PaidUntil = end
+		s.DueSince = time.Time{}
+	}
+	acc.resumeSubscription(s)
+	return
+}
+
+// resumeSubscription lifts the suspension, enabling back the account if no other subscription keeps it suspended
+func (acc *Account) resumeSubscription(s *AccountSubscription) {
+	if !s.Suspended {
+		return
+	}
+	s.Suspended = false
+	for _, other := range acc.Subscriptions {
+		if other.Suspended {
+			return
+		}
+	}
+	acc.Disabled = false // same as *enable_account
+}
+
+// StartSubscription adds the subscription to the account, charging the rest of the current billing cycle.
+// The billing cycles are anchored on the activation time unless the subscription defines its Anchor.
+// Without GracePeriod the subscription is refused, leaving the account untouched, if the first charge fails.
+func (acc *Account) StartSubscription(s *AccountSubscription, now time.Time, fltrS *FilterS) (err error) {
+	if s.Fee < 0 {
+		return utils.ErrNegative
+	}
+	if _, has := acc.Subscriptions[s.ID]; has {
+		return utils.ErrExists
+	}
+	s = s.Clone()
+	if s.Anchor.IsZero() {
+		s.Anchor = now
+	}
+	s.ActivationTime = now
+	s.PaidUntil = now
+	s.DueSince = time.Time{}
+	s.Suspended = false
+	if s.GracePeriod == 0 {
+		_, end := s.cycleAt(now)
+		var charged bool
+		if charged, err = acc.chargeSubscription(s, s.feeFor(now, end), fltrS); err != nil {
+			return
+		} else if !charged {
+			return utils.ErrInsufficientCredit
+		}
+		s.PaidUntil = end
+	}
+	if acc.Subscriptions == nil {
+		acc.Subscriptions = make(map[string]*AccountSubscription)
+	}
+	acc.Subscriptions[s.ID] = s
+	return acc.billSubscription(s, now, fltrS)
+}
+
+// ChangeSubscription replaces the fee of the subscription, charging or refunding
+// the difference for the rest of the period already paid
+func (acc *Account) ChangeSubscription(sID string, fee float64, now time.Time, fltrS *FilterS) (err error) {
+	s, has := acc.Subscriptions[sID]
+	if !has {
+		return utils.ErrNotFound
+	}
+	if fee < 0 {
+		return utils.ErrNegative
+	}
+	if s.PaidUntil.After(now) {
+		newS := s.Clone()
+		newS.Fee = fee
+		var charged bool
+		if charged, err = acc.chargeSubscription(s,
+			newS.feeFor(now, s.PaidUntil)-s.feeFor(now, s.PaidUntil), fltrS); err != nil {
+			return
+		} else if !charged {
+			return utils.ErrInsufficientCredit
+		}
+	}
+	s.Fee = fee
+	return
+}
+
+// StopSubscription removes the subscription, refunding the rest of the period already paid
+func (acc *Account) StopSubscription(sID string, now time.Time, fltrS *FilterS) (err error) {
+	s, has := acc.Subscriptions[sID]
+	if !has {
+		return utils.ErrNotFound
+	}
+	if s.PaidUntil.After(now) {
+		if _, err = acc.chargeSubscription(s, -s.feeFor(now, s.PaidUntil), fltrS); err != nil {
+			return
+		}
+	}
+	delete(acc.Subscriptions, sID)
+	acc.resumeSubscription(s)
+	if len(acc.Subscriptions) == 0 {
+		acc.Subscriptions = nil // leave it nil if empty
+	}
+	return
+}
+
+// BillSubscriptions charges the billing cycles started until now for all the subscriptions of the account
+func (acc *Account) BillSubscriptions(now time.Time, fltrS *FilterS) (err error) {
+	sIDs := make([]string, 0, len(acc.Subscriptions))
+	for sID := range acc.Subscriptions {
+		sIDs = append(sIDs, sID)
+	}
+	slices.Sort(sIDs)
+	for _, sID := range sIDs {
+		if err = acc.billSubscription(acc.Subscriptions[sID], now, fltrS); err != nil {
+			return
+		}
+	}
+	return
+}
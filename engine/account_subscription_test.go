@@ -0,0 +1,143 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cgrates/cgrates/utils"
+)
+
+func TestAccountSubscriptionCycleAt(t *testing.T) {
+	s := &AccountSubscription{Anchor: time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC)}
+	for _, tc := range []struct {
+		t          time.Time
+		start, end time.Time
+	}{
+		{time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC),
+			time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC), time.Date(2026, 2, 28, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2026, 2, 15, 0, 0, 0, 0, time.UTC),
+			time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC), time.Date(2026, 2, 28, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+			time.Date(2026, 2, 28, 0, 0, 0, 0, time.UTC), time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2025, 12, 30, 0, 0, 0, 0, time.UTC), // before the anchor
+			time.Date(2025, 11, 30, 0, 0, 0, 0, time.UTC), time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC)},
+	} {
+		if start, end := s.cycleAt(tc.t); !start.Equal(tc.start) || !end.Equal(tc.end) {
+			t.Errorf("for %v expected [%v, %v), received [%v, %v)", tc.t, tc.start, tc.end, start, end)
+		}
+	}
+	// prorated over two cycles: 14 days of 28 plus 15 days of 31
+	s.Fee = 62
+	if fee := s.feeFor(time.Date(2026, 2, 14, 0, 0, 0, 0, time.UTC),
+		time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC)); fee != 61 {
+		t.Errorf("expected 61, received %v", fee)
+	}
+}
+
+func TestAccountSubscriptionLifecycle(t *testing.T) {
+	day := func(m time.Month, d int) time.Time {
+		return time.Date(2026, m, d, 0, 0, 0, 0, time.UTC)
+	}
+	acc := &Account{
+		ID: "cgrates.org:sub1",
+		BalanceMap: map[string]Balances{
+			utils.MetaMonetary: {{Uuid: "uuid1", ID: utils.MetaDefault, Value: 100}},
+		},
+	}
+	checkValue := func(exp float64) {
+		t.Helper()
+		if rcv := acc.BalanceMap[utils.MetaMonetary][0].GetValue(); rcv != exp {
+			t.Errorf("expected balance %v, received %v", exp, rcv)
+		}
+	}
+	if err := acc.StartSubscription(&AccountSubscription{ID: "TV", Fee: 31,
+		Anchor: day(time.January, 1), GracePeriod: 48 * time.Hour}, day(time.January, 11), nil); err != nil {
+		t.Fatal(err)
+	}
+	checkValue(79) // 21 days out of 31
+	if err := acc.StartSubscription(&AccountSubscription{ID: "TV"}, day(time.January, 11), nil); err != utils.ErrExists {
+		t.Errorf("expected %v, received %v", utils.ErrExists, err)
+	}
+	// no grace period for the unpaid start
+	if err := acc.StartSubscription(&AccountSubscription{ID: "PHONE", Fee: 1000},
+		day(time.January, 11), nil); err != utils.ErrInsufficientCredit {
+		t.Errorf("expected %v, received %v", utils.ErrInsufficientCredit, err)
+	}
+	if _, has := acc.Subscriptions["PHONE"]; has || acc.Disabled {
+		t.Errorf("unexpected account: %s", utils.ToJSON(acc))
+	}
+	checkValue(79)
+	sub := acc.Subscriptions["TV"]
+	if !sub.PaidUntil.Equal(day(time.February, 1)) {
+		t.Errorf("unexpected subscription: %s", utils.ToJSON(sub))
+	}
+
+	// plan change for the last 11 days of January
+	if err := acc.ChangeSubscription("TV", 62, day(time.January, 21), nil); err != nil {
+		t.Fatal(err)
+	}
+	checkValue(68)
+	if err := acc.ChangeSubscription("TV", 10000, day(time.January, 21), nil); err != utils.ErrInsufficientCredit {
+		t.Errorf("expected %v, received %v", utils.ErrInsufficientCredit, err)
+	}
+
+	if err := acc.BillSubscriptions(day(time.February, 1), nil); err != nil {
+		t.Fatal(err)
+	}
+	checkValue(6)
+
+	// insufficient funds, suspended after the grace period
+	if err := acc.BillSubscriptions(day(time.March, 1), nil); err != nil {
+		t.Fatal(err)
+	}
+	if sub.Suspended || acc.Disabled || !sub.DueSince.Equal(day(time.March, 1)) {
+		t.Errorf("unexpected subscription: %s", utils.ToJSON(sub))
+	}
+	if err := acc.BillSubscriptions(day(time.March, 4), nil); err != nil {
+		t.Fatal(err)
+	}
+	if !sub.Suspended || !acc.Disabled {
+		t.Errorf("expected suspension, received: %s", utils.ToJSON(sub))
+	}
+	checkValue(6)
+
+	// funded again
+	acc.BalanceMap[utils.MetaMonetary][0].AddValue(94)
+	if err := acc.BillSubscriptions(day(time.March, 5), nil); err != nil {
+		t.Fatal(err)
+	}
+	if sub.Suspended || acc.Disabled || !sub.DueSince.IsZero() || !sub.PaidUntil.Equal(day(time.April, 1)) {
+		t.Errorf("unexpected subscription: %s", utils.ToJSON(sub))
+	}
+	checkValue(38)
+
+	// refund of the last 16 days of March
+	if err := acc.StopSubscription("TV", day(time.March, 16), nil); err != nil {
+		t.Fatal(err)
+	}
+	checkValue(70)
+	if acc.Subscriptions != nil {
+		t.Errorf("expected no subscriptions, received: %s", utils.ToJSON(acc.Subscriptions))
+	}
+	if err := acc.StopSubscription("TV", day(time.March, 16), nil); err != utils.ErrNotFound {
+		t.Errorf("expected %v, received %v", utils.ErrNotFound, err)
+	}
+}
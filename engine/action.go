@@ -178,6 +178,7 @@ var accountActions = map[string]bool{
 	utils.TopUpZeroNegative:           true,
 	utils.MetaSetBalance:              true,
 	utils.MetaRemoveBalance:           true,
+	utils.MetaBillSubscriptions:       true,
 }
 
 func init() {
@@ -209,6 +210,7 @@ func init() {
 	actionFuncMap[utils.TopUpZeroNegative] = topupZeroNegativeAction
 	actionFuncMap[utils.SetExpiry] = setExpiryAction
 	actionFuncMap[utils.MetaPublishAccount] = publishAccount
+	actionFuncMap[utils.MetaBillSubscriptions] = billSubscriptionsAction
 	actionFuncMap[utils.MetaRemoveSessionCosts] = removeSessionCosts
 	actionFuncMap[utils.MetaRemoveExpired] = removeExpired
 	actionFuncMap[utils.MetaCDRAccount] = resetAccountCDR
@@ -278,6 +280,9 @@ func transferBalanceAction(srcAcc *Account, act *Action, acts Actions, fltrS *Fi
 	// Lock the destination account if different from source, otherwise
 	// pass without lock key and timeout.
 	diffAcnts := srcAcc.ID != destInfo.AccID
+	if diffAcnts && srcAcc.simulation != nil {
+		return errors.New("transfers towards other accounts cannot be simulated")
+	}
 	var lockTimeout time.Duration
 	lockKeys := make([]string, 0, 1)
 	if diffAcnts {
@@ -291,12 +296,14 @@ func transferBalanceAction(srcAcc *Account, act *Action, acts Actions, fltrS *Fi
 	guardErr := guardian.Guardian.Guard(func() error {
 
 		var destAcc *Account
+		var snp *balanceSnapshot // journals the destination account
 		switch diffAcnts {
 		case true:
 			var err error
 			if destAcc, err = dm.GetAccount(destInfo.AccID); err != nil {
 				return fmt.Errorf("retrieving destination account failed: %w", err)
 			}
+			snp = newBalanceSnapshot(destAcc)
 		case false:
 			destAcc = srcAcc
 		}
@@ -361,6 +368,10 @@ func transferBalanceAction(srcAcc *Account, act *Action, acts Actions, fltrS *Fi
 			if err := dm.SetAccount(destAcc); err != nil {
 				return fmt.Errorf("updating destination account failed: %w", err)
 			}
+			snp.journalBalances(&BalanceJournalCause{
+				Source:   utils.RALs,
+				ActionID: act.Id,
+			})
 		}
 		return nil
 	}, lockTimeout, lockKeys...)
@@ -1145,6 +1156,14 @@ func setExpiryAction(ub *Account, a *Action, _ Actions, _ *FilterS, _ any, _ Sha
 	return nil
 }
 
+// billSubscriptionsAction charges the billing cycles of the account subscriptions started until now
+func billSubscriptionsAction(acc *Account, _ *Action, _ Actions, fltrS *FilterS, _ any, _ SharedActionsData, _ ActionConnCfg) error {
+	if acc == nil {
+		return errors.New("nil account")
+	}
+	return acc.BillSubscriptions(time.Now(), fltrS)
+}
+
 // publishAccount will publish the account as well as each balance received to ThresholdS
 func publishAccount(ub *Account, a *Action, _ Actions, _ *FilterS, _ any, _ SharedActionsData, _ ActionConnCfg) error {
 	if ub == nil {
@@ -1395,6 +1414,7 @@ func resetAccountCDR(ub *Account, action *Action, _ Actions, fltrS *FilterS, _ a
 			Weight:   &bsum.Weight,
 			Disabled: &bsum.Disabled,
 			Factors:  &bsum.Factors,
+			Currency: &bsum.Currency,
 		}, fltrS); err != nil {
 			utils.Logger.Warning(fmt.Sprintf("<%s> Error %s setting balance %s for account: %s", utils.Actions, err, bsum.UUID, account))
 		}
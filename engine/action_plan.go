@@ -143,6 +143,16 @@ func (at *ActionTiming) GetNextStartTime(refTime time.Time) time.Time {
 	if rateIvl == nil || rateIvl.Timing == nil {
 		return time.Time{}
 	}
+	if isCronTiming(rateIvl.Timing.ID) {
+		expr, loc, err := parseCronTiming(rateIvl.Timing.ID)
+		if err != nil {
+			utils.Logger.Warning(fmt.Sprintf("<%s> invalid timing <%s> for action plan <%s>: %s",
+				utils.SchedulerS, rateIvl.Timing.ID, at.actionPlanID, err))
+			return time.Time{}
+		}
+		at.stCache = expr.Next(refTime.In(loc))
+		return at.stCache
+	}
 	// Normalize
 	if rateIvl.Timing.StartTime == "" {
 		rateIvl.Timing.StartTime = "00:00:00"
@@ -183,6 +193,23 @@ func (at *ActionTiming) GetNextStartTime(refTime time.Time) time.Time {
 	return at.stCache
 }
 
+// GetNextStartTimes returns the next n start times after refTime without altering the cached one
+func (at *ActionTiming) GetNextStartTimes(refTime time.Time, n int) (sTimes []time.Time) {
+	cln := at.Clone()
+	for range n {
+		if refTime = cln.GetNextStartTime(refTime); refTime.IsZero() {
+			break
+		}
+		sTimes = append(sTimes, refTime)
+		if cln.Timing != nil && cln.Timing.Timing != nil &&
+			strings.HasPrefix(cln.Timing.Timing.StartTime, utils.PlusChar) {
+			break // one time action
+		}
+		cln.ResetStartTimeCache()
+	}
+	return
+}
+
 func (at *ActionTiming) ResetStartTimeCache() {
 	if at.Timing != nil && at.Timing.Timing != nil && strings.HasPrefix(at.Timing.Timing.StartTime,
 		utils.PlusChar) {
@@ -252,6 +279,7 @@ func (at *ActionTiming) Execute(fltrS *FilterS, originService string, thresholdS
 			transactionFailed := false
 			removeAccountActionFound := false
 			sharedData := NewSharedActionsData(acts)
+			var jes []*BalanceJournalEntry
 			for i, act := range acts {
 				// check action filter
 				if len(act.Filters) > 0 {
@@ -289,11 +317,19 @@ func (at *ActionTiming) Execute(fltrS *FilterS, originService string, thresholdS
 				if act.ActionType == utils.MetaSyPublish { // cgrEvent is not needed in ExtraData for *sy_publish
 					extraData = thresholdSyConn
 				}
-				if err := actionFunction(acc, act, acts, fltrS, extraData, sharedData,
-					newActionConnCfg(originService, act.ActionType, config.CgrConfig())); err != nil {
+				snp := newBalanceSnapshot(acc)
+				errAct := actionFunction(acc, act, acts, fltrS, extraData, sharedData,
+					newActionConnCfg(originService, act.ActionType, config.CgrConfig()))
+				// collected on errors too, the failed action may have changed the balances already
+				jes = append(jes, snp.entries(&BalanceJournalCause{
+					Source:    originService,
+					ActionsID: at.ActionsID,
+					ActionID:  act.Id,
+				})...)
+				if errAct != nil {
 					utils.Logger.Err(
 						fmt.Sprintf("Error executing action %s: %v!",
-							act.ActionType, err))
+							act.ActionType, errAct))
 					partialyExecuted = true
 					transactionFailed = true
 					break
@@ -304,6 +340,7 @@ func (at *ActionTiming) Execute(fltrS *FilterS, originService string, thresholdS
 			}
 			if !transactionFailed && !removeAccountActionFound {
 				dm.SetAccount(acc)
+				storeBalanceJournal(jes)
 			}
 			return nil
 		}, config.CgrConfig().GeneralCfg().LockingTimeout, utils.AccountPrefix+accID)
@@ -461,6 +498,18 @@ func (attr *AttrActionPlan) GetRITiming(dm *DataManager) (timing *RITiming, err
 // checkDefaultTiming will check the tStr if it's of the the default timings ( the same as in TPReader )
 // and will compute it properly
 func checkDefaultTiming(tStr string) (rTm *RITiming, isDefault bool) {
+	if isCronTiming(tStr) {
+		if _, _, err := parseCronTiming(tStr); err != nil {
+			return nil, false
+		}
+		return &RITiming{
+			ID:        tStr,
+			Years:     utils.Years{},
+			Months:    utils.Months{},
+			MonthDays: utils.MonthDays{},
+			WeekDays:  utils.WeekDays{},
+		}, true
+	}
 	currentTime := time.Now()
 	fmtTime := currentTime.Format("15:04:05")
 	switch tStr {
@@ -548,6 +597,36 @@ func checkDefaultTiming(tStr string) (rTm *RITiming, isDefault bool) {
 	}
 }
 
+// isCronTiming checks if the timing is defined as a cron expression (*cron:<expression>)
+func isCronTiming(tStr string) bool {
+	return strings.HasPrefix(tStr, utils.MetaCron+utils.InInFieldSep)
+}
+
+// parseCronTiming parses a timing defined as *cron:[CRON_TZ=<zone> ]<expression> where
+// the expression has the standard 5 fields or 6 fields when starting with the seconds,
+// evaluated in the default_timezone of the general config without CRON_TZ
+func parseCronTiming(tStr string) (expr *cronexpr.Expression, loc *time.Location, err error) {
+	cronStr := strings.TrimSpace(strings.TrimPrefix(tStr, utils.MetaCron+utils.InInFieldSep))
+	tz := config.CgrConfig().GeneralCfg().DefaultTimezone
+	if strings.HasPrefix(cronStr, utils.CronTZPrefix) {
+		tz, cronStr, _ = strings.Cut(strings.TrimPrefix(cronStr, utils.CronTZPrefix), utils.SepCgr)
+		cronStr = strings.TrimSpace(cronStr)
+	}
+	if loc, err = time.LoadLocation(tz); err != nil {
+		return
+	}
+	switch flds := strings.Fields(cronStr); len(flds) {
+	case 5: // minute hour monthday month weekday
+		cronStr = strings.Join(append([]string{"0"}, flds...), utils.SepCgr) + " *"
+	case 6: // second minute hour monthday month weekday
+		cronStr = strings.Join(flds, utils.SepCgr) + " *"
+	default:
+		return nil, nil, fmt.Errorf("expected 5 or 6 fields in cron expression, received %d", len(flds))
+	}
+	expr, err = cronexpr.Parse(cronStr)
+	return
+}
+
 func verifyFormat(tStr string) bool {
 	if tStr == utils.EmptyString || tStr == utils.MetaASAP ||
 		strings.HasPrefix(tStr, utils.PlusChar) {
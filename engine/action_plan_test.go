@@ -756,6 +756,8 @@ func TestCheckDefaultTiming(t *testing.T) {
 		{"Monthly Estimated", utils.MetaMonthlyEstimated, utils.MetaMonthlyEstimated, true},
 		{"Month End", utils.MetaMonthEnd, utils.MetaMonthEnd, true},
 		{"Yearly", utils.MetaYearly, utils.MetaYearly, true},
+		{"Cron", "*cron:*/15 * * * 1-5", "*cron:*/15 * * * 1-5", true},
+		{"Invalid Cron", "*cron:* * *", "", false},
 		{"Unknown", "unknown", "", false},
 	}
 
@@ -1110,3 +1112,94 @@ func TestAttrActionPlanGetRITiming(t *testing.T) {
 		})
 	}
 }
+
+func TestActionTimingCronNextStartTime(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skip(err)
+	}
+	tmpCfg := config.CgrConfig()
+	defer config.SetCgrConfig(tmpCfg)
+	cfg := config.NewDefaultCGRConfig()
+	cfg.GeneralCfg().DefaultTimezone = "UTC" // not depending on the timezone of the host
+	config.SetCgrConfig(cfg)
+	tests := []struct {
+		name     string
+		timing   string
+		refTime  time.Time
+		expected time.Time
+	}{
+		{
+			name:     "every 15 minutes on business days",
+			timing:   "*cron:*/15 * * * 1-5",
+			refTime:  time.Date(2024, 3, 8, 23, 50, 0, 0, time.UTC), // Friday
+			expected: time.Date(2024, 3, 11, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "last Friday of the month",
+			timing:   "*cron:0 9 * * 5L",
+			refTime:  time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+			expected: time.Date(2024, 3, 29, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "with seconds",
+			timing:   "*cron:30 0 12 1 * *",
+			refTime:  time.Date(2024, 3, 1, 12, 0, 31, 0, time.UTC),
+			expected: time.Date(2024, 4, 1, 12, 0, 30, 0, time.UTC),
+		},
+		{
+			name:     "with timezone",
+			timing:   "*cron:CRON_TZ=Europe/Berlin 0 8 * * *",
+			refTime:  time.Date(2024, 7, 1, 7, 0, 0, 0, time.UTC),
+			expected: time.Date(2024, 7, 2, 8, 0, 0, 0, berlin),
+		},
+		{
+			name:    "invalid",
+			timing:  "*cron:CRON_TZ=Mars/Olympus 0 8 * * *",
+			refTime: time.Date(2024, 7, 1, 7, 0, 0, 0, time.UTC),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			at := &ActionTiming{Timing: &RateInterval{Timing: &RITiming{ID: tt.timing}}}
+			if rcv := at.GetNextStartTime(tt.refTime); !rcv.Equal(tt.expected) {
+				t.Errorf("expected %v, received %v", tt.expected, rcv)
+			}
+		})
+	}
+
+	// without CRON_TZ the default timezone applies
+	cfg.GeneralCfg().DefaultTimezone = "Europe/Berlin"
+	at := &ActionTiming{Timing: &RateInterval{Timing: &RITiming{ID: "*cron:0 8 * * *"}}}
+	if rcv, exp := at.GetNextStartTime(time.Date(2024, 7, 1, 7, 0, 0, 0, time.UTC)),
+		time.Date(2024, 7, 2, 8, 0, 0, 0, berlin); !rcv.Equal(exp) {
+		t.Errorf("expected %v, received %v", exp, rcv)
+	}
+}
+
+func TestActionTimingGetNextStartTimesCron(t *testing.T) {
+	tmpCfg := config.CgrConfig()
+	defer config.SetCgrConfig(tmpCfg)
+	cfg := config.NewDefaultCGRConfig()
+	cfg.GeneralCfg().DefaultTimezone = "UTC"
+	config.SetCgrConfig(cfg)
+	at := &ActionTiming{Timing: &RateInterval{Timing: &RITiming{ID: "*cron:0 */6 * * *"}}}
+	refTime := time.Date(2024, 3, 1, 1, 0, 0, 0, time.UTC)
+	exp := []time.Time{
+		time.Date(2024, 3, 1, 6, 0, 0, 0, time.UTC),
+		time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC),
+		time.Date(2024, 3, 1, 18, 0, 0, 0, time.UTC),
+	}
+	rcv := at.GetNextStartTimes(refTime, 3)
+	if len(rcv) != len(exp) {
+		t.Fatalf("expected %v, received %v", exp, rcv)
+	}
+	for i := range exp {
+		if !rcv[i].Equal(exp[i]) {
+			t.Errorf("expected %v, received %v", exp, rcv)
+		}
+	}
+	if !at.stCache.IsZero() {
+		t.Errorf("cached start time altered: %v", at.stCache)
+	}
+}
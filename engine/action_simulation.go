@@ -0,0 +1,112 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"fmt"
+
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/utils"
+)
+
+// ActionsSimulation is the outcome of executing actions over a copy of an account,
+// without persisting it or reaching other subsystems
+type ActionsSimulation struct {
+	Account        *AccountSummary        // state of the account after the simulation
+	BalanceChanges []*BalanceJournalEntry // balances changed by the simulation
+	ActionTriggers []*SimulatedTrigger    // action triggers fired during the simulation
+	SkippedActions []*SimulatedAction     // actions acting outside the account, not executed
+	Events         []*utils.CGREvent      // events which would have been published
+}
+
+// SimulatedTrigger identifies an ActionTrigger fired during a simulation
+type SimulatedTrigger struct {
+	ID        string
+	UniqueID  string
+	ActionsID string
+}
+
+// SimulatedAction identifies an Action skipped by a simulation
+type SimulatedAction struct {
+	ActionsID  string
+	ActionType string
+}
+
+// skipAction reports if the action is not executed within the simulation, recording it
+func (sim *ActionsSimulation) skipAction(act *Action) bool {
+	if accountActions[act.ActionType] {
+		return false
+	}
+	sim.SkippedActions = append(sim.SkippedActions, &SimulatedAction{
+		ActionsID:  act.Id,
+		ActionType: act.ActionType,
+	})
+	return true
+}
+
+// SimulateActions executes the lists of actions, in order, over a clone of the account.
+// The account is not modified, the outcome being returned as ActionsSimulation.
+func (acc *Account) SimulateActions(fltrS *FilterS, actsList ...Actions) (sim *ActionsSimulation, err error) {
+	sim = new(ActionsSimulation)
+	cln := acc.Clone()
+	cln.simulation = sim
+	initBals := snapshotBalances(cln)
+	for _, acts := range actsList {
+		acts = acts.Clone()
+		acts.Sort()
+		sharedData := NewSharedActionsData(acts)
+		for i, act := range acts {
+			if len(act.Filters) > 0 {
+				var pass bool
+				if pass, err = fltrS.Pass(utils.NewTenantID(cln.ID).Tenant, act.Filters,
+					utils.MapStorage{utils.MetaReq: cln}); err != nil {
+					return nil, err
+				} else if !pass {
+					continue
+				}
+			}
+			if sim.skipAction(act) {
+				continue
+			}
+			if act.Balance == nil {
+				act.Balance = &BalanceFilter{}
+			}
+			if act.ExpirationString != "" {
+				if expDate, parseErr := utils.ParseTimeDetectLayout(act.ExpirationString,
+					config.CgrConfig().GeneralCfg().DefaultTimezone); parseErr == nil {
+					act.Balance.ExpirationDate = &expDate
+				}
+			}
+			actionFunction, exists := getActionFunc(act.ActionType)
+			if !exists {
+				return nil, fmt.Errorf("unsupported action type: <%s>", act.ActionType)
+			}
+			sharedData.idx = i
+			if err = actionFunction(cln, act, acts, fltrS, nil, sharedData,
+				newActionConnCfg(utils.ApierS, act.ActionType, config.CgrConfig())); err != nil {
+				return nil, fmt.Errorf("failed executing action <%s> of <%s>: %w",
+					act.ActionType, act.Id, err)
+			}
+		}
+	}
+	cln.simulation = nil
+	sim.BalanceChanges = balanceChanges(cln, initBals, &BalanceJournalCause{Source: utils.MetaDryRun})
+	sim.Account = cln.AsAccountSummary()
+	return
+}
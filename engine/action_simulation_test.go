@@ -0,0 +1,124 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/cgrates/cgrates/utils"
+)
+
+func TestAccountSimulateActions(t *testing.T) {
+	if err := dm.SetActions("SIM_REFILL", Actions{
+		{Id: "SIM_REFILL", ActionType: utils.MetaTopUp, Balance: &BalanceFilter{
+			ID:    utils.StringPointer("refill"),
+			Type:  utils.StringPointer(utils.MetaMonetary),
+			Value: &utils.ValueFormula{Static: 20},
+		}},
+		{Id: "SIM_REFILL", ActionType: utils.CDRLog},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	acc := &Account{
+		ID: "cgrates.org:sim1",
+		BalanceMap: map[string]Balances{
+			utils.MetaMonetary: {{Uuid: "uuid1", ID: "main", Value: 10}},
+		},
+		ActionTriggers: ActionTriggers{{
+			ID:             "LOW_CREDIT",
+			UniqueID:       "low1",
+			ThresholdType:  utils.TriggerMinBalance,
+			ThresholdValue: 5,
+			Balance:        &BalanceFilter{Type: utils.StringPointer(utils.MetaMonetary)},
+			ActionsID:      "SIM_REFILL",
+		}},
+	}
+	acts := Actions{
+		{Id: "SIM_OFFER", ActionType: utils.MetaDebit, Weight: 20, Balance: &BalanceFilter{
+			ID:    utils.StringPointer("main"),
+			Type:  utils.StringPointer(utils.MetaMonetary),
+			Value: &utils.ValueFormula{Static: 8},
+		}},
+		{Id: "SIM_OFFER", ActionType: utils.MetaHTTPPost, Weight: 10},
+		{Id: "SIM_OFFER", ActionType: utils.MetaPublishAccount},
+	}
+	sim, err := acc.SimulateActions(nil, acts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(sim.BalanceChanges) != 2 {
+		t.Fatalf("expected 2 balance changes, received: %s", utils.ToJSON(sim.BalanceChanges))
+	}
+	for _, je := range sim.BalanceChanges {
+		switch je.BalanceID {
+		case "main":
+			if je.Before != 10 || je.After != 2 || je.Delta != -8 {
+				t.Errorf("unexpected change: %s", utils.ToJSON(je))
+			}
+		case "refill":
+			if je.Before != 0 || je.After != 20 || je.Delta != 20 {
+				t.Errorf("unexpected change: %s", utils.ToJSON(je))
+			}
+		default:
+			t.Errorf("unexpected change: %s", utils.ToJSON(je))
+		}
+		if je.Source != utils.MetaDryRun {
+			t.Errorf("expected source %q, received %q", utils.MetaDryRun, je.Source)
+		}
+	}
+	expTrgs := []*SimulatedTrigger{{ID: "LOW_CREDIT", UniqueID: "low1", ActionsID: "SIM_REFILL"}}
+	if !reflect.DeepEqual(expTrgs, sim.ActionTriggers) {
+		t.Errorf("expected %s, received %s", utils.ToJSON(expTrgs), utils.ToJSON(sim.ActionTriggers))
+	}
+	expSkipped := []*SimulatedAction{
+		{ActionsID: "SIM_REFILL", ActionType: utils.CDRLog}, // fired by the debit
+		{ActionsID: "SIM_OFFER", ActionType: utils.MetaHTTPPost},
+	}
+	if !reflect.DeepEqual(expSkipped, sim.SkippedActions) {
+		t.Errorf("expected %s, received %s", utils.ToJSON(expSkipped), utils.ToJSON(sim.SkippedActions))
+	}
+	if len(sim.Events) != 1 || sim.Events[0].Event[utils.AccountID] != "sim1" {
+		t.Errorf("unexpected events: %s", utils.ToJSON(sim.Events))
+	}
+	if sim.Account == nil || len(sim.Account.BalanceSummaries) != 2 {
+		t.Errorf("unexpected account: %s", utils.ToJSON(sim.Account))
+	}
+
+	// nothing touched outside the simulation
+	if acc.BalanceMap[utils.MetaMonetary][0].Value != 10 ||
+		len(acc.BalanceMap[utils.MetaMonetary]) != 1 ||
+		acc.ActionTriggers[0].Executed {
+		t.Errorf("account modified by the simulation: %s", utils.ToJSON(acc))
+	}
+	if _, err = dm.GetAccount(acc.ID); err != utils.ErrNotFound {
+		t.Errorf("expected %v, received %v", utils.ErrNotFound, err)
+	}
+
+	// transfers towards other accounts are not simulated
+	if _, err = acc.SimulateActions(nil, Actions{{Id: "SIM_TRANSFER", ActionType: utils.MetaTransferBalance,
+		ExtraParameters: `{"DestinationAccountID":"cgrates.org:sim2","DestinationBalanceID":"main"}`,
+		Balance: &BalanceFilter{
+			ID:    utils.StringPointer("main"),
+			Value: &utils.ValueFormula{Static: 1},
+		}}}); err == nil {
+		t.Error("expected error for transfers towards other accounts")
+	}
+}
@@ -67,9 +67,17 @@ func (at *ActionTrigger) Execute(acc *Account, fltrS *FilterS) (err error) {
 	}
 	acts.Sort()
 	at.Executed = true
+	if acc != nil && acc.simulation != nil {
+		acc.simulation.ActionTriggers = append(acc.simulation.ActionTriggers, &SimulatedTrigger{
+			ID:        at.ID,
+			UniqueID:  at.UniqueID,
+			ActionsID: at.ActionsID,
+		})
+	}
 	transactionFailed := false
 	removeAccountActionFound := false
 	sharedData := NewSharedActionsData(acts)
+	var jes []*BalanceJournalEntry
 	for i, act := range acts {
 		// check action filter
 		if len(act.Filters) > 0 {
@@ -91,6 +99,9 @@ func (at *ActionTrigger) Execute(acc *Account, fltrS *FilterS) (err error) {
 			}
 		}
 
+		if acc != nil && acc.simulation != nil && acc.simulation.skipAction(act) {
+			continue
+		}
 		actionFunction, exists := getActionFunc(act.ActionType)
 		if !exists {
 			utils.Logger.Err(
@@ -100,11 +111,19 @@ func (at *ActionTrigger) Execute(acc *Account, fltrS *FilterS) (err error) {
 			break
 		}
 		sharedData.idx = i // set the current action index in shared data
-		if err := actionFunction(acc, act, acts, fltrS, nil, sharedData,
-			newActionConnCfg(utils.RALs, act.ActionType, config.CgrConfig())); err != nil {
+		snp := newBalanceSnapshot(acc)
+		errAct := actionFunction(acc, act, acts, fltrS, nil, sharedData,
+			newActionConnCfg(utils.RALs, act.ActionType, config.CgrConfig()))
+		// collected on errors too since the account is still saved
+		jes = append(jes, snp.entries(&BalanceJournalCause{
+			Source:    utils.RALs,
+			ActionsID: at.ActionsID,
+			ActionID:  act.Id,
+		})...)
+		if errAct != nil {
 			utils.Logger.Err(
 				fmt.Sprintf("Error executing action %s: %v!",
-					act.ActionType, err))
+					act.ActionType, errAct))
 			transactionFailed = false
 			break
 		}
@@ -115,8 +134,9 @@ func (at *ActionTrigger) Execute(acc *Account, fltrS *FilterS) (err error) {
 	if transactionFailed || at.Recurrent {
 		at.Executed = false
 	}
-	if !transactionFailed && acc != nil && !removeAccountActionFound {
+	if !transactionFailed && acc != nil && !removeAccountActionFound && acc.simulation == nil {
 		dm.SetAccount(acc)
+		storeBalanceJournal(jes)
 	}
 	return
 }
@@ -103,26 +103,34 @@ func (alS *AttributeService) attributeProfileForEvent(tnt string, ctx *string, a
 		}
 		attrIDs = aPrflIDs.AsSlice()
 	}
+	opts, _ := evNm[utils.MetaOpts].(map[string]any)
+	expl := ExplanationFromOpts(opts)
+	expl.SetCandidates(attrIDs)
 	for _, apID := range attrIDs {
 		aPrfl, err := alS.dm.GetAttributeProfile(tnt, apID, true, true, utils.NonTransactional)
 		if err != nil {
 			if err == utils.ErrNotFound {
+				expl.Skip(apID, ExplainReasonNotFound)
 				continue
 			}
 			return nil, err
 		}
 		if !(len(aPrfl.Contexts) == 1 && aPrfl.Contexts[0] == utils.MetaAny) &&
 			!slices.Contains(aPrfl.Contexts, contextVal) {
+			expl.Skip(apID, ExplainReasonContext)
 			continue
 		}
 		if aPrfl.ActivationInterval != nil && actTime != nil &&
 			!aPrfl.ActivationInterval.IsActiveAtTime(*actTime) { // not active
+			expl.Skip(apID, ExplainReasonInactive)
 			continue
 		}
 		tntID := aPrfl.TenantIDInline()
 		(evNm[utils.MetaVars].(utils.MapStorage))[utils.MetaAttrPrfTenantID] = tntID
-		if !ignoreFilters {
-			if pass, err := alS.filterS.Pass(tnt, aPrfl.FilterIDs,
+		if ignoreFilters {
+			expl.Skip(apID, ExplainReasonIgnoreFilters)
+		} else {
+			if pass, err := alS.filterS.ExplainPass(expl, apID, tnt, aPrfl.FilterIDs,
 				evNm); err != nil {
 				return nil, err
 			} else if !pass {
@@ -285,6 +293,27 @@ func (alS *AttributeService) V1GetAttributeForEvent(ctx *context.Context, args *
 	return
 }
 
+// V1ExplainEvent details why the AttributeProfiles were matched or not for the event
+func (alS *AttributeService) V1ExplainEvent(ctx *context.Context, args *utils.CGREvent,
+	reply *MatchExplanation) (err error) {
+	if args == nil {
+		return utils.NewErrMandatoryIeMissing(utils.CGREventString)
+	}
+	args, expl := explainArgs(args)
+	var attrPrf AttributeProfile
+	if err = alS.V1GetAttributeForEvent(ctx, args, &attrPrf); err != nil {
+		if err != utils.ErrNotFound {
+			return
+		}
+		err = nil
+	} else {
+		expl.Matched = []string{attrPrf.ID}
+	}
+	expl.Sort()
+	*reply = *expl
+	return
+}
+
 // V1ProcessEvent proccess the event and returns the result
 func (alS *AttributeService) V1ProcessEvent(ctx *context.Context, args *utils.CGREvent,
 	reply *AttrSProcessEventReply) (err error) {
@@ -561,6 +590,10 @@ func ParseAttribute(dp utils.DataProvider, attrType, path string, value config.R
 
 		sort.Strings(values[1:])
 		out = strings.Join(values, utils.InfieldSep)
+	case utils.MetaLookup:
+		out, err = parseLookupAttribute(dp, value)
+	case utils.MetaWasm:
+		out, err = parseWasmAttribute(dp, value)
 	default:
 		if strings.HasPrefix(attrType, utils.MetaHTTP) {
 			out, err = externalAttributeAPI(attrType, dp)
@@ -45,6 +45,7 @@ type BalanceFilter struct {
 	Disabled       *bool
 	Factors        *ValueFactors
 	Blocker        *bool
+	Currency       *string `json:",omitempty"`
 }
 
 // NewBalanceFilter creates a new BalanceFilter based on given filter
@@ -109,6 +110,9 @@ func NewBalanceFilter(filter map[string]any, defaultTimezone string) (*BalanceFi
 		}
 		bf.Blocker = utils.BoolPointer(value)
 	}
+	if ccy, has := filter[utils.Currency]; has {
+		bf.Currency = utils.StringPointer(utils.IfaceAsString(ccy))
+	}
 	if facVal, has := filter[utils.Factors]; has {
 		var err error
 		var facBytes []byte
@@ -147,6 +151,7 @@ func (bp *BalanceFilter) CreateBalance() *Balance {
 		Disabled:       bp.GetDisabled(),
 		Factors:        bp.GetFactors(),
 		Blocker:        bp.GetBlocker(),
+		Currency:       bp.GetCurrency(),
 	}
 	return b.Clone()
 }
@@ -217,6 +222,10 @@ func (bf *BalanceFilter) Clone() *BalanceFilter {
 		result.Blocker = new(bool)
 		*result.Blocker = *bf.Blocker
 	}
+	if bf.Currency != nil {
+		result.Currency = new(string)
+		*result.Currency = *bf.Currency
+	}
 	return result
 }
 
@@ -265,6 +274,9 @@ func (bf *BalanceFilter) LoadFromBalance(b *Balance) *BalanceFilter {
 	if b.Blocker {
 		bf.Blocker = &b.Blocker
 	}
+	if b.Currency != "" {
+		bf.Currency = &b.Currency
+	}
 	bf.Timings = b.Timings
 	return bf
 }
@@ -375,6 +387,13 @@ func (bp *BalanceFilter) GetBlocker() bool {
 	return *bp.Blocker
 }
 
+func (bp *BalanceFilter) GetCurrency() string {
+	if bp == nil || bp.Currency == nil {
+		return ""
+	}
+	return *bp.Currency
+}
+
 func (bp *BalanceFilter) GetExpirationDate() time.Time {
 	if bp == nil || bp.ExpirationDate == nil {
 		return time.Time{}
@@ -443,6 +462,9 @@ func (bf *BalanceFilter) ModifyBalance(b *Balance) {
 	if bf.Disabled != nil {
 		b.Disabled = *bf.Disabled
 	}
+	if bf.Currency != nil {
+		b.Currency = *bf.Currency
+	}
 	b.SetDirty() // Mark the balance as dirty since we have modified and it should be checked by action triggers
 }
 
@@ -584,6 +606,14 @@ func (bp *BalanceFilter) FieldAsInterface(fldPath []string) (val any, err error)
 			return
 		}
 		return *bp.Blocker, nil
+	case utils.Currency:
+		if len(fldPath) != 1 {
+			return nil, utils.ErrNotFound
+		}
+		if bp.Currency == nil {
+			return
+		}
+		return *bp.Currency, nil
 	case utils.DestinationIDs:
 		if len(fldPath) == 1 {
 			return bp.DestinationIDs, nil
@@ -0,0 +1,200 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/utils"
+)
+
+// BalanceJournalEntry is the immutable record of one balance change
+type BalanceJournalEntry struct {
+	Tenant      string
+	Account     string
+	BalanceType string
+	BalanceUUID string
+	BalanceID   string
+	Before      float64
+	After       float64
+	Delta       float64
+	Source      string // subsystem originating the change
+	ActionsID   string // populated for changes done by actions
+	ActionID    string
+	CGRID       string // populated for changes done by debits and refunds
+	Time        time.Time
+}
+
+// BalanceJournalCause identifies what changed the balances
+type BalanceJournalCause struct {
+	Source    string
+	ActionsID string
+	ActionID  string
+	CGRID     string
+}
+
+// journaledBalance is the state of a balance when the snapshot was taken
+type journaledBalance struct {
+	blcType string
+	id      string
+	value   float64
+}
+
+// balanceSnapshot keeps the balance values of an account to journal their changes
+type balanceSnapshot struct {
+	acc      *Account
+	parent   *balanceSnapshot // snapshot taken by the operation which triggered this one
+	balances map[string]*journaledBalance
+}
+
+// newBalanceSnapshot returns the snapshot of the account balances
+// or nil if the balance journal is disabled
+func newBalanceSnapshot(acc *Account) (snp *balanceSnapshot) {
+	if acc == nil || !config.CgrConfig().RalsCfg().BalanceJournal {
+		return
+	}
+	snp = &balanceSnapshot{
+		acc:      acc,
+		parent:   acc.balanceSnapshot,
+		balances: snapshotBalances(acc),
+	}
+	acc.balanceSnapshot = snp
+	return
+}
+
+// snapshotBalances returns the current state of the account balances indexed on their UUID
+func snapshotBalances(acc *Account) (jbs map[string]*journaledBalance) {
+	jbs = make(map[string]*journaledBalance)
+	for blcType, blcs := range acc.BalanceMap {
+		for _, b := range blcs {
+			jbs[b.Uuid] = &journaledBalance{
+				blcType: blcType,
+				id:      b.ID,
+				value:   b.GetValue(),
+			}
+		}
+	}
+	return
+}
+
+// entries returns the journal entries of the balances changed since the snapshot was taken
+func (snp *balanceSnapshot) entries(cause *BalanceJournalCause) (jes []*BalanceJournalEntry) {
+	if snp == nil {
+		return
+	}
+	snp.acc.balanceSnapshot = snp.parent
+	jes = balanceChanges(snp.acc, snp.balances, cause)
+	snp.parent.exclude(jes) // journaled separately from the parent
+	return
+}
+
+// balanceChanges returns the journal entries of the account balances differing from the snapshotted ones
+func balanceChanges(acc *Account, jbs map[string]*journaledBalance, cause *BalanceJournalCause) (jes []*BalanceJournalEntry) {
+	tntID := utils.NewTenantID(acc.ID)
+	newEntry := func(blcType, uuid, id string, before, after float64) *BalanceJournalEntry {
+		return &BalanceJournalEntry{
+			Tenant:      tntID.Tenant,
+			Account:     tntID.ID,
+			BalanceType: blcType,
+			BalanceUUID: uuid,
+			BalanceID:   id,
+			Before:      before,
+			After:       after,
+			Delta:       after - before,
+			Source:      cause.Source,
+			ActionsID:   cause.ActionsID,
+			ActionID:    cause.ActionID,
+			CGRID:       cause.CGRID,
+		}
+	}
+	current := make(utils.StringSet)
+	for blcType, blcs := range acc.BalanceMap {
+		for _, b := range blcs {
+			current.Add(b.Uuid)
+			var before float64
+			if jb, has := jbs[b.Uuid]; has {
+				before = jb.value
+			}
+			if after := b.GetValue(); after != before {
+				jes = append(jes, newEntry(blcType, b.Uuid, b.ID, before, after))
+			}
+		}
+	}
+	for uuid, jb := range jbs { // removed balances
+		if !current.Has(uuid) && jb.value != 0 {
+			jes = append(jes, newEntry(jb.blcType, uuid, jb.id, jb.value, 0))
+		}
+	}
+	sort.Slice(jes, func(i, j int) bool {
+		return jes[i].BalanceUUID < jes[j].BalanceUUID
+	})
+	return
+}
+
+// exclude moves the snapshot over the changes journaled separately (ie: by action triggers)
+func (snp *balanceSnapshot) exclude(jes []*BalanceJournalEntry) {
+	if snp == nil {
+		return
+	}
+	for _, je := range jes {
+		jb, has := snp.balances[je.BalanceUUID]
+		if !has {
+			jb = &journaledBalance{
+				blcType: je.BalanceType,
+				id:      je.BalanceID,
+			}
+			snp.balances[je.BalanceUUID] = jb
+		}
+		jb.value += je.Delta
+	}
+}
+
+// storeBalanceJournal writes the journal entries in StorDB
+func storeBalanceJournal(jes []*BalanceJournalEntry) {
+	if len(jes) == 0 || cdrStorage == nil {
+		return
+	}
+	now := time.Now()
+	for _, je := range jes {
+		je.Time = now
+	}
+	if err := cdrStorage.SetBalanceJournalEntries(jes); err != nil {
+		utils.Logger.Warning(
+			fmt.Sprintf("<%s> failed storing the balance journal of account <%s:%s>, error: %s",
+				utils.RALs, jes[0].Tenant, jes[0].Account, err))
+	}
+}
+
+// journalBalances stores the balance changes done since the snapshot was taken
+func (snp *balanceSnapshot) journalBalances(cause *BalanceJournalCause) {
+	storeBalanceJournal(snp.entries(cause))
+}
+
+// JournalBalances runs f over the account and journals the balance changes done by it
+func JournalBalances(acc *Account, cause *BalanceJournalCause, f func() error) (err error) {
+	snp := newBalanceSnapshot(acc)
+	if err = f(); err != nil {
+		return
+	}
+	snp.journalBalances(cause)
+	return
+}
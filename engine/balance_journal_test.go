@@ -0,0 +1,168 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/utils"
+)
+
+func TestBalanceJournal(t *testing.T) {
+	tmpCdr := cdrStorage
+	cfg := config.NewDefaultCGRConfig()
+	cfg.RalsCfg().BalanceJournal = true
+	config.SetCgrConfig(cfg)
+	db, err := NewInternalDB(nil, nil, false, nil, cfg.StorDbCfg().Items)
+	if err != nil {
+		t.Fatal(err)
+	}
+	SetCdrStorage(db)
+	defer func() {
+		cdrStorage = tmpCdr
+		config.SetCgrConfig(config.NewDefaultCGRConfig())
+	}()
+
+	acc := &Account{
+		ID: "cgrates.org:1001",
+		BalanceMap: map[string]Balances{
+			utils.MetaMonetary: {
+				{Uuid: "uuid1", ID: "b1", Value: 10},
+				{Uuid: "uuid2", ID: "b2", Value: 5},
+			},
+		},
+	}
+	if err = JournalBalances(acc, &BalanceJournalCause{Source: utils.RALs, CGRID: "cgrid1"}, func() error {
+		acc.BalanceMap[utils.MetaMonetary][0].SubtractValue(3)
+		// trigger changing the balances, journaled on its own
+		trgSnp := newBalanceSnapshot(acc)
+		acc.BalanceMap[utils.MetaMonetary] = append(acc.BalanceMap[utils.MetaMonetary],
+			&Balance{Uuid: "uuid3", ID: "b3", Value: 2})
+		trgSnp.journalBalances(&BalanceJournalCause{Source: utils.RALs, ActionsID: "TOPUP", ActionID: "act1"})
+		acc.BalanceMap[utils.MetaMonetary] = acc.BalanceMap[utils.MetaMonetary][:1]
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if acc.balanceSnapshot != nil {
+		t.Error("snapshot not released")
+	}
+	if err = JournalBalances(acc, &BalanceJournalCause{Source: utils.RALs}, func() error {
+		return utils.ErrNotFound
+	}); err != utils.ErrNotFound {
+		t.Errorf("expected %v, received %v", utils.ErrNotFound, err)
+	}
+
+	jes, err := db.GetBalanceJournal(&utils.BalanceJournalFilter{Tenant: "cgrates.org", Account: "1001"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	type change struct {
+		uuid                 string
+		before, after, delta float64
+		actionID, cgrID      string
+	}
+	exp := []change{
+		{"uuid3", 0, 2, 2, "act1", ""},
+		{"uuid1", 10, 7, -3, "", "cgrid1"},
+		{"uuid2", 5, 0, -5, "", "cgrid1"},
+		{"uuid3", 2, 0, -2, "", "cgrid1"},
+	}
+	if len(jes) != len(exp) {
+		t.Fatalf("expected %d entries, received: %s", len(exp), utils.ToJSON(jes))
+	}
+	for i, je := range jes {
+		if rcv := (change{je.BalanceUUID, je.Before, je.After, je.Delta, je.ActionID, je.CGRID}); rcv != exp[i] {
+			t.Errorf("entry %d: expected %+v, received %+v", i, exp[i], rcv)
+		}
+		if je.Time.IsZero() {
+			t.Errorf("entry %d without time", i)
+		}
+	}
+
+	// pagination
+	limit, offset := 1, 1
+	if jes, err = db.GetBalanceJournal(&utils.BalanceJournalFilter{Tenant: "cgrates.org", Account: "1001",
+		Paginator: utils.Paginator{Limit: &limit, Offset: &offset}}); err != nil {
+		t.Fatal(err)
+	} else if len(jes) != 1 || jes[0].BalanceUUID != "uuid1" {
+		t.Errorf("unexpected page: %s", utils.ToJSON(jes))
+	}
+	// time range
+	future := time.Now().Add(time.Hour)
+	if _, err = db.GetBalanceJournal(&utils.BalanceJournalFilter{Tenant: "cgrates.org", Account: "1001",
+		Time: utils.TimeInterval{Begin: &future}}); !errors.Is(err, utils.ErrNotFound) {
+		t.Errorf("expected %v, received %v", utils.ErrNotFound, err)
+	}
+	if _, err = db.GetBalanceJournal(&utils.BalanceJournalFilter{Tenant: "cgrates.org", Account: "1002"}); !errors.Is(err, utils.ErrNotFound) {
+		t.Errorf("expected %v, received %v", utils.ErrNotFound, err)
+	}
+
+	// disabled journal
+	cfg.RalsCfg().BalanceJournal = false
+	if snp := newBalanceSnapshot(acc); snp != nil {
+		t.Errorf("expected no snapshot, received: %+v", snp)
+	}
+}
+
+func TestBalanceJournalActionError(t *testing.T) {
+	tmpCdr := cdrStorage
+	cfg := config.NewDefaultCGRConfig()
+	cfg.RalsCfg().BalanceJournal = true
+	config.SetCgrConfig(cfg)
+	db, err := NewInternalDB(nil, nil, false, nil, cfg.StorDbCfg().Items)
+	if err != nil {
+		t.Fatal(err)
+	}
+	SetCdrStorage(db)
+	defer func() {
+		cdrStorage = tmpCdr
+		config.SetCgrConfig(config.NewDefaultCGRConfig())
+	}()
+	// debits the balance before failing
+	RegisterActionFunc("*debit_and_fail", func(acc *Account, _ *Action, _ Actions, _ *FilterS, _ any, _ SharedActionsData, _ ActionConnCfg) error {
+		acc.BalanceMap[utils.MetaMonetary][0].SubtractValue(2)
+		return utils.ErrPartiallyExecuted
+	})
+	defer delete(actionFuncMap, "*debit_and_fail")
+	if err = dm.SetActions("ACT_JOURNAL_FAIL", Actions{{Id: "act1", ActionType: "*debit_and_fail"}}); err != nil {
+		t.Fatal(err)
+	}
+	acc := &Account{
+		ID: "cgrates.org:1003",
+		BalanceMap: map[string]Balances{
+			utils.MetaMonetary: {{Uuid: "uuid1", ID: "b1", Value: 10}},
+		},
+	}
+	at := &ActionTrigger{ID: "TRG_JOURNAL_FAIL", ActionsID: "ACT_JOURNAL_FAIL"}
+	if err = at.Execute(acc, nil); err != nil {
+		t.Fatal(err)
+	}
+	if acc.balanceSnapshot != nil {
+		t.Error("snapshot not released")
+	}
+	if jes, err := db.GetBalanceJournal(&utils.BalanceJournalFilter{Tenant: "cgrates.org", Account: "1003"}); err != nil {
+		t.Fatal(err)
+	} else if len(jes) != 1 || jes[0].Before != 10 || jes[0].After != 8 || jes[0].ActionID != "act1" {
+		t.Errorf("unexpected entries: %s", utils.ToJSON(jes))
+	}
+}
@@ -46,9 +46,11 @@ type Balance struct {
 	Disabled       bool
 	Factors        ValueFactors
 	Blocker        bool
+	Currency       string `json:",omitempty"` // ISO 4217 currency of the *monetary balances
 	precision      int
 	account        *Account // used to store ub reference for shared balances
 	dirty          bool
+	held           float64 // value reserved by the account holds while debiting
 }
 
 func (b *Balance) Equal(o *Balance) bool {
@@ -67,7 +69,8 @@ func (b *Balance) Equal(o *Balance) bool {
 		b.Categories.Equal(o.Categories) &&
 		b.SharedGroups.Equal(o.SharedGroups) &&
 		b.Disabled == o.Disabled &&
-		b.Blocker == o.Blocker
+		b.Blocker == o.Blocker &&
+		b.Currency == o.Currency
 }
 
 func (b *Balance) MatchFilter(o *BalanceFilter, bType string, skipIds, skipExpiry bool) bool {
@@ -97,7 +100,8 @@ func (b *Balance) MatchFilter(o *BalanceFilter, bType string, skipIds, skipExpir
 		(o.Categories == nil || b.Categories.Includes(*o.Categories)) &&
 		(o.TimingIDs == nil || b.TimingIDs.Includes(*o.TimingIDs)) &&
 		(o.SharedGroups == nil || b.SharedGroups.Includes(*o.SharedGroups)) &&
-		(o.RatingSubject == nil || b.RatingSubject == *o.RatingSubject)
+		(o.RatingSubject == nil || b.RatingSubject == *o.RatingSubject) &&
+		(o.Currency == nil || b.Currency == *o.Currency)
 }
 
 func (b *Balance) HardMatchFilter(o *BalanceFilter, skipIds bool) bool {
@@ -118,7 +122,8 @@ func (b *Balance) HardMatchFilter(o *BalanceFilter, skipIds bool) bool {
 		(o.Categories == nil || b.Categories.Equal(*o.Categories)) &&
 		(o.TimingIDs == nil || b.TimingIDs.Equal(*o.TimingIDs)) &&
 		(o.SharedGroups == nil || b.SharedGroups.Equal(*o.SharedGroups)) &&
-		(o.RatingSubject == nil || b.RatingSubject == *o.RatingSubject)
+		(o.RatingSubject == nil || b.RatingSubject == *o.RatingSubject) &&
+		(o.Currency == nil || b.Currency == *o.Currency)
 }
 
 // the default balance has standard Id
@@ -196,6 +201,7 @@ func (b *Balance) Clone() *Balance {
 		Disabled:       b.Disabled,
 		DestinationIDs: b.DestinationIDs,
 		Factors:        b.Factors,
+		Currency:       b.Currency,
 		dirty:          b.dirty,
 	}
 	if b.DestinationIDs != nil {
@@ -296,6 +302,11 @@ func (b *Balance) GetValue() float64 {
 	return b.Value
 }
 
+// availableValue returns the value which is not reserved by the account holds
+func (b *Balance) availableValue() float64 {
+	return b.GetValue() - b.held
+}
+
 func (b *Balance) AddValue(amount float64) {
 	b.SetValue(b.GetValue() + amount)
 }
@@ -324,6 +335,7 @@ func (b *Balance) AsBalanceSummary(typ string) *BalanceSummary {
 		Weight:   b.Weight,
 		Disabled: b.Disabled,
 		Factors:  b.Factors,
+		Currency: b.Currency,
 	}
 	if bd.ID == "" {
 		bd.ID = b.Uuid
@@ -440,6 +452,7 @@ type BalanceSummary struct {
 	Weight   float64 `json:",omitempty"`
 	Disabled bool
 	Factors  ValueFactors `json:",omitempty"`
+	Currency string       `json:",omitempty"`
 }
 
 // BalanceSummaries is a list of BalanceSummaries
@@ -483,6 +496,8 @@ func (bl *BalanceSummary) FieldAsInterface(fldPath []string) (val any, err error
 			return bl.Factors, nil
 		}
 		return bl.Factors.FieldAsInterface(fldPath[1:])
+	case utils.Currency:
+		return bl.Currency, nil
 	}
 }
 
@@ -490,7 +505,7 @@ func (bl *BalanceSummary) FieldAsInterface(fldPath []string) (val any, err error
 // returns the amount debited within cc
 func (b *Balance) debit(cd *CallDescriptor, ub *Account, moneyBalances Balances,
 	count, dryRun, debitConnectFee, isUnitBal bool, fltrS *FilterS) (cc *CallCost, err error) {
-	if !b.IsActiveAt(cd.TimeStart) || b.GetValue() <= 0 {
+	if !b.IsActiveAt(cd.TimeStart) || b.availableValue() <= 0 {
 		return
 	}
 	tor := cd.ToR
@@ -545,7 +560,7 @@ func (b *Balance) debit(cd *CallDescriptor, ub *Account, moneyBalances Balances,
 			if bFactor != 1 {
 				amount = utils.Round(amount*bFactor, globalRoundingDecimals, utils.MetaRoundingUp)
 			}
-			if b.GetValue() >= amount {
+			if b.availableValue() >= amount {
 				b.SubtractValue(amount)
 				inc.BalanceInfo.Unit = &UnitInfo{
 					UUID:          b.Uuid,
@@ -591,7 +606,9 @@ func (b *Balance) debit(cd *CallDescriptor, ub *Account, moneyBalances Balances,
 	//log.Print("cc: " + utils.ToJSON(cc))
 	if debitConnectFee {
 		// this is the first add, debit the connect fee
-		if connectFeeDebited, debitedConnectFeeBalance = ub.DebitConnectionFee(cc, moneyBalances, count, true, fltrS); !connectFeeDebited {
+		if connectFeeDebited, debitedConnectFeeBalance, err = ub.DebitConnectionFee(cc, moneyBalances, count, true, fltrS); err != nil {
+			return nil, err
+		} else if !connectFeeDebited {
 			// balance is blocker
 			return nil, nil
 		}
@@ -623,9 +640,10 @@ func (b *Balance) debit(cd *CallDescriptor, ub *Account, moneyBalances Balances,
 				Cost:     ts.RateInterval.Rating.ConnectFee,
 				BalanceInfo: &DebitInfo{
 					Monetary: &MonetaryInfo{
-						UUID:  debitedConnectFeeBalance.Uuid,
-						ID:    debitedConnectFeeBalance.ID,
-						Value: debitedConnectFeeBalance.Value,
+						UUID:         debitedConnectFeeBalance.Uuid,
+						ID:           debitedConnectFeeBalance.ID,
+						Value:        debitedConnectFeeBalance.Value,
+						ExchangeRate: cc.connectFeeExRate,
 					},
 					AccountID: ub.ID,
 				},
@@ -683,14 +701,20 @@ func (b *Balance) debit(cd *CallDescriptor, ub *Account, moneyBalances Balances,
 			amount := float64(inc.Duration)
 			cost := inc.Cost
 
-			canDebitCost := b.GetValue() >= cost
+			var canDebitCost bool
 			var moneyBal *Balance
+			var debitCost, exRate float64 // cost in the currency of the monetary balance
 			if isUnitBal {
 				if bFactor != 1 {
 					amount = utils.Round(amount*bFactor, globalRoundingDecimals, utils.MetaRoundingUp)
 				}
 				for _, mb := range moneyBalances {
-					if mb.GetValue() >= cost {
+					var errCnv error
+					if debitCost, exRate, errCnv = mb.convertCost(cost, ts.Currency, ts.TimeStart); errCnv != nil {
+						utils.Logger.Warning(fmt.Sprintf("<%s> skipping balance <%s> for the cost: %s", utils.RALs, mb.ID, errCnv))
+						continue
+					}
+					if mb.availableValue() >= debitCost {
 						moneyBal = mb
 						break
 					}
@@ -700,8 +724,17 @@ func (b *Balance) debit(cd *CallDescriptor, ub *Account, moneyBalances Balances,
 						utils.Logger.Warning(fmt.Sprintf("<RALs> Going negative on account %s with AllowNegative: false", cd.GetAccountKey()))
 					}
 					moneyBal = ub.GetDefaultMoneyBalance()
+					if debitCost, exRate, err = moneyBal.convertCost(cost, ts.Currency, ts.TimeStart); err != nil {
+						return nil, err
+					}
 				}
-				canDebitCost = b.GetValue() >= amount && (moneyBal != nil || cost == 0)
+				canDebitCost = b.availableValue() >= amount && (moneyBal != nil || cost == 0)
+			} else if debitCost, exRate, err = b.convertCost(cost, ts.Currency, ts.TimeStart); err != nil {
+				// no exchange rate, leave the cost to the next balances
+				utils.Logger.Warning(fmt.Sprintf("<%s> skipping balance <%s> for the cost: %s", utils.RALs, b.ID, err))
+				err = nil
+			} else {
+				canDebitCost = b.availableValue() >= debitCost
 			}
 			if !canDebitCost {
 				// delete the rest of the unpaid increments/timespans
@@ -733,34 +766,36 @@ func (b *Balance) debit(cd *CallDescriptor, ub *Account, moneyBalances Balances,
 				}
 				inc.BalanceInfo.AccountID = ub.ID
 				if cost != 0 {
-					moneyBal.SubtractValue(cost)
+					moneyBal.SubtractValue(debitCost)
 					inc.BalanceInfo.Monetary = &MonetaryInfo{
-						UUID:  moneyBal.Uuid,
-						ID:    moneyBal.ID,
-						Value: moneyBal.Value,
+						UUID:         moneyBal.Uuid,
+						ID:           moneyBal.ID,
+						Value:        moneyBal.Value,
+						ExchangeRate: exRate,
 					}
 					cd.MaxCostSoFar += cost
 				}
 				if count {
 					ub.countUnits(amount, cc.ToR, cc, b, fltrS)
 					if cost != 0 {
-						ub.countUnits(cost, utils.MetaMonetary, cc, moneyBal, fltrS)
+						ub.countUnits(debitCost, utils.MetaMonetary, cc, moneyBal, fltrS)
 					}
 				}
 			} else { // monetary balance
-				b.SubtractValue(cost)
+				b.SubtractValue(debitCost)
 				cd.MaxCostSoFar += cost
 				inc.BalanceInfo.Monetary = &MonetaryInfo{
-					UUID:  b.Uuid,
-					ID:    b.ID,
-					Value: b.Value,
+					UUID:         b.Uuid,
+					ID:           b.ID,
+					Value:        b.Value,
+					ExchangeRate: exRate,
 				}
 				inc.BalanceInfo.AccountID = ub.ID
 				if b.RatingSubject != "" {
 					inc.BalanceInfo.Monetary.RateInterval = ts.RateInterval
 				}
 				if count {
-					ub.countUnits(cost, utils.MetaMonetary, cc, b, fltrS)
+					ub.countUnits(debitCost, utils.MetaMonetary, cc, b, fltrS)
 				}
 			}
 		}
@@ -775,7 +810,7 @@ func (b *Balance) debit(cd *CallDescriptor, ub *Account, moneyBalances Balances,
 // returns the amount debited within cc
 func (b *Balance) debitFromCost(cd *CallDescriptor, ub *Account, moneyBalances Balances,
 	count, dryRun, debitConnectFee bool, fltrS *FilterS, cdrCostToBeDebited float64) (cc *CallCost, costRemaining float64, err error) {
-	if !b.IsActiveAt(cd.TimeStart) || b.GetValue() <= 0 {
+	if !b.IsActiveAt(cd.TimeStart) || b.availableValue() <= 0 {
 		return nil, cdrCostToBeDebited, nil
 	}
 
@@ -883,7 +918,7 @@ func (b *Balance) debitFromCost(cd *CallDescriptor, ub *Account, moneyBalances B
 			// debit money
 			cost := inc.Cost
 
-			canDebitCost := simBalVal >= cost
+			canDebitCost := simBalVal-b.held >= cost
 			if !canDebitCost {
 				// delete the rest of the unpaid increments/timespans
 				if incIndex == 0 {
@@ -1110,6 +1145,11 @@ func (b *Balance) FieldAsInterface(fldPath []string) (val any, err error) {
 			return nil, utils.ErrNotFound
 		}
 		return b.Blocker, nil
+	case utils.Currency:
+		if len(fldPath) != 1 {
+			return nil, utils.ErrNotFound
+		}
+		return b.Currency, nil
 	}
 }
 
@@ -127,6 +127,11 @@ func init() {
 	gob.Register(new(TrendWithAPIOpts))
 	gob.Register(new(SetBackupSessionsArgs))
 	gob.Register(new(RemoveSessionBackupArgs))
+	gob.Register(new(ExchangeRateWithAPIOpts))
+	gob.Register(new(TaxProfileWithAPIOpts))
+	gob.Register(new(FraudProfileWithAPIOpts))
+	gob.Register(new(PortedNumberWithAPIOpts))
+	gob.Register(new(LookupTableWithAPIOpts))
 	gob.Register(new(utils.GetIndexesArg))
 	gob.Register(new(utils.SetIndexesArg))
 	gob.Register(new(utils.LoadIDsWithAPIOpts))
@@ -40,6 +40,7 @@ type CallCost struct {
 	deductConnectFee   bool
 	negativeConnectFee bool // the connect fee went negative on default balance
 	maxCostDisconect   bool
+	connectFeeExRate   float64 // exchange rate applied when debiting the connect fee
 }
 
 // Merges the received timespan if they are similar (same activation period, same interval, same minute info.
@@ -87,6 +88,14 @@ func (cc *CallCost) GetConnectFee() float64 {
 	return cc.Timespans[0].RateInterval.Rating.ConnectFee
 }
 
+// getConnectFeeCurrency returns the currency the connect fee was rated in
+func (cc *CallCost) getConnectFeeCurrency() string {
+	if len(cc.Timespans) == 0 {
+		return utils.EmptyString
+	}
+	return cc.Timespans[0].Currency
+}
+
 // Creates a CallDescriptor structure copying related data from CallCost
 func (cc *CallCost) CreateCallDescriptor() *CallDescriptor {
 	return &CallDescriptor{
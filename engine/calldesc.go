@@ -620,6 +620,9 @@ func (origCD *CallDescriptor) getMaxSessionDuration(origAcc *Account, fltrS *Fil
 	if account.AllowNegative {
 		return -1, nil
 	}
+	// the held values are not available for the session
+	account.reserveHolds()
+	account.Holds = nil
 	// for zero duration index
 	if origCD.DurationIndex < origCD.TimeEnd.Sub(origCD.TimeStart) {
 		origCD.DurationIndex = origCD.TimeEnd.Sub(origCD.TimeStart)
@@ -632,7 +635,7 @@ func (origCD *CallDescriptor) getMaxSessionDuration(origAcc *Account, fltrS *Fil
 	defaultBalance := account.GetDefaultMoneyBalance()
 
 	//use this to check what increment was payed with debt
-	initialDefaultBalanceValue := defaultBalance.GetValue()
+	initialDefaultBalanceValue := defaultBalance.availableValue()
 
 	cc, err := cd.debit(account, true, false, fltrS)
 	if err != nil {
@@ -723,6 +726,10 @@ func (cd *CallDescriptor) debit(account *Account, dryRun bool, goNegative bool,
 	if cd.ToR == "" {
 		cd.ToR = utils.MetaVoice
 	}
+	var snp *balanceSnapshot
+	if !dryRun {
+		snp = newBalanceSnapshot(account)
+	}
 	//log.Printf("Debit CD: %+v", cd)
 	cc, err = account.debitCreditBalance(cd, !dryRun, dryRun, goNegative, fltrS)
 	//log.Printf("HERE: %+v %v", cc, err)
@@ -735,6 +742,7 @@ func (cd *CallDescriptor) debit(account *Account, dryRun bool, goNegative bool,
 	cc.Timespans.Compress()
 	if !dryRun {
 		dm.SetAccount(account)
+		snp.journalBalances(&BalanceJournalCause{Source: utils.RALs, CGRID: cd.CgrID})
 	}
 	if cd.PerformRounding {
 		cc.Round()
@@ -753,6 +761,10 @@ func (cd *CallDescriptor) debitMonetary(account *Account, dryRun bool, goNegativ
 	if cd.ToR == "" {
 		cd.ToR = utils.MetaVoice
 	}
+	var snp *balanceSnapshot
+	if !dryRun {
+		snp = newBalanceSnapshot(account)
+	}
 	//log.Printf("Debit CD: %+v", cd)
 	cc, err = account.debitMonetaryBalance(cd, !dryRun, dryRun, goNegative, fltrS, cost)
 	//log.Printf("HERE: %+v %v", cc, err)
@@ -765,6 +777,7 @@ func (cd *CallDescriptor) debitMonetary(account *Account, dryRun bool, goNegativ
 	cc.Timespans.Compress()
 	if !dryRun {
 		dm.SetAccount(account)
+		snp.journalBalances(&BalanceJournalCause{Source: utils.RALs, CGRID: cd.CgrID})
 	}
 	if cd.PerformRounding {
 		cc.Round()
@@ -920,6 +933,7 @@ func (cd *CallDescriptor) refundIncrements(fltrS *FilterS) (acnt *Account, err e
 			if acc, err := dm.GetAccount(increment.BalanceInfo.AccountID); err == nil && acc != nil {
 				account = acc
 				accountsCache[increment.BalanceInfo.AccountID] = account
+				defer newBalanceSnapshot(account).journalBalances(&BalanceJournalCause{Source: utils.RALs, CGRID: cd.CgrID})
 				// will save the account only once at the end of the function
 				defer dm.SetAccount(account)
 			}
@@ -947,8 +961,9 @@ func (cd *CallDescriptor) refundIncrements(fltrS *FilterS) (acnt *Account, err e
 				utils.Logger.Warning(fmt.Sprintf("Could not get the balnce: <%s> to be refunded for account: <%s>", increment.BalanceInfo.Monetary.UUID, increment.BalanceInfo.AccountID))
 				continue
 			}
-			balance.AddValue(increment.Cost)
-			account.countUnits(-increment.Cost, utils.MetaMonetary, cc, balance, fltrS)
+			refundCost := applyExchangeRate(increment.Cost, increment.BalanceInfo.Monetary.ExchangeRate)
+			balance.AddValue(refundCost)
+			account.countUnits(-refundCost, utils.MetaMonetary, cc, balance, fltrS)
 		}
 	}
 	acnt = accountsCache[utils.ConcatenatedKey(cd.Tenant, cd.Account)]
@@ -982,6 +997,7 @@ func (cd *CallDescriptor) refundRounding(old *Account, fltrS *FilterS) (accounts
 	accountsCache = make(map[string]*Account)
 	if old != nil {
 		accountsCache[old.ID] = old
+		defer newBalanceSnapshot(old).journalBalances(&BalanceJournalCause{Source: utils.RALs, CGRID: cd.CgrID})
 		defer dm.SetAccount(old)
 	}
 	for _, increment := range cd.Increments {
@@ -990,6 +1006,7 @@ func (cd *CallDescriptor) refundRounding(old *Account, fltrS *FilterS) (accounts
 			if acc, err := dm.GetAccount(increment.BalanceInfo.AccountID); err == nil && acc != nil {
 				account = acc
 				accountsCache[increment.BalanceInfo.AccountID] = account
+				defer newBalanceSnapshot(account).journalBalances(&BalanceJournalCause{Source: utils.RALs, CGRID: cd.CgrID})
 				// will save the account only once at the end of the function
 				defer dm.SetAccount(account)
 			}
@@ -1004,8 +1021,9 @@ func (cd *CallDescriptor) refundRounding(old *Account, fltrS *FilterS) (accounts
 			if balance = account.BalanceMap[utils.MetaMonetary].GetBalance(increment.BalanceInfo.Monetary.UUID); balance == nil {
 				return
 			}
-			balance.AddValue(-increment.Cost)
-			account.countUnits(increment.Cost, utils.MetaMonetary, cc, balance, fltrS)
+			roundingCost := applyExchangeRate(increment.Cost, increment.BalanceInfo.Monetary.ExchangeRate)
+			balance.AddValue(-roundingCost)
+			account.countUnits(roundingCost, utils.MetaMonetary, cc, balance, fltrS)
 		}
 	}
 	return
@@ -321,8 +321,16 @@ func (cdrS *CDRServer) getCostFromRater(cdr *CDRWithAPIOpts) (*CallCost, error)
 
 // rateCDRWithErr rates a CDR including errors
 func (cdrS *CDRServer) rateCDRWithErr(cdr *CDRWithAPIOpts) (ratedCDRs []*CDR) {
-	var err error
-	ratedCDRs, err = cdrS.rateCDR(cdr)
+	err := CheckCDRCostSplit(cdr.CDR) // before rating so the accounts are not debited
+	if err == nil {
+		ratedCDRs, err = cdrS.rateCDR(cdr)
+	}
+	for _, rtCDR := range ratedCDRs { // apply the cost share of the ChargerS run
+		if err != nil {
+			break
+		}
+		err = SplitCDRCost(rtCDR, cdrS.cgrCfg.GeneralCfg().RoundingDecimals)
+	}
 	if err != nil {
 		cdr.Cost = -1.0 // If there was an error, mark the CDR
 		cdr.ExtraInfo = err.Error()
@@ -429,6 +437,18 @@ func (cdrS *CDRServer) statSProcessEvent(cgrEv *utils.CGREvent) (err error) {
 	return
 }
 
+// fraudSProcessEvent will process the event with the FraudS component
+func (cdrS *CDRServer) fraudSProcessEvent(cgrEv *utils.CGREvent) (err error) {
+	var reply FraudReply
+	if err = cdrS.connMgr.Call(context.TODO(), cdrS.cgrCfg.CdrsCfg().FraudSConns,
+		utils.FraudSv1ProcessEvent,
+		cgrEv.Clone(), &reply); err != nil &&
+		err.Error() == utils.ErrNotFound.Error() {
+		err = nil // NotFound is not considered error
+	}
+	return
+}
+
 // eeSProcessEvent will process the event with the EEs component
 func (cdrS *CDRServer) eeSProcessEvent(cgrEv *CGREventWithEeIDs) (err error) {
 	var reply map[string]map[string]any
@@ -447,11 +467,13 @@ type cdrProcessingArgs struct {
 	chrgS     bool
 	refund    bool
 	ralS      bool
+	taxes     bool
 	store     bool
 	reRate    bool
 	export    bool
 	thdS      bool
 	stS       bool
+	frdS      bool
 	reprocess bool
 }
 
@@ -464,7 +486,9 @@ func newCDRProcessingArgs(cfg *config.CdrsCfg, flags utils.FlagsWithParams, opts
 		export: len(cfg.OnlineCDRExports) != 0 || len(cfg.EEsConns) != 0,
 		thdS:   len(cfg.ThresholdSConns) != 0,
 		stS:    len(cfg.StatSConns) != 0,
+		frdS:   len(cfg.FraudSConns) != 0,
 		ralS:   len(cfg.RaterConns) != 0,
+		taxes:  cfg.Taxes,
 	}
 	var err error
 	if v, has := opts[utils.OptsAttributeS]; has {
@@ -505,6 +529,14 @@ func newCDRProcessingArgs(cfg *config.CdrsCfg, flags utils.FlagsWithParams, opts
 	if flags.Has(utils.MetaStats) {
 		args.stS = flags.GetBool(utils.MetaStats)
 	}
+	if v, has := opts[utils.OptsFraudS]; has {
+		if args.frdS, err = utils.IfaceAsBool(v); err != nil {
+			return nil, err
+		}
+	}
+	if flags.Has(utils.MetaFrauds) {
+		args.frdS = flags.GetBool(utils.MetaFrauds)
+	}
 	if v, has := opts[utils.OptsRerate]; has {
 		if args.reRate, err = utils.IfaceAsBool(v); err != nil {
 			return nil, err
@@ -542,6 +574,14 @@ func newCDRProcessingArgs(cfg *config.CdrsCfg, flags utils.FlagsWithParams, opts
 	if flags.Has(utils.MetaRALs) {
 		args.ralS = flags.GetBool(utils.MetaRALs)
 	}
+	if v, has := opts[utils.OptsTaxes]; has {
+		if args.taxes, err = utils.IfaceAsBool(v); err != nil {
+			return nil, err
+		}
+	}
+	if flags.Has(utils.MetaTaxes) {
+		args.taxes = flags.GetBool(utils.MetaTaxes)
+	}
 	return args, nil
 }
 
@@ -587,6 +627,14 @@ func newCDRProcessingArgsNoCfg(flags utils.FlagsWithParams, opts map[string]any)
 	if flags.Has(utils.MetaStats) {
 		args.stS = flags.GetBool(utils.MetaStats)
 	}
+	if v, has := opts[utils.OptsFraudS]; has {
+		if args.frdS, err = utils.IfaceAsBool(v); err != nil {
+			return nil, err
+		}
+	}
+	if flags.Has(utils.MetaFrauds) {
+		args.frdS = flags.GetBool(utils.MetaFrauds)
+	}
 	if v, has := opts[utils.OptsRerate]; has {
 		if args.reRate, err = utils.IfaceAsBool(v); err != nil {
 			return nil, err
@@ -624,6 +672,14 @@ func newCDRProcessingArgsNoCfg(flags utils.FlagsWithParams, opts map[string]any)
 	if flags.Has(utils.MetaRALs) {
 		args.ralS = flags.GetBool(utils.MetaRALs)
 	}
+	if v, has := opts[utils.OptsTaxes]; has {
+		if args.taxes, err = utils.IfaceAsBool(v); err != nil {
+			return nil, err
+		}
+	}
+	if flags.Has(utils.MetaTaxes) {
+		args.taxes = flags.GetBool(utils.MetaTaxes)
+	}
 	return args, nil
 }
 
@@ -686,7 +742,7 @@ func (cdrS *CDRServer) processEvents(evs []*utils.CGREvent, args *cdrProcessingA
 	}
 	// Populate CDR list out of events
 	cdrs := make([]*CDR, len(cgrEvs))
-	if args.refund || args.ralS || args.store || args.reRate || args.export {
+	if args.refund || args.ralS || args.taxes || args.store || args.reRate || args.export {
 		for i, cgrEv := range cgrEvs {
 			if args.refund {
 				if _, has := cgrEv.Event[utils.CostDetails]; !has {
@@ -769,17 +825,38 @@ func (cdrS *CDRServer) processEvents(evs []*utils.CGREvent, args *cdrProcessingA
 			}
 		}
 	}
-	if args.store {
-		refundCDRCosts := func() { // will be used to refund all CDRs on errors
-			for _, cdr := range cdrs { // refund what we have charged since duplicates are not allowed
-				if _, errRfd := cdrS.refundEventCost(cdr.CostDetails,
-					cdr.RequestType, cdr.ToR); errRfd != nil {
-					utils.Logger.Warning(
-						fmt.Sprintf("<%s> error: <%s> refunding CDR %+v",
-							utils.CDRs, errRfd.Error(), utils.ToJSON(cdr)))
+	refundCDRCosts := func() { // will be used to refund all CDRs on errors
+		for _, cdr := range cdrs { // refund what we have charged since duplicates are not allowed
+			if _, errRfd := cdrS.refundEventCost(cdr.CostDetails,
+				cdr.RequestType, cdr.ToR); errRfd != nil {
+				utils.Logger.Warning(
+					fmt.Sprintf("<%s> error: <%s> refunding CDR %+v",
+						utils.CDRs, errRfd.Error(), utils.ToJSON(cdr)))
+			}
+		}
+	}
+	if args.taxes {
+		for i, cdr := range cdrs {
+			if _, taxed := cdr.ExtraFields[utils.MetaTaxes]; taxed && !args.ralS { // the cost includes the taxes already
+				continue
+			}
+			if err = TaxCDR(cdrS.dm, cdrS.filterS, cdr); err != nil {
+				utils.Logger.Warning(
+					fmt.Sprintf("<%s> error: <%s> applying taxes on CDR %+v",
+						utils.CDRs, err.Error(), utils.ToJSON(cdr)))
+				if args.ralS { // not stored, so the costs are refunded
+					refundCDRCosts()
 				}
+				err = utils.ErrPartiallyExecuted
+				return
 			}
+			cgrEv := cdr.AsCGREvent()
+			cgrEv.APIOpts = cgrEvs[i].APIOpts
+			cgrEvs[i] = cgrEv
+			procFlgs[i].Add(utils.MetaTaxes)
 		}
+	}
+	if args.store {
 		for _, cdr := range cdrs {
 			if err = cdrS.cdrDb.SetCDR(cdr, false); err != nil {
 				if err != utils.ErrExists || !args.reRate {
@@ -833,6 +910,20 @@ func (cdrS *CDRServer) processEvents(evs []*utils.CGREvent, args *cdrProcessingA
 			}
 		}
 	}
+	if args.frdS {
+		for _, cgrEv := range cgrEvs {
+			if runID, _ := cgrEv.FieldAsString(utils.RunID); runID != utils.EmptyString &&
+				runID != utils.MetaDefault { // score each CDR once, not each of its runs
+				continue
+			}
+			if err = cdrS.fraudSProcessEvent(cgrEv); err != nil {
+				utils.Logger.Warning(
+					fmt.Sprintf("<%s> error: <%s> processing event %+v with %s",
+						utils.CDRs, err.Error(), utils.ToJSON(cgrEv), utils.FraudS))
+				partiallyExecuted = true
+			}
+		}
+	}
 	if partiallyExecuted {
 		err = utils.ErrPartiallyExecuted
 	}
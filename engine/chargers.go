@@ -20,6 +20,7 @@ package engine
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/cgrates/birpc/context"
 	"github.com/cgrates/cgrates/config"
@@ -64,20 +65,24 @@ func (cS *ChargerService) matchingChargerProfilesForEvent(tnt string, cgrEv *uti
 	if err != nil {
 		return nil, err
 	}
+	expl := ExplanationFromOpts(cgrEv.APIOpts)
+	expl.SetCandidates(cpIDs.AsSlice())
 	matchingCPs := make(map[string]*ChargerProfile)
 	for cpID := range cpIDs {
 		cP, err := cS.dm.GetChargerProfile(tnt, cpID, true, true, utils.NonTransactional)
 		if err != nil {
 			if err == utils.ErrNotFound {
+				expl.Skip(cpID, ExplainReasonNotFound)
 				continue
 			}
 			return nil, err
 		}
 		if cP.ActivationInterval != nil && cgrEv.Time != nil &&
 			!cP.ActivationInterval.IsActiveAtTime(*cgrEv.Time) { // not active
+			expl.Skip(cpID, ExplainReasonInactive)
 			continue
 		}
-		if pass, err := cS.filterS.Pass(tnt, cP.FilterIDs,
+		if pass, err := cS.filterS.ExplainPass(expl, cpID, tnt, cP.FilterIDs,
 			evNm); err != nil {
 			return nil, err
 		} else if !pass {
@@ -111,6 +116,13 @@ func (cS *ChargerService) processEvent(tnt string, cgrEv *utils.CGREvent) (rply
 	if cPs, err = cS.matchingChargerProfilesForEvent(tnt, cgrEv); err != nil {
 		return nil, err
 	}
+	var usage *time.Duration
+	if usage, err = MapEvent(cgrEv.Event).GetDurationPtr(utils.Usage); err != nil &&
+		err != utils.ErrNotFound {
+		return nil, err
+	}
+	err = nil
+	splits := chargerSplits(cPs, usage)
 	rply = make([]*ChrgSProcessEventReply, len(cPs))
 	for i, cP := range cPs {
 		clonedEv := cgrEv.Clone()
@@ -122,6 +134,15 @@ func (cS *ChargerService) processEvent(tnt string, cgrEv *utils.CGREvent) (rply
 			CGREvent:        clonedEv,
 			AlteredFields:   []string{utils.MetaReqRunID},
 		}
+		if split, has := splits[cP.ID]; has {
+			split.splitEvent(clonedEv.Event)
+			if split.Type == utils.MetaUsage {
+				rply[i].AlteredFields = append(rply[i].AlteredFields,
+					utils.MetaReq+utils.NestingSep+utils.Usage)
+			}
+			rply[i].AlteredFields = append(rply[i].AlteredFields,
+				utils.MetaReq+utils.NestingSep+utils.MetaSplit)
+		}
 		if len(cP.AttributeIDs) == 1 && cP.AttributeIDs[0] == utils.MetaNone {
 			continue // AttributeS disabled
 		}
@@ -189,3 +210,25 @@ func (cS *ChargerService) V1GetChargersForEvent(ctx *context.Context, args *util
 	*rply = cPs
 	return
 }
+
+// V1ExplainEvent details why the ChargerProfiles were matched or not for the event
+func (cS *ChargerService) V1ExplainEvent(ctx *context.Context, args *utils.CGREvent,
+	reply *MatchExplanation) (err error) {
+	if args == nil {
+		return utils.NewErrMandatoryIeMissing(utils.CGREventString)
+	}
+	args, expl := explainArgs(args)
+	var cPs ChargerProfiles
+	if err = cS.V1GetChargersForEvent(ctx, args, &cPs); err != nil {
+		if err != utils.ErrNotFound {
+			return
+		}
+		err = nil
+	}
+	for _, cP := range cPs {
+		expl.Matched = append(expl.Matched, cP.ID)
+	}
+	expl.Sort()
+	*reply = *expl
+	return
+}
@@ -19,6 +19,8 @@ along with this program.  If not, see <https://www.gnu.org/licenses/>
 package engine
 
 import (
+	"time"
+
 	"github.com/cgrates/cgrates/utils"
 )
 
@@ -602,6 +604,74 @@ func (dbM *DataDBMock) RemoveSessionsBackupDrv(nodeID, tnt, cgrid string) error
 	return utils.ErrNotImplemented
 }
 
+func (dbM *DataDBMock) GetExchangeRateDrv(from, to string) (*ExchangeRate, error) {
+	return nil, utils.ErrNotImplemented
+}
+
+func (dbM *DataDBMock) SetExchangeRateDrv(*ExchangeRate) error {
+	return utils.ErrNotImplemented
+}
+
+func (dbM *DataDBMock) RemoveExchangeRateDrv(from, to string) error {
+	return utils.ErrNotImplemented
+}
+
+func (dbM *DataDBMock) GetTaxProfileDrv(tnt, id string) (*TaxProfile, error) {
+	return nil, utils.ErrNotImplemented
+}
+
+func (dbM *DataDBMock) SetTaxProfileDrv(*TaxProfile) error {
+	return utils.ErrNotImplemented
+}
+
+func (dbM *DataDBMock) RemoveTaxProfileDrv(tnt, id string) error {
+	return utils.ErrNotImplemented
+}
+
+func (dbM *DataDBMock) GetFraudProfileDrv(tnt, id string) (*FraudProfile, error) {
+	return nil, utils.ErrNotImplemented
+}
+
+func (dbM *DataDBMock) SetFraudProfileDrv(*FraudProfile) error {
+	return utils.ErrNotImplemented
+}
+
+func (dbM *DataDBMock) RemoveFraudProfileDrv(tnt, id string) error {
+	return utils.ErrNotImplemented
+}
+
+func (dbM *DataDBMock) GetPortedNumberDrv(string) (*PortedNumber, error) {
+	return nil, utils.ErrNotImplemented
+}
+
+func (dbM *DataDBMock) SetPortedNumberDrv(*PortedNumber) error {
+	return utils.ErrNotImplemented
+}
+
+func (dbM *DataDBMock) RemovePortedNumberDrv(string) error {
+	return utils.ErrNotImplemented
+}
+
+func (dbM *DataDBMock) GetLookupTableDrv(tnt, id string) (*LookupTable, error) {
+	return nil, utils.ErrNotImplemented
+}
+
+func (dbM *DataDBMock) SetLookupTableDrv(*LookupTable) error {
+	return utils.ErrNotImplemented
+}
+
+func (dbM *DataDBMock) RemoveLookupTableDrv(tnt, id string) error {
+	return utils.ErrNotImplemented
+}
+
+func (dbM *DataDBMock) AcquireLeaseDrv(*Lease, time.Time) (*Lease, bool, error) {
+	return nil, false, utils.ErrNotImplemented
+}
+
+func (dbM *DataDBMock) RateLimitDrv(string, time.Duration, time.Duration, time.Time, bool) (time.Duration, error) {
+	return 0, utils.ErrNotImplemented
+}
+
 func (dbM *DataDBMock) DumpDataDB() error {
 	return utils.ErrNotImplemented
 }
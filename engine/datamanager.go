@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/cgrates/baningo"
 	"github.com/cgrates/birpc/context"
@@ -56,6 +57,8 @@ var (
 		utils.ResourceProfilesPrefix:   {},
 		utils.IPProfilesPrefix:         {},
 		utils.TimingsPrefix:            {},
+		utils.PortedNumberPrefix:       {},
+		utils.LookupTablePrefix:        {},
 		utils.ResourcesPrefix:          {},
 		utils.IPAllocationsPrefix:      {},
 		utils.StatQueuePrefix:          {},
@@ -70,6 +73,8 @@ var (
 		utils.ChargerProfilePrefix:     {},
 		utils.DispatcherProfilePrefix:  {},
 		utils.DispatcherHostPrefix:     {},
+		utils.FraudProfilesPrefix:      {},
+		utils.TaxProfilesPrefix:        {},
 		utils.MetaDispatchers:          {}, // not realy a prefix as this is not stored in DB
 		utils.AttributeFilterIndexes:   {},
 		utils.ResourceFilterIndexes:    {},
@@ -79,6 +84,8 @@ var (
 		utils.RouteFilterIndexes:       {},
 		utils.ChargerFilterIndexes:     {},
 		utils.DispatcherFilterIndexes:  {},
+		utils.FraudFilterIndexes:       {},
+		utils.TaxFilterIndexes:         {},
 		utils.FilterIndexPrfx:          {},
 		utils.MetaAPIBan:               {}, // not realy a prefix as this is not stored in DB
 		utils.MetaNotSentryPeer:        {},
@@ -220,6 +227,11 @@ func (dm *DataManager) CacheDataFromDB(prfx string, ids []string, mustBeCached b
 			_, err = dm.GetRanking(tntID.Tenant, tntID.ID, false, true, utils.NonTransactional)
 		case utils.TimingsPrefix:
 			_, err = dm.GetTiming(dataID, true, utils.NonTransactional)
+		case utils.PortedNumberPrefix:
+			_, err = dm.GetPortedNumber(dataID, false, true, utils.NonTransactional)
+		case utils.LookupTablePrefix:
+			tntID := utils.NewTenantID(dataID)
+			_, err = dm.GetLookupTable(tntID.Tenant, tntID.ID, false, true, utils.NonTransactional)
 		case utils.ThresholdProfilePrefix:
 			tntID := utils.NewTenantID(dataID)
 			lkID := guardian.Guardian.GuardIDs("", config.CgrConfig().GeneralCfg().LockingTimeout, thresholdProfileLockKey(tntID.Tenant, tntID.ID))
@@ -248,6 +260,12 @@ func (dm *DataManager) CacheDataFromDB(prfx string, ids []string, mustBeCached b
 		case utils.ChargerProfilePrefix:
 			tntID := utils.NewTenantID(dataID)
 			_, err = dm.GetChargerProfile(tntID.Tenant, tntID.ID, false, true, utils.NonTransactional)
+		case utils.FraudProfilesPrefix:
+			tntID := utils.NewTenantID(dataID)
+			_, err = dm.GetFraudProfile(tntID.Tenant, tntID.ID, false, true, utils.NonTransactional)
+		case utils.TaxProfilesPrefix:
+			tntID := utils.NewTenantID(dataID)
+			_, err = dm.GetTaxProfile(tntID.Tenant, tntID.ID, false, true, utils.NonTransactional)
 		case utils.DispatcherProfilePrefix:
 			tntID := utils.NewTenantID(dataID)
 			_, err = dm.GetDispatcherProfile(tntID.Tenant, tntID.ID, false, true, utils.NonTransactional)
@@ -290,6 +308,18 @@ func (dm *DataManager) CacheDataFromDB(prfx string, ids []string, mustBeCached b
 				return
 			}
 			_, err = dm.GetIndexes(utils.CacheChargerFilterIndexes, tntCtx, false, true, idxKey)
+		case utils.FraudFilterIndexes:
+			var tntCtx, idxKey string
+			if tntCtx, idxKey, err = splitFilterIndex(dataID); err != nil {
+				return
+			}
+			_, err = dm.GetIndexes(utils.CacheFraudFilterIndexes, tntCtx, false, true, idxKey)
+		case utils.TaxFilterIndexes:
+			var tntCtx, idxKey string
+			if tntCtx, idxKey, err = splitFilterIndex(dataID); err != nil {
+				return
+			}
+			_, err = dm.GetIndexes(utils.CacheTaxFilterIndexes, tntCtx, false, true, idxKey)
 		case utils.DispatcherFilterIndexes:
 			var tntCtx, idxKey string
 			if tntCtx, idxKey, err = splitFilterIndex(dataID); err != nil {
@@ -4007,6 +4037,439 @@ func (dm *DataManager) SetBackupSessions(nodeID, tenant string,
 		}, itm)
 }
 
+// GetExchangeRate returns the exchange rate of the currency pair
+func (dm *DataManager) GetExchangeRate(from, to string) (er *ExchangeRate, err error) {
+	if dm == nil {
+		return nil, utils.ErrNoDatabaseConn
+	}
+	return dm.dataDB.GetExchangeRateDrv(from, to)
+}
+
+// SetExchangeRate stores the exchange rate with its rates sorted by effective time
+func (dm *DataManager) SetExchangeRate(er *ExchangeRate) (err error) {
+	if dm == nil {
+		return utils.ErrNoDatabaseConn
+	}
+	er.Sort()
+	if err = dm.dataDB.SetExchangeRateDrv(er); err != nil {
+		return
+	}
+	itm := config.CgrConfig().DataDbCfg().Items[utils.MetaExchangeRates]
+	return dm.replicator.replicate(
+		utils.ExchangeRatesPrefix, er.PairID(), // these are used to get the host IDs from cache
+		utils.ReplicatorSv1SetExchangeRate,
+		&ExchangeRateWithAPIOpts{
+			ExchangeRate: er,
+			APIOpts: utils.GenerateDBItemOpts(itm.APIKey, itm.RouteID,
+				utils.EmptyString, utils.EmptyString),
+		}, itm)
+}
+
+// RemoveExchangeRate removes the exchange rate of the currency pair
+func (dm *DataManager) RemoveExchangeRate(from, to string) (err error) {
+	if dm == nil {
+		return utils.ErrNoDatabaseConn
+	}
+	if err = dm.dataDB.RemoveExchangeRateDrv(from, to); err != nil {
+		return
+	}
+	itm := config.CgrConfig().DataDbCfg().Items[utils.MetaExchangeRates]
+	return dm.replicator.replicate(
+		utils.ExchangeRatesPrefix, utils.ConcatenatedKey(from, to),
+		utils.ReplicatorSv1RemoveExchangeRate,
+		&utils.StringWithAPIOpts{
+			Arg: utils.ConcatenatedKey(from, to),
+			APIOpts: utils.GenerateDBItemOpts(itm.APIKey, itm.RouteID,
+				utils.EmptyString, utils.EmptyString),
+		}, itm)
+}
+
+// GetTaxProfile returns the TaxProfile, reading it through the cache
+func (dm *DataManager) GetTaxProfile(tnt, id string, cacheRead, cacheWrite bool,
+	transactionID string) (tp *TaxProfile, err error) {
+	tntID := utils.ConcatenatedKey(tnt, id)
+	if cacheRead {
+		if x, ok := Cache.Get(utils.CacheTaxProfiles, tntID); ok {
+			if x == nil {
+				return nil, utils.ErrNotFound
+			}
+			return x.(*TaxProfile), nil
+		}
+	}
+	if dm == nil {
+		return nil, utils.ErrNoDatabaseConn
+	}
+	if tp, err = dm.dataDB.GetTaxProfileDrv(tnt, id); err != nil {
+		if err == utils.ErrNotFound && cacheWrite {
+			if errCh := Cache.Set(utils.CacheTaxProfiles, tntID, nil, nil,
+				cacheCommit(transactionID), transactionID); errCh != nil {
+				return nil, errCh
+			}
+		}
+		return nil, err
+	}
+	if cacheWrite {
+		if errCh := Cache.Set(utils.CacheTaxProfiles, tntID, tp, nil,
+			cacheCommit(transactionID), transactionID); errCh != nil {
+			return nil, errCh
+		}
+	}
+	return
+}
+
+// SetTaxProfile stores the TaxProfile, updating its filter indexes when withIndex is set
+func (dm *DataManager) SetTaxProfile(tp *TaxProfile, withIndex bool) (err error) {
+	if dm == nil {
+		return utils.ErrNoDatabaseConn
+	}
+	if withIndex {
+		if err = dm.checkFilters(tp.Tenant, tp.FilterIDs); err != nil {
+			// if we get a broken filter do not set the profile
+			return fmt.Errorf("%+s for item with ID: %+v",
+				err, tp.TenantID())
+		}
+	}
+	oldTp, err := dm.GetTaxProfile(tp.Tenant, tp.ID, true, false, utils.NonTransactional)
+	if err != nil && err != utils.ErrNotFound {
+		return err
+	}
+	if err = dm.dataDB.SetTaxProfileDrv(tp); err != nil {
+		return
+	}
+	if withIndex {
+		var oldFiltersIDs *[]string
+		if oldTp != nil {
+			oldFiltersIDs = &oldTp.FilterIDs
+		}
+		if err = updatedIndexes(dm, utils.CacheTaxFilterIndexes, tp.Tenant,
+			utils.EmptyString, tp.ID, oldFiltersIDs, tp.FilterIDs, false); err != nil {
+			return
+		}
+	}
+	itm := config.CgrConfig().DataDbCfg().Items[utils.MetaTaxProfiles]
+	return dm.replicator.replicate(
+		utils.TaxProfilesPrefix, tp.TenantID(), // these are used to get the host IDs from cache
+		utils.ReplicatorSv1SetTaxProfile,
+		&TaxProfileWithAPIOpts{
+			TaxProfile: tp,
+			APIOpts: utils.GenerateDBItemOpts(itm.APIKey, itm.RouteID,
+				config.CgrConfig().DataDbCfg().RplCache, utils.EmptyString),
+		}, itm)
+}
+
+// RemoveTaxProfile removes the TaxProfile together with its filter indexes when withIndex is set
+func (dm *DataManager) RemoveTaxProfile(tnt, id string, withIndex bool) (err error) {
+	if dm == nil {
+		return utils.ErrNoDatabaseConn
+	}
+	oldTp, err := dm.GetTaxProfile(tnt, id, true, false, utils.NonTransactional)
+	if err != nil && err != utils.ErrNotFound {
+		return err
+	}
+	if err = dm.dataDB.RemoveTaxProfileDrv(tnt, id); err != nil {
+		return
+	}
+	if oldTp == nil {
+		return utils.ErrNotFound
+	}
+	if withIndex {
+		if err = removeIndexFiltersItem(dm, utils.CacheTaxFilterIndexes, tnt, id, oldTp.FilterIDs); err != nil {
+			return
+		}
+		if err = removeItemFromFilterIndex(dm, utils.CacheTaxFilterIndexes,
+			tnt, utils.EmptyString, id, oldTp.FilterIDs); err != nil {
+			return
+		}
+	}
+	itm := config.CgrConfig().DataDbCfg().Items[utils.MetaTaxProfiles]
+	_ = dm.replicator.replicate(
+		utils.TaxProfilesPrefix, utils.ConcatenatedKey(tnt, id), // these are used to get the host IDs from cache
+		utils.ReplicatorSv1RemoveTaxProfile,
+		&utils.TenantIDWithAPIOpts{
+			TenantID: &utils.TenantID{Tenant: tnt, ID: id},
+			APIOpts: utils.GenerateDBItemOpts(itm.APIKey, itm.RouteID,
+				config.CgrConfig().DataDbCfg().RplCache, utils.EmptyString),
+		}, itm)
+	return
+}
+
+// GetFraudProfile returns the FraudProfile, reading it through the cache
+func (dm *DataManager) GetFraudProfile(tnt, id string, cacheRead, cacheWrite bool,
+	transactionID string) (fp *FraudProfile, err error) {
+	tntID := utils.ConcatenatedKey(tnt, id)
+	if cacheRead {
+		if x, ok := Cache.Get(utils.CacheFraudProfiles, tntID); ok {
+			if x == nil {
+				return nil, utils.ErrNotFound
+			}
+			return x.(*FraudProfile), nil
+		}
+	}
+	if dm == nil {
+		return nil, utils.ErrNoDatabaseConn
+	}
+	if fp, err = dm.dataDB.GetFraudProfileDrv(tnt, id); err != nil {
+		if err == utils.ErrNotFound && cacheWrite {
+			if errCh := Cache.Set(utils.CacheFraudProfiles, tntID, nil, nil,
+				cacheCommit(transactionID), transactionID); errCh != nil {
+				return nil, errCh
+			}
+		}
+		return nil, err
+	}
+	if cacheWrite {
+		if errCh := Cache.Set(utils.CacheFraudProfiles, tntID, fp, nil,
+			cacheCommit(transactionID), transactionID); errCh != nil {
+			return nil, errCh
+		}
+	}
+	return
+}
+
+// SetFraudProfile stores the FraudProfile, updating its filter indexes when withIndex is set
+func (dm *DataManager) SetFraudProfile(fp *FraudProfile, withIndex bool) (err error) {
+	if dm == nil {
+		return utils.ErrNoDatabaseConn
+	}
+	if withIndex {
+		if err = dm.checkFilters(fp.Tenant, fp.FilterIDs); err != nil {
+			// if we get a broken filter do not set the profile
+			return fmt.Errorf("%+s for item with ID: %+v",
+				err, fp.TenantID())
+		}
+	}
+	oldFp, err := dm.GetFraudProfile(fp.Tenant, fp.ID, true, false, utils.NonTransactional)
+	if err != nil && err != utils.ErrNotFound {
+		return err
+	}
+	if err = dm.dataDB.SetFraudProfileDrv(fp); err != nil {
+		return
+	}
+	if withIndex {
+		var oldFiltersIDs *[]string
+		if oldFp != nil {
+			oldFiltersIDs = &oldFp.FilterIDs
+		}
+		if err = updatedIndexes(dm, utils.CacheFraudFilterIndexes, fp.Tenant,
+			utils.EmptyString, fp.ID, oldFiltersIDs, fp.FilterIDs, false); err != nil {
+			return
+		}
+	}
+	itm := config.CgrConfig().DataDbCfg().Items[utils.MetaFraudProfiles]
+	return dm.replicator.replicate(
+		utils.FraudProfilesPrefix, fp.TenantID(), // these are used to get the host IDs from cache
+		utils.ReplicatorSv1SetFraudProfile,
+		&FraudProfileWithAPIOpts{
+			FraudProfile: fp,
+			APIOpts: utils.GenerateDBItemOpts(itm.APIKey, itm.RouteID,
+				config.CgrConfig().DataDbCfg().RplCache, utils.EmptyString),
+		}, itm)
+}
+
+// RemoveFraudProfile removes the FraudProfile together with its filter indexes when withIndex is set
+func (dm *DataManager) RemoveFraudProfile(tnt, id string, withIndex bool) (err error) {
+	if dm == nil {
+		return utils.ErrNoDatabaseConn
+	}
+	oldFp, err := dm.GetFraudProfile(tnt, id, true, false, utils.NonTransactional)
+	if err != nil && err != utils.ErrNotFound {
+		return err
+	}
+	if err = dm.dataDB.RemoveFraudProfileDrv(tnt, id); err != nil {
+		return
+	}
+	if oldFp == nil {
+		return utils.ErrNotFound
+	}
+	if withIndex {
+		if err = removeIndexFiltersItem(dm, utils.CacheFraudFilterIndexes, tnt, id, oldFp.FilterIDs); err != nil {
+			return
+		}
+		if err = removeItemFromFilterIndex(dm, utils.CacheFraudFilterIndexes,
+			tnt, utils.EmptyString, id, oldFp.FilterIDs); err != nil {
+			return
+		}
+	}
+	itm := config.CgrConfig().DataDbCfg().Items[utils.MetaFraudProfiles]
+	_ = dm.replicator.replicate(
+		utils.FraudProfilesPrefix, utils.ConcatenatedKey(tnt, id), // these are used to get the host IDs from cache
+		utils.ReplicatorSv1RemoveFraudProfile,
+		&utils.TenantIDWithAPIOpts{
+			TenantID: &utils.TenantID{Tenant: tnt, ID: id},
+			APIOpts: utils.GenerateDBItemOpts(itm.APIKey, itm.RouteID,
+				config.CgrConfig().DataDbCfg().RplCache, utils.EmptyString),
+		}, itm)
+	return
+}
+
+// GetPortedNumber returns the porting information of the number
+func (dm *DataManager) GetPortedNumber(number string, cacheRead, cacheWrite bool,
+	transactionID string) (pn *PortedNumber, err error) {
+	if cacheRead {
+		if x, ok := Cache.Get(utils.CachePortedNumbers, number); ok {
+			if x == nil {
+				return nil, utils.ErrNotFound
+			}
+			return x.(*PortedNumber), nil
+		}
+	}
+	if dm == nil {
+		return nil, utils.ErrNoDatabaseConn
+	}
+	if pn, err = dm.dataDB.GetPortedNumberDrv(number); err != nil {
+		if err == utils.ErrNotFound && cacheWrite {
+			if errCh := Cache.Set(utils.CachePortedNumbers, number, nil, nil,
+				cacheCommit(transactionID), transactionID); errCh != nil {
+				return nil, errCh
+			}
+		}
+		return nil, err
+	}
+	if cacheWrite {
+		if errCh := Cache.Set(utils.CachePortedNumbers, number, pn, nil,
+			cacheCommit(transactionID), transactionID); errCh != nil {
+			return nil, errCh
+		}
+	}
+	return
+}
+
+// SetPortedNumber stores the porting information of the number
+func (dm *DataManager) SetPortedNumber(pn *PortedNumber) (err error) {
+	if dm == nil {
+		return utils.ErrNoDatabaseConn
+	}
+	if err = dm.dataDB.SetPortedNumberDrv(pn); err != nil {
+		return
+	}
+	if err = dm.CacheDataFromDB(utils.PortedNumberPrefix, []string{pn.Number}, true); err != nil {
+		return
+	}
+	itm := config.CgrConfig().DataDbCfg().Items[utils.MetaPortedNumbers]
+	return dm.replicator.replicate(
+		utils.PortedNumberPrefix, pn.Number, // these are used to get the host IDs from cache
+		utils.ReplicatorSv1SetPortedNumber,
+		&PortedNumberWithAPIOpts{
+			PortedNumber: pn,
+			APIOpts: utils.GenerateDBItemOpts(itm.APIKey, itm.RouteID,
+				config.CgrConfig().DataDbCfg().RplCache, utils.EmptyString),
+		}, itm)
+}
+
+// RemovePortedNumber removes the porting information of the number
+func (dm *DataManager) RemovePortedNumber(number string) (err error) {
+	if dm == nil {
+		return utils.ErrNoDatabaseConn
+	}
+	if err = dm.dataDB.RemovePortedNumberDrv(number); err != nil {
+		return
+	}
+	if errCh := Cache.Remove(utils.CachePortedNumbers, number,
+		true, utils.NonTransactional); errCh != nil {
+		return errCh
+	}
+	itm := config.CgrConfig().DataDbCfg().Items[utils.MetaPortedNumbers]
+	return dm.replicator.replicate(
+		utils.PortedNumberPrefix, number, // these are used to get the host IDs from cache
+		utils.ReplicatorSv1RemovePortedNumber,
+		&utils.StringWithAPIOpts{
+			Arg:    number,
+			Tenant: config.CgrConfig().GeneralCfg().DefaultTenant,
+			APIOpts: utils.GenerateDBItemOpts(itm.APIKey, itm.RouteID,
+				config.CgrConfig().DataDbCfg().RplCache, utils.EmptyString),
+		}, itm)
+}
+
+// GetLookupTable returns the LookupTable
+func (dm *DataManager) GetLookupTable(tnt, id string, cacheRead, cacheWrite bool,
+	transactionID string) (lt *LookupTable, err error) {
+	tntID := utils.ConcatenatedKey(tnt, id)
+	if cacheRead {
+		if x, ok := Cache.Get(utils.CacheLookupTables, tntID); ok {
+			if x == nil {
+				return nil, utils.ErrNotFound
+			}
+			return x.(*LookupTable), nil
+		}
+	}
+	if dm == nil {
+		return nil, utils.ErrNoDatabaseConn
+	}
+	if lt, err = dm.dataDB.GetLookupTableDrv(tnt, id); err != nil {
+		if err == utils.ErrNotFound && cacheWrite {
+			if errCh := Cache.Set(utils.CacheLookupTables, tntID, nil, nil,
+				cacheCommit(transactionID), transactionID); errCh != nil {
+				return nil, errCh
+			}
+		}
+		return nil, err
+	}
+	if cacheWrite {
+		if errCh := Cache.Set(utils.CacheLookupTables, tntID, lt, nil,
+			cacheCommit(transactionID), transactionID); errCh != nil {
+			return nil, errCh
+		}
+	}
+	return
+}
+
+// SetLookupTable stores the LookupTable, replacing the previous one
+func (dm *DataManager) SetLookupTable(lt *LookupTable) (err error) {
+	if dm == nil {
+		return utils.ErrNoDatabaseConn
+	}
+	if err = dm.dataDB.SetLookupTableDrv(lt); err != nil {
+		return
+	}
+	itm := config.CgrConfig().DataDbCfg().Items[utils.MetaLookupTables]
+	return dm.replicator.replicate(
+		utils.LookupTablePrefix, lt.TenantID(), // these are used to get the host IDs from cache
+		utils.ReplicatorSv1SetLookupTable,
+		&LookupTableWithAPIOpts{
+			LookupTable: lt,
+			APIOpts: utils.GenerateDBItemOpts(itm.APIKey, itm.RouteID,
+				config.CgrConfig().DataDbCfg().RplCache, utils.EmptyString),
+		}, itm)
+}
+
+// RemoveLookupTable removes the LookupTable
+func (dm *DataManager) RemoveLookupTable(tnt, id string) (err error) {
+	if dm == nil {
+		return utils.ErrNoDatabaseConn
+	}
+	if err = dm.dataDB.RemoveLookupTableDrv(tnt, id); err != nil {
+		return
+	}
+	itm := config.CgrConfig().DataDbCfg().Items[utils.MetaLookupTables]
+	return dm.replicator.replicate(
+		utils.LookupTablePrefix, utils.ConcatenatedKey(tnt, id), // these are used to get the host IDs from cache
+		utils.ReplicatorSv1RemoveLookupTable,
+		&utils.TenantIDWithAPIOpts{
+			TenantID: &utils.TenantID{Tenant: tnt, ID: id},
+			APIOpts: utils.GenerateDBItemOpts(itm.APIKey, itm.RouteID,
+				config.CgrConfig().DataDbCfg().RplCache, utils.EmptyString),
+		}, itm)
+}
+
+// AcquireLease takes or renews the lease for its holder, returning the lease as stored in dataDB
+// leases are not replicated since they are only meaningful on the DataDB shared by the engines
+func (dm *DataManager) AcquireLease(lease *Lease) (stored *Lease, acquired bool, err error) {
+	if dm == nil {
+		return nil, false, utils.ErrNoDatabaseConn
+	}
+	return dm.dataDB.AcquireLeaseDrv(lease, time.Now())
+}
+
+// RateLimit applies the increment on the rate limiter shared within dataDB under key, unless dryRun,
+// returning the duration to retry after if the tolerance is exceeded
+// rate limiters are not replicated since they are only meaningful on the DataDB shared by the engines
+func (dm *DataManager) RateLimit(key string, increment, tolerance time.Duration, dryRun bool) (retryAfter time.Duration, err error) {
+	if dm == nil {
+		return 0, utils.ErrNoDatabaseConn
+	}
+	return dm.dataDB.RateLimitDrv(key, increment, tolerance, time.Now(), dryRun)
+}
+
 type RemoveSessionBackupArgs struct {
 	Tenant string // used as part of filter of DataDB query
 	NodeID string // used as part of filter of DataDB query
@@ -59,6 +59,9 @@ func NewEventCostFromCallCost(cc *CallCost, cgrID, runID string) (ec *EventCost)
 			utils.RatingPlanID:          ts.RatingPlanId,
 			utils.Subject:               ts.MatchedSubject,
 		}
+		if ts.Currency != utils.EmptyString {
+			rf[utils.Currency] = ts.Currency
+		}
 		isPause := ts.RatingPlanId == utils.MetaPause
 		cIl.RatingID = ec.ratingIDForRateInterval(ts.RateInterval, rf, isPause)
 		if len(ts.Increments) != 0 {
@@ -99,10 +102,11 @@ func (ec *EventCost) newChargingIncrement(incr *Increment, rf RatingMatchedFilte
 				rateID = ec.ratingIDForRateInterval(incr.BalanceInfo.Monetary.RateInterval, rf, isPause)
 			}
 			bc := &BalanceCharge{
-				AccountID:   incr.BalanceInfo.AccountID,
-				BalanceUUID: incr.BalanceInfo.Monetary.UUID,
-				Units:       incr.Cost,
-				RatingID:    rateID,
+				AccountID:    incr.BalanceInfo.AccountID,
+				BalanceUUID:  incr.BalanceInfo.Monetary.UUID,
+				Units:        incr.Cost,
+				RatingID:     rateID,
+				ExchangeRate: incr.BalanceInfo.Monetary.ExchangeRate,
 			}
 			if isPause {
 				ecUUID = utils.MetaPause
@@ -138,10 +142,11 @@ func (ec *EventCost) newChargingIncrement(incr *Increment, rf RatingMatchedFilte
 			rateID = ec.ratingIDForRateInterval(incr.BalanceInfo.Monetary.RateInterval, rf, isPause)
 		}
 		bc := &BalanceCharge{
-			AccountID:   incr.BalanceInfo.AccountID,
-			BalanceUUID: incr.BalanceInfo.Monetary.UUID,
-			Units:       incr.Cost,
-			RatingID:    rateID,
+			AccountID:    incr.BalanceInfo.AccountID,
+			BalanceUUID:  incr.BalanceInfo.Monetary.UUID,
+			Units:        incr.Cost,
+			RatingID:     rateID,
+			ExchangeRate: incr.BalanceInfo.Monetary.ExchangeRate,
 		}
 		if isPause {
 			cIt.AccountingID = utils.MetaPause
@@ -451,6 +456,9 @@ func (ec *EventCost) AsCallCost(tor string) *CallCost {
 			ts.MatchedPrefix = rfs[utils.DestinationPrefixName].(string)
 			ts.MatchedDestId = rfs[utils.DestinationID].(string)
 			ts.RatingPlanId = rfs[utils.RatingPlanID].(string)
+			if ccy, has := rfs[utils.Currency]; has {
+				ts.Currency = utils.IfaceAsString(ccy)
+			}
 		}
 		ts.RateInterval = ec.rateIntervalForRatingID(cIl.RatingID)
 
@@ -523,7 +531,7 @@ func (ec *EventCost) newIntervalFromCharge(cInc *ChargingIncrement) (incr *Incre
 		}
 	}
 	if cBC.ExtraChargeID != utils.MetaNone {
-		incr.BalanceInfo.Monetary = &MonetaryInfo{UUID: cBC.BalanceUUID}
+		incr.BalanceInfo.Monetary = &MonetaryInfo{UUID: cBC.BalanceUUID, ExchangeRate: cBC.ExchangeRate}
 		incr.BalanceInfo.Monetary.RateInterval = ec.rateIntervalForRatingID(cBC.RatingID)
 	}
 	return
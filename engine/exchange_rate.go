@@ -0,0 +1,140 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/cgrates/cgrates/utils"
+)
+
+// ExchangeRate keeps the rates converting the From currency into the To one
+type ExchangeRate struct {
+	From  string               // ISO 4217 currency code
+	To    string               // ISO 4217 currency code
+	Rates []*ExchangeRateValue // sorted by EffectiveTime
+}
+
+// ExchangeRateValue is the rate applied starting with the EffectiveTime
+type ExchangeRateValue struct {
+	EffectiveTime time.Time
+	Rate          float64
+}
+
+// ExchangeRateWithAPIOpts is used in replicatorV1 for dispatcher
+type ExchangeRateWithAPIOpts struct {
+	*ExchangeRate
+	APIOpts map[string]any
+}
+
+// PairID returns the ID of the currency pair
+func (er *ExchangeRate) PairID() string {
+	return utils.ConcatenatedKey(er.From, er.To)
+}
+
+// Sort orders the rates by their effective time
+func (er *ExchangeRate) Sort() {
+	slices.SortStableFunc(er.Rates, func(a, b *ExchangeRateValue) int {
+		return a.EffectiveTime.Compare(b.EffectiveTime)
+	})
+}
+
+// RateAt returns the rate effective at the given time
+func (er *ExchangeRate) RateAt(t time.Time) (rate float64, has bool) {
+	for _, erv := range er.Rates {
+		if erv.EffectiveTime.After(t) {
+			break
+		}
+		rate, has = erv.Rate, true
+	}
+	return
+}
+
+// Clone returns a clone of ExchangeRate
+func (er *ExchangeRate) Clone() *ExchangeRate {
+	if er == nil {
+		return nil
+	}
+	cln := &ExchangeRate{
+		From: er.From,
+		To:   er.To,
+	}
+	if er.Rates != nil {
+		cln.Rates = make([]*ExchangeRateValue, len(er.Rates))
+		for i, erv := range er.Rates {
+			cln.Rates[i] = &ExchangeRateValue{
+				EffectiveTime: erv.EffectiveTime,
+				Rate:          erv.Rate,
+			}
+		}
+	}
+	return cln
+}
+
+// CacheClone returns a clone of ExchangeRate used by ltcache CacheCloner
+func (er *ExchangeRate) CacheClone() any {
+	return er.Clone()
+}
+
+// exchangeRate returns the rate converting the from currency into the to one at the given time,
+// falling back on the inverse of the to/from pair
+func exchangeRate(from, to string, t time.Time) (rate float64, err error) {
+	if from == to {
+		return 1, nil
+	}
+	var er *ExchangeRate
+	if er, err = dm.GetExchangeRate(from, to); err == nil {
+		if rate, has := er.RateAt(t); has {
+			return rate, nil
+		}
+	} else if err != utils.ErrNotFound {
+		return
+	}
+	if er, err = dm.GetExchangeRate(to, from); err == nil {
+		if rate, has := er.RateAt(t); has && rate != 0 {
+			return 1 / rate, nil
+		}
+	} else if err != utils.ErrNotFound {
+		return
+	}
+	return 0, fmt.Errorf("no exchange rate from <%s> to <%s> effective at <%s>",
+		from, to, t.Format(time.RFC3339))
+}
+
+// convertCost returns the cost, rated in the ccy currency, in the currency of the balance
+// together with the exchange rate applied (0 if no conversion was needed)
+func (b *Balance) convertCost(cost float64, ccy string, t time.Time) (converted, rate float64, err error) {
+	if ccy == utils.EmptyString || b.Currency == utils.EmptyString || ccy == b.Currency {
+		return cost, 0, nil
+	}
+	if rate, err = exchangeRate(ccy, b.Currency, t); err != nil {
+		return
+	}
+	return applyExchangeRate(cost, rate), rate, nil
+}
+
+// applyExchangeRate converts the cost using the rate, 0 meaning no conversion
+func applyExchangeRate(cost, rate float64) float64 {
+	if rate == 0 {
+		return cost
+	}
+	return utils.Round(cost*rate, globalRoundingDecimals, utils.MetaRoundingMiddle)
+}
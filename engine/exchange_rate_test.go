@@ -0,0 +1,259 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cgrates/cgrates/utils"
+)
+
+func TestExchangeRateRateAt(t *testing.T) {
+	t0 := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	er := &ExchangeRate{
+		From: "EUR",
+		To:   "USD",
+		Rates: []*ExchangeRateValue{
+			{EffectiveTime: t0.AddDate(0, 1, 0), Rate: 1.2},
+			{EffectiveTime: t0, Rate: 1.1},
+		},
+	}
+	er.Sort()
+	if _, has := er.RateAt(t0.Add(-time.Second)); has {
+		t.Error("expected no rate before the first effective time")
+	}
+	if rate, has := er.RateAt(t0.AddDate(0, 0, 15)); !has || rate != 1.1 {
+		t.Errorf("expected 1.1, received %v", rate)
+	}
+	if rate, has := er.RateAt(t0.AddDate(0, 2, 0)); !has || rate != 1.2 {
+		t.Errorf("expected 1.2, received %v", rate)
+	}
+	if er.PairID() != "EUR:USD" {
+		t.Errorf("unexpected pair: %s", er.PairID())
+	}
+}
+
+func TestExchangeRateConvertCost(t *testing.T) {
+	tm := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	if err := dm.SetExchangeRate(&ExchangeRate{From: "EUR", To: "USD",
+		Rates: []*ExchangeRateValue{{EffectiveTime: tm.AddDate(0, -1, 0), Rate: 1.25}}}); err != nil {
+		t.Fatal(err)
+	}
+	defer dm.RemoveExchangeRate("EUR", "USD")
+
+	b := &Balance{Currency: "USD"}
+	if cost, rate, err := b.convertCost(10, "EUR", tm); err != nil {
+		t.Fatal(err)
+	} else if cost != 12.5 || rate != 1.25 {
+		t.Errorf("expected 12.5 at 1.25, received %v at %v", cost, rate)
+	}
+	// inverse pair
+	b = &Balance{Currency: "EUR"}
+	if cost, rate, err := b.convertCost(10, "USD", tm); err != nil {
+		t.Fatal(err)
+	} else if cost != 8 || rate != 0.8 {
+		t.Errorf("expected 8 at 0.8, received %v at %v", cost, rate)
+	}
+	// same or implicit currency
+	if cost, rate, err := b.convertCost(10, "EUR", tm); err != nil || cost != 10 || rate != 0 {
+		t.Errorf("unexpected conversion: %v at %v, err: %v", cost, rate, err)
+	}
+	if cost, rate, err := b.convertCost(10, utils.EmptyString, tm); err != nil || cost != 10 || rate != 0 {
+		t.Errorf("unexpected conversion: %v at %v, err: %v", cost, rate, err)
+	}
+	// no rate effective yet
+	if _, _, err := b.convertCost(10, "USD", tm.AddDate(-1, 0, 0)); err == nil {
+		t.Error("expected error for missing exchange rate")
+	}
+	if _, _, err := b.convertCost(10, "GBP", tm); err == nil {
+		t.Error("expected error for missing exchange rate")
+	}
+}
+
+func TestExchangeRateDebitAndRefund(t *testing.T) {
+	tStart := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	if err := dm.SetExchangeRate(&ExchangeRate{From: "EUR", To: "USD",
+		Rates: []*ExchangeRateValue{{EffectiveTime: tStart.AddDate(0, -1, 0), Rate: 2}}}); err != nil {
+		t.Fatal(err)
+	}
+	defer dm.RemoveExchangeRate("EUR", "USD")
+
+	cc := &CallCost{
+		Destination: "0723",
+		Timespans: []*TimeSpan{
+			{
+				TimeStart:     tStart,
+				TimeEnd:       tStart.Add(10 * time.Second),
+				DurationIndex: 10 * time.Second,
+				Currency:      "EUR",
+				RateInterval: &RateInterval{
+					Rating: &RIRate{
+						Rates: RateGroups{
+							&RGRate{GroupIntervalStart: 0,
+								Value:         1,
+								RateIncrement: 10 * time.Second,
+								RateUnit:      time.Second}}}},
+			},
+		},
+		ToR: utils.MetaVoice,
+	}
+	cd := &CallDescriptor{
+		TimeStart:     cc.Timespans[0].TimeStart,
+		TimeEnd:       cc.Timespans[0].TimeEnd,
+		Destination:   cc.Destination,
+		ToR:           cc.ToR,
+		DurationIndex: cc.GetDuration(),
+		testCallcost:  cc,
+	}
+	acc := &Account{ID: "cgrates.org:exr",
+		BalanceMap: map[string]Balances{
+			utils.MetaMonetary: {&Balance{Uuid: "usd", Value: 50, Currency: "USD"}},
+		}}
+	cc, err := acc.debitCreditBalance(cd, false, false, true, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if val := acc.BalanceMap[utils.MetaMonetary][0].GetValue(); val != 30 {
+		t.Errorf("expected 30 USD left, received %v", val)
+	}
+	inc := cc.Timespans[0].Increments[0]
+	if inc.Cost != 10 || inc.BalanceInfo.Monetary.ExchangeRate != 2 {
+		t.Errorf("unexpected increment: %s", utils.ToJSON(inc))
+	}
+	ec := NewEventCostFromCallCost(cc, "cgrid", utils.MetaDefault)
+	if bc := ec.Accounting[ec.Charges[0].Increments[0].AccountingID]; bc.ExchangeRate != 2 {
+		t.Errorf("expected the exchange rate in the EventCost, received: %s", utils.ToJSON(bc))
+	}
+	if ccy, err := ec.RatingFilters.FieldAsInterface([]string{ec.Rating[ec.Charges[0].RatingID].RatingFiltersID, utils.Currency}); err != nil ||
+		ccy != "EUR" {
+		t.Errorf("expected EUR rating currency, received %v, err: %v", ccy, err)
+	}
+
+	cd.Account, cd.Tenant = "exr", "cgrates.org"
+	if err = dm.SetAccount(acc); err != nil {
+		t.Fatal(err)
+	}
+	defer dm.RemoveAccount(acc.ID)
+	cd.Increments = cc.Timespans[0].Increments
+	if _, err = cd.refundIncrements(nil); err != nil {
+		t.Fatal(err)
+	}
+	if acc, err = dm.GetAccount(acc.ID); err != nil {
+		t.Fatal(err)
+	} else if val := acc.BalanceMap[utils.MetaMonetary][0].GetValue(); val != 50 {
+		t.Errorf("expected 50 USD after refund, received %v", val)
+	}
+}
+
+func TestExchangeRateDebitSkipsBalanceWithoutRate(t *testing.T) {
+	tStart := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	if err := dm.SetExchangeRate(&ExchangeRate{From: "EUR", To: "USD",
+		Rates: []*ExchangeRateValue{{EffectiveTime: tStart.AddDate(0, -1, 0), Rate: 2}}}); err != nil {
+		t.Fatal(err)
+	}
+	defer dm.RemoveExchangeRate("EUR", "USD")
+
+	cc := &CallCost{
+		Destination: "0723",
+		Timespans: []*TimeSpan{
+			{
+				TimeStart:     tStart,
+				TimeEnd:       tStart.Add(10 * time.Second),
+				DurationIndex: 10 * time.Second,
+				Currency:      "EUR",
+				RateInterval: &RateInterval{
+					Rating: &RIRate{
+						Rates: RateGroups{
+							&RGRate{GroupIntervalStart: 0,
+								Value:         1,
+								RateIncrement: 10 * time.Second,
+								RateUnit:      time.Second}}}},
+			},
+		},
+		ToR: utils.MetaVoice,
+	}
+	cd := &CallDescriptor{
+		TimeStart:     cc.Timespans[0].TimeStart,
+		TimeEnd:       cc.Timespans[0].TimeEnd,
+		Destination:   cc.Destination,
+		ToR:           cc.ToR,
+		DurationIndex: cc.GetDuration(),
+		testCallcost:  cc,
+	}
+	gbp := &Balance{Uuid: "gbp", Value: 50, Currency: "GBP"}
+	acc := &Account{ID: "cgrates.org:exr_skip",
+		BalanceMap: map[string]Balances{utils.MetaMonetary: {gbp}}}
+	if cc, err := gbp.debit(cd, acc, acc.BalanceMap[utils.MetaMonetary],
+		false, false, true, false, nil); err != nil {
+		t.Fatal(err)
+	} else if cc != nil {
+		t.Errorf("expected the cost left to the next balances, received: %s", utils.ToJSON(cc))
+	}
+	if val := gbp.GetValue(); val != 50 {
+		t.Errorf("expected 50 GBP untouched, received %v", val)
+	}
+}
+
+func TestExchangeRateDebitMissingRate(t *testing.T) {
+	tStart := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	newCD := func(connectFee float64) *CallDescriptor {
+		cc := &CallCost{
+			Destination: "0723",
+			Timespans: []*TimeSpan{
+				{
+					TimeStart:     tStart,
+					TimeEnd:       tStart.Add(10 * time.Second),
+					DurationIndex: 10 * time.Second,
+					Currency:      "EUR",
+					RateInterval: &RateInterval{
+						Rating: &RIRate{
+							ConnectFee: connectFee,
+							Rates: RateGroups{
+								&RGRate{GroupIntervalStart: 0,
+									Value:         1,
+									RateIncrement: 10 * time.Second,
+									RateUnit:      time.Second}}}},
+				},
+			},
+			ToR:              utils.MetaVoice,
+			deductConnectFee: connectFee != 0,
+		}
+		return &CallDescriptor{
+			TimeStart:     cc.Timespans[0].TimeStart,
+			TimeEnd:       cc.Timespans[0].TimeEnd,
+			Destination:   cc.Destination,
+			ToR:           cc.ToR,
+			DurationIndex: cc.GetDuration(),
+			testCallcost:  cc,
+		}
+	}
+	for _, connectFee := range []float64{0, 0.5} {
+		acc := &Account{ID: "cgrates.org:exr_missing",
+			BalanceMap: map[string]Balances{
+				utils.MetaMonetary: {&Balance{Uuid: "gbp", ID: utils.MetaDefault, Value: 50, Currency: "GBP"}},
+			}}
+		if _, err := acc.debitCreditBalance(newCD(connectFee), false, false, true, nil); err == nil {
+			t.Errorf("expected error for the missing exchange rate with connect fee %v", connectFee)
+		}
+		if val := acc.BalanceMap[utils.MetaMonetary][0].GetValue(); val != 50 {
+			t.Errorf("expected 50 GBP untouched with connect fee %v, received %v", connectFee, val)
+		}
+	}
+}
@@ -0,0 +1,189 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/cgrates/cgrates/utils"
+)
+
+// Reasons recorded for candidate profiles discarded before their filters are checked
+const (
+	ExplainReasonNotFound       = "profile not found"
+	ExplainReasonInactive       = "profile not active at event time"
+	ExplainReasonContext        = "profile not configured for the context"
+	ExplainReasonSubsystem      = "profile not configured for the subsystem"
+	ExplainReasonIgnoreFilters  = "filters ignored"
+	ExplainReasonFilterInactive = "no active filter"
+)
+
+// RuleExplanation details the evaluation of one filter rule
+type RuleExplanation struct {
+	Type         string
+	Element      string
+	Values       []string
+	ElementValue string // value of Element in the event, empty if not found
+	Pass         bool
+	Error        string // error returned by the rule or, if failing, by the Element lookup
+}
+
+// FilterExplanation details the evaluation of one filter
+// rules are evaluated in order, stopping at the first one failing
+type FilterExplanation struct {
+	ID       string
+	Pass     bool
+	Inactive bool
+	Error    string
+	Rules    []*RuleExplanation
+}
+
+// ProfileExplanation details why a candidate profile matched or not
+type ProfileExplanation struct {
+	ID      string
+	Pass    bool
+	Reason  string
+	Filters []*FilterExplanation
+}
+
+// MatchExplanation is the reply of the ExplainEvent APIs, collected
+// by the subsystems while matching the profiles for an event
+type MatchExplanation struct {
+	Candidates []string // profile IDs selected by indexes or by the profileIDs option
+	Profiles   []*ProfileExplanation
+	Matched    []string
+}
+
+// ExplanationFromOpts returns the explanation collector out of the event APIOpts, nil if not requested
+func ExplanationFromOpts(opts map[string]any) (expl *MatchExplanation) {
+	expl, _ = opts[utils.OptsExplain].(*MatchExplanation)
+	return
+}
+
+// SetCandidates records the candidate profile IDs
+func (expl *MatchExplanation) SetCandidates(prfIDs []string) {
+	if expl == nil {
+		return
+	}
+	expl.Candidates = slices.Clone(prfIDs)
+	slices.Sort(expl.Candidates)
+}
+
+// Skip records a candidate discarded before checking its filters
+func (expl *MatchExplanation) Skip(prfID, reason string) {
+	if expl == nil {
+		return
+	}
+	expl.Profiles = append(expl.Profiles, &ProfileExplanation{
+		ID:     prfID,
+		Reason: reason,
+	})
+}
+
+// ExplainPass is the same as Pass but records the result of each filter and rule into expl
+func (fS *FilterS) ExplainPass(expl *MatchExplanation, prfID, tenant string,
+	filterIDs []string, ev utils.DataProvider) (pass bool, err error) {
+	if expl == nil {
+		return fS.Pass(tenant, filterIDs, ev)
+	}
+	prfExpl := &ProfileExplanation{ID: prfID}
+	expl.Profiles = append(expl.Profiles, prfExpl)
+	if len(filterIDs) == 0 {
+		prfExpl.Pass = true
+		return true, nil
+	}
+	dDP := newDynamicDP(fS.cfg.FilterSCfg().ResourceSConns, fS.cfg.FilterSCfg().StatSConns,
+		fS.cfg.FilterSCfg().ApierSConns, fS.cfg.FilterSCfg().TrendSConns, fS.cfg.FilterSCfg().RankingSConns, tenant, ev)
+	for _, fltrID := range filterIDs {
+		fltrExpl := &FilterExplanation{ID: fltrID}
+		prfExpl.Filters = append(prfExpl.Filters, fltrExpl)
+		f, err := fS.dm.GetFilter(tenant, fltrID,
+			true, true, utils.NonTransactional)
+		if err != nil {
+			if err == utils.ErrNotFound {
+				err = utils.ErrPrefixNotFound(fltrID)
+			}
+			fltrExpl.Error = err.Error()
+			prfExpl.Reason = err.Error()
+			return false, err
+		}
+		if f.ActivationInterval != nil &&
+			!f.ActivationInterval.IsActiveAtTime(time.Now()) { // not active
+			fltrExpl.Inactive = true
+			continue
+		}
+		fltrExpl.Pass = true
+		for _, rule := range f.Rules {
+			ruleExpl, err := rule.explain(dDP)
+			fltrExpl.Rules = append(fltrExpl.Rules, ruleExpl)
+			if err != nil || !ruleExpl.Pass {
+				fltrExpl.Pass = false
+				fltrExpl.Error = ruleExpl.Error
+				if err != nil {
+					prfExpl.Reason = err.Error()
+				}
+				return false, err
+			}
+		}
+		pass = true
+	}
+	if prfExpl.Pass = pass; !pass {
+		prfExpl.Reason = ExplainReasonFilterInactive
+	}
+	return
+}
+
+// explain evaluates the rule recording the value of its Element, returning the error of the evaluation
+func (fltr *FilterRule) explain(dDP utils.DataProvider) (ruleExpl *RuleExplanation, err error) {
+	ruleExpl = &RuleExplanation{
+		Type:    fltr.Type,
+		Element: fltr.Element,
+		Values:  fltr.Values,
+	}
+	if ruleExpl.Pass, err = fltr.Pass(dDP); err != nil {
+		ruleExpl.Pass = false
+		ruleExpl.Error = err.Error()
+	}
+	if fltr.rsrElement == nil {
+		return
+	}
+	var errVal error
+	if ruleExpl.ElementValue, errVal = fltr.rsrElement.ParseDataProviderWithInterfaces(dDP); errVal != nil &&
+		!ruleExpl.Pass && ruleExpl.Error == utils.EmptyString {
+		ruleExpl.Error = errVal.Error()
+	}
+	return
+}
+
+// Sort orders the explained profiles by ID
+func (expl *MatchExplanation) Sort() {
+	slices.SortStableFunc(expl.Profiles, func(a, b *ProfileExplanation) int {
+		return strings.Compare(a.ID, b.ID)
+	})
+}
+
+// explainArgs returns a copy of args carrying a new explanation collector
+func explainArgs(args *utils.CGREvent) (*utils.CGREvent, *MatchExplanation) {
+	expl := new(MatchExplanation)
+	args = args.Clone()
+	args.APIOpts[utils.OptsExplain] = expl
+	return args, expl
+}
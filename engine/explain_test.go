@@ -0,0 +1,196 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cgrates/birpc/context"
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/utils"
+)
+
+func TestChargersV1ExplainEvent(t *testing.T) {
+	Cache.Clear(nil)
+	cfg := config.NewDefaultCGRConfig()
+	cfg.ChargerSCfg().IndexedSelects = false
+	dataDB, err := NewInternalDB(nil, nil, true, nil, cfg.DataDbCfg().Items)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dm := NewDataManager(dataDB, cfg.CacheCfg(), nil)
+	fltr := &Filter{
+		Tenant: "cgrates.org",
+		ID:     "FLTR_DE",
+		Rules: []*FilterRule{
+			{
+				Type:    utils.MetaString,
+				Element: "~*req.Account",
+				Values:  []string{"1001"},
+			},
+			{
+				Type:    utils.MetaPrefix,
+				Element: "~*req.Destination",
+				Values:  []string{"+49"},
+			},
+		},
+	}
+	if err := fltr.Compile(); err != nil {
+		t.Fatal(err)
+	}
+	if err := dm.SetFilter(fltr, true); err != nil {
+		t.Fatal(err)
+	}
+	for _, cP := range []*ChargerProfile{
+		{
+			Tenant:    "cgrates.org",
+			ID:        "CP_DE",
+			RunID:     "run_de",
+			FilterIDs: []string{"FLTR_DE"},
+		},
+		{
+			Tenant:    "cgrates.org",
+			ID:        "CP_1001",
+			RunID:     utils.MetaDefault,
+			FilterIDs: []string{"*string:~*req.Account:1001"},
+		},
+		{
+			Tenant: "cgrates.org",
+			ID:     "CP_EXPIRED",
+			RunID:  "run_expired",
+			ActivationInterval: &utils.ActivationInterval{
+				ExpiryTime: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+	} {
+		if err := dm.SetChargerProfile(cP, true); err != nil {
+			t.Fatal(err)
+		}
+	}
+	cS := &ChargerService{
+		dm: dm,
+		filterS: &FilterS{
+			dm:  dm,
+			cfg: cfg,
+		},
+		cfg: cfg,
+	}
+	args := &utils.CGREvent{
+		Tenant: "cgrates.org",
+		ID:     "cgrEvID",
+		Time:   utils.TimePointer(time.Now()),
+		Event: map[string]any{
+			utils.AccountField: "1001",
+			utils.Destination:  "+4420",
+		},
+	}
+	exp := MatchExplanation{
+		Candidates: []string{"CP_1001", "CP_DE", "CP_EXPIRED"},
+		Profiles: []*ProfileExplanation{
+			{
+				ID:   "CP_1001",
+				Pass: true,
+				Filters: []*FilterExplanation{
+					{
+						ID:   "*string:~*req.Account:1001",
+						Pass: true,
+						Rules: []*RuleExplanation{
+							{
+								Type:         utils.MetaString,
+								Element:      "~*req.Account",
+								Values:       []string{"1001"},
+								ElementValue: "1001",
+								Pass:         true,
+							},
+						},
+					},
+				},
+			},
+			{
+				ID: "CP_DE",
+				Filters: []*FilterExplanation{
+					{
+						ID: "FLTR_DE",
+						Rules: []*RuleExplanation{
+							{
+								Type:         utils.MetaString,
+								Element:      "~*req.Account",
+								Values:       []string{"1001"},
+								ElementValue: "1001",
+								Pass:         true,
+							},
+							{
+								Type:         utils.MetaPrefix,
+								Element:      "~*req.Destination",
+								Values:       []string{"+49"},
+								ElementValue: "+4420",
+							},
+						},
+					},
+				},
+			},
+			{
+				ID:     "CP_EXPIRED",
+				Reason: ExplainReasonInactive,
+			},
+		},
+		Matched: []string{"CP_1001"},
+	}
+	var reply MatchExplanation
+	if err := cS.V1ExplainEvent(context.Background(), args, &reply); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(reply, exp) {
+		t.Errorf("expected: %s,\nreceived: %s", utils.ToJSON(exp), utils.ToJSON(reply))
+	}
+	if _, has := args.APIOpts[utils.OptsExplain]; has {
+		t.Errorf("explanation collector leaked into the original event: %s", utils.ToJSON(args.APIOpts))
+	}
+}
+
+func TestFilterSExplainPassErrors(t *testing.T) {
+	Cache.Clear(nil)
+	cfg := config.NewDefaultCGRConfig()
+	dataDB, err := NewInternalDB(nil, nil, true, nil, cfg.DataDbCfg().Items)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dm := NewDataManager(dataDB, cfg.CacheCfg(), nil)
+	fS := &FilterS{dm: dm, cfg: cfg}
+	ev := utils.MapStorage{utils.MetaReq: utils.MapStorage{utils.AccountField: "1001"}}
+	for _, fltrIDs := range [][]string{
+		{"FLTR_MISSING"},
+		{"*gte:~*req.Account:notANumber"},
+	} {
+		expPass, expErr := fS.Pass("cgrates.org", fltrIDs, ev)
+		if expErr == nil {
+			t.Fatalf("expected error from Pass for %v", fltrIDs)
+		}
+		expl := new(MatchExplanation)
+		if pass, err := fS.ExplainPass(expl, "PRF", "cgrates.org", fltrIDs, ev); pass != expPass ||
+			err == nil || err.Error() != expErr.Error() {
+			t.Errorf("expected %v, %v, received %v, %v", expPass, expErr, pass, err)
+		}
+		if len(expl.Profiles) != 1 || expl.Profiles[0].Reason != expErr.Error() {
+			t.Errorf("error not recorded: %s", utils.ToJSON(expl))
+		}
+	}
+}
@@ -0,0 +1,895 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/utils"
+)
+
+var errExprDivisionByZero = errors.New("division by zero")
+
+const (
+	exprMaxLen   = 4096 // maximum length of an expression, in bytes
+	exprMaxDepth = 32   // maximum nesting of the parentheses, lists, function calls and unary operators
+)
+
+// filterExpr is the compiled expression of an *expr filter rule
+//
+// The expressions are sandboxed: they only read the fields of the event out of
+// the DataProvider and call the builtin functions, without side effects.
+type filterExpr struct {
+	root exprNode
+}
+
+// newFilterExpr compiles the expression
+func newFilterExpr(src string) (fe *filterExpr, err error) {
+	if len(src) > exprMaxLen {
+		return nil, fmt.Errorf("expression longer than %d bytes", exprMaxLen)
+	}
+	p := &exprParser{src: src}
+	if p.toks, err = lexExpr(src); err != nil {
+		return
+	}
+	fe = new(filterExpr)
+	if fe.root, err = p.parseOr(); err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); tok.kind != exprTokEOF {
+		return nil, p.errorf("unexpected <%s>", tok.text)
+	}
+	return
+}
+
+// pass evaluates the expression against the DataProvider, the missing fields evaluating to null
+func (fe *filterExpr) pass(dDP utils.DataProvider) (bool, error) {
+	val, err := fe.root.eval(dDP)
+	if err != nil {
+		return false, err
+	}
+	return exprBool(val)
+}
+
+// stringRules returns the *string conditions the expression cannot pass without,
+// out of the equalities between fields and string literals joined by &&
+func (fe *filterExpr) stringRules(rules []*FilterRule) []*FilterRule {
+	return exprStringRules(fe.root, rules)
+}
+
+func exprStringRules(n exprNode, rules []*FilterRule) []*FilterRule {
+	switch e := n.(type) {
+	case *exprLogic:
+		if !e.or {
+			rules = exprStringRules(e.x, rules)
+			rules = exprStringRules(e.y, rules)
+		}
+	case *exprBinary:
+		if e.op != exprOpEq && e.op != exprOpIn {
+			break
+		}
+		x, y := e.x, e.y
+		if _, isPath := y.(*exprPath); isPath {
+			x, y = y, x
+		}
+		path, isPath := x.(*exprPath)
+		if !isPath || e.op == exprOpIn && x != e.x ||
+			strings.ContainsAny(path.path, "{<") {
+			break
+		}
+		var vals []string
+		switch lit := y.(type) {
+		case *exprLiteral:
+			if lit.isString {
+				vals = []string{lit.val.(string)}
+			}
+		case *exprList:
+			for _, itm := range lit.items {
+				if itmLit, isLit := itm.(*exprLiteral); isLit && itmLit.isString {
+					vals = append(vals, itmLit.val.(string))
+					continue
+				}
+				return rules // can only index the lists made of strings
+			}
+		}
+		if len(vals) != 0 {
+			rules = append(rules, &FilterRule{
+				Type:    utils.MetaString,
+				Element: path.path,
+				Values:  vals,
+			})
+		}
+	}
+	return rules
+}
+
+const (
+	exprTokEOF = iota
+	exprTokPath
+	exprTokNumber
+	exprTokString
+	exprTokIdent
+	exprTokOp
+
+	exprOpOr  = "||"
+	exprOpAnd = "&&"
+	exprOpEq  = "=="
+	exprOpNe  = "!="
+	exprOpLt  = "<"
+	exprOpLe  = "<="
+	exprOpGt  = ">"
+	exprOpGe  = ">="
+	exprOpIn  = "in"
+	exprOpNot = "!"
+	exprOpAdd = "+"
+	exprOpSub = "-"
+	exprOpMul = "*"
+	exprOpDiv = "/"
+	exprOpMod = "%"
+)
+
+type exprToken struct {
+	kind int
+	text string
+	pos  int
+}
+
+// lexExpr splits the expression into tokens
+func lexExpr(src string) (toks []exprToken, err error) {
+	for i := 0; i < len(src); {
+		c := src[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == utils.DynamicDataPrefix[0]:
+			j := scanExprPath(src, i)
+			toks = append(toks, exprToken{kind: exprTokPath, text: src[i:j], pos: i})
+			i = j
+		case c == '"' || c == '\'':
+			j := i + 1
+			for ; j < len(src) && src[j] != c; j++ {
+				if src[j] == '\\' && c == '"' {
+					j++
+				}
+			}
+			if j >= len(src) {
+				return nil, fmt.Errorf("unterminated string at position %d in expression <%s>", i, src)
+			}
+			text := src[i+1 : j]
+			if c == '"' {
+				if text, err = strconv.Unquote(src[i : j+1]); err != nil {
+					return nil, fmt.Errorf("invalid string at position %d in expression <%s>: %v", i, src, err)
+				}
+			}
+			toks = append(toks, exprToken{kind: exprTokString, text: text, pos: i})
+			i = j + 1
+		case c >= '0' && c <= '9':
+			j := i + 1
+			for ; j < len(src) && (isExprIdentChar(src[j]) || src[j] == '.'); j++ {
+			}
+			toks = append(toks, exprToken{kind: exprTokNumber, text: src[i:j], pos: i})
+			i = j
+		case isExprIdentChar(c):
+			j := i + 1
+			for ; j < len(src) && isExprIdentChar(src[j]); j++ {
+			}
+			toks = append(toks, exprToken{kind: exprTokIdent, text: src[i:j], pos: i})
+			i = j
+		default:
+			op := src[i : i+1]
+			if i+1 < len(src) {
+				switch src[i : i+2] {
+				case exprOpOr, exprOpAnd, exprOpEq, exprOpNe, exprOpLe, exprOpGe:
+					op = src[i : i+2]
+				}
+			}
+			if !strings.Contains("()[],<>!+-*/%", op) && len(op) == 1 {
+				return nil, fmt.Errorf("unexpected <%s> at position %d in expression <%s>", op, i, src)
+			}
+			toks = append(toks, exprToken{kind: exprTokOp, text: op, pos: i})
+			i += len(op)
+		}
+	}
+	return append(toks, exprToken{kind: exprTokEOF, pos: len(src)}), nil
+}
+
+func isExprIdentChar(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' ||
+		c >= '0' && c <= '9' || c == '_'
+}
+
+// scanExprPath returns the end of the path starting at i, the paths end with
+// a whitespace or an operator, except the ones inside the converter braces or the index brackets
+func scanExprPath(src string, i int) int {
+	var brackets, braces int
+	for i++; i < len(src); i++ {
+		c := src[i]
+		if braces != 0 {
+			switch c {
+			case '{':
+				braces++
+			case '}':
+				braces--
+			}
+			continue
+		}
+		switch c {
+		case '{':
+			braces++
+		case '[':
+			brackets++
+		case ']':
+			if brackets == 0 {
+				return i
+			}
+			brackets--
+		case ' ', '\t', '\n', '\r', '(', ')', ',', '!', '=', '<', '>', '&', '|', '"', '\'':
+			return i
+		}
+	}
+	return i
+}
+
+type exprParser struct {
+	src   string
+	toks  []exprToken
+	pos   int
+	depth int // current nesting, limited to exprMaxDepth
+}
+
+func (p *exprParser) peek() exprToken {
+	return p.toks[p.pos]
+}
+
+func (p *exprParser) next() (tok exprToken) {
+	tok = p.toks[p.pos]
+	if tok.kind != exprTokEOF {
+		p.pos++
+	}
+	return
+}
+
+// accept consumes the next token if it is the given operator
+func (p *exprParser) accept(op string) bool {
+	if tok := p.peek(); (tok.kind == exprTokOp ||
+		tok.kind == exprTokIdent && op == exprOpIn) && tok.text == op {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *exprParser) expect(op string) error {
+	if !p.accept(op) {
+		return p.errorf("expecting <%s>", op)
+	}
+	return nil
+}
+
+func (p *exprParser) errorf(format string, args ...any) error {
+	return fmt.Errorf("%s at position %d in expression <%s>",
+		fmt.Sprintf(format, args...), p.peek().pos, p.src)
+}
+
+// nest enters a nested expression, to be left via unnest
+func (p *exprParser) nest() error {
+	if p.depth++; p.depth > exprMaxDepth {
+		return p.errorf("expression nested deeper than %d levels", exprMaxDepth)
+	}
+	return nil
+}
+
+func (p *exprParser) unnest() {
+	p.depth--
+}
+
+func (p *exprParser) parseOr() (n exprNode, err error) {
+	if err = p.nest(); err != nil {
+		return
+	}
+	defer p.unnest()
+	if n, err = p.parseAnd(); err != nil {
+		return
+	}
+	for p.accept(exprOpOr) {
+		var y exprNode
+		if y, err = p.parseAnd(); err != nil {
+			return
+		}
+		n = &exprLogic{or: true, x: n, y: y}
+	}
+	return
+}
+
+func (p *exprParser) parseAnd() (n exprNode, err error) {
+	if n, err = p.parseCmp(); err != nil {
+		return
+	}
+	for p.accept(exprOpAnd) {
+		var y exprNode
+		if y, err = p.parseCmp(); err != nil {
+			return
+		}
+		n = &exprLogic{x: n, y: y}
+	}
+	return
+}
+
+func (p *exprParser) parseCmp() (n exprNode, err error) {
+	if n, err = p.parseSum(); err != nil {
+		return
+	}
+	for _, op := range []string{exprOpEq, exprOpNe, exprOpLe, exprOpGe, exprOpLt, exprOpGt, exprOpIn} {
+		if !p.accept(op) {
+			continue
+		}
+		var y exprNode
+		if y, err = p.parseSum(); err != nil {
+			return
+		}
+		if _, isList := y.(*exprList); op == exprOpIn && !isList {
+			return nil, p.errorf("expecting a list after <%s>", exprOpIn)
+		}
+		return &exprBinary{op: op, x: n, y: y}, nil
+	}
+	return
+}
+
+func (p *exprParser) parseSum() (n exprNode, err error) {
+	if n, err = p.parseProd(); err != nil {
+		return
+	}
+	for {
+		op := exprOpAdd
+		if !p.accept(op) {
+			if op = exprOpSub; !p.accept(op) {
+				return
+			}
+		}
+		var y exprNode
+		if y, err = p.parseProd(); err != nil {
+			return
+		}
+		n = &exprBinary{op: op, x: n, y: y}
+	}
+}
+
+func (p *exprParser) parseProd() (n exprNode, err error) {
+	if n, err = p.parseUnary(); err != nil {
+		return
+	}
+	for {
+		var op string
+		for _, o := range []string{exprOpMul, exprOpDiv, exprOpMod} {
+			if p.accept(o) {
+				op = o
+				break
+			}
+		}
+		if op == utils.EmptyString {
+			return
+		}
+		var y exprNode
+		if y, err = p.parseUnary(); err != nil {
+			return
+		}
+		n = &exprBinary{op: op, x: n, y: y}
+	}
+}
+
+func (p *exprParser) parseUnary() (n exprNode, err error) {
+	for _, op := range []string{exprOpNot, exprOpSub} {
+		if p.accept(op) {
+			if err = p.nest(); err != nil {
+				return
+			}
+			defer p.unnest()
+			if n, err = p.parseUnary(); err != nil {
+				return
+			}
+			return &exprUnary{op: op, x: n}, nil
+		}
+	}
+	return p.parsePrimary()
+}
+
+func (p *exprParser) parsePrimary() (n exprNode, err error) {
+	tok := p.peek()
+	switch tok.kind {
+	case exprTokNumber:
+		p.next()
+		val := utils.StringToInterface(tok.text)
+		if _, isStr := val.(string); isStr {
+			return nil, p.errorf("invalid number <%s>", tok.text)
+		}
+		return &exprLiteral{val: val}, nil
+	case exprTokString:
+		p.next()
+		return &exprLiteral{val: tok.text, isString: true}, nil
+	case exprTokPath:
+		p.next()
+		pth := &exprPath{path: tok.text}
+		if pth.rsr, err = config.NewRSRParser(tok.text); err != nil {
+			return nil, fmt.Errorf("%v in expression <%s>", err, p.src)
+		}
+		return pth, nil
+	case exprTokIdent:
+		p.next()
+		switch tok.text {
+		case utils.TrueStr:
+			return &exprLiteral{val: true}, nil
+		case utils.FalseStr:
+			return &exprLiteral{val: false}, nil
+		case "null":
+			return &exprLiteral{}, nil
+		}
+		return p.parseCall(tok.text)
+	}
+	switch {
+	case p.accept("("):
+		if n, err = p.parseOr(); err != nil {
+			return
+		}
+		return n, p.expect(")")
+	case p.accept("["):
+		lst := new(exprList)
+		for !p.accept("]") {
+			if len(lst.items) != 0 {
+				if err = p.expect(","); err != nil {
+					return
+				}
+			}
+			var itm exprNode
+			if itm, err = p.parseOr(); err != nil {
+				return
+			}
+			lst.items = append(lst.items, itm)
+		}
+		return lst, nil
+	}
+	if tok.kind == exprTokEOF {
+		return nil, p.errorf("unexpected end")
+	}
+	return nil, p.errorf("unexpected <%s>", tok.text)
+}
+
+// exprFuncArgs is the number of arguments of the builtin functions
+var exprFuncArgs = map[string]int{
+	"has":        1,
+	"len":        1,
+	"startsWith": 2,
+	"endsWith":   2,
+	"contains":   2,
+	"matches":    2,
+}
+
+func (p *exprParser) parseCall(name string) (n exprNode, err error) {
+	nArgs, has := exprFuncArgs[name]
+	if !has {
+		return nil, p.errorf("unknown function <%s>", name)
+	}
+	if err = p.expect("("); err != nil {
+		return
+	}
+	call := &exprCall{name: name}
+	for !p.accept(")") {
+		if len(call.args) != 0 {
+			if err = p.expect(","); err != nil {
+				return
+			}
+		}
+		var arg exprNode
+		if arg, err = p.parseOr(); err != nil {
+			return
+		}
+		call.args = append(call.args, arg)
+	}
+	if len(call.args) != nArgs {
+		return nil, p.errorf("function <%s> expects %d arguments, received %d", name, nArgs, len(call.args))
+	}
+	switch name {
+	case "has":
+		if _, isPath := call.args[0].(*exprPath); !isPath {
+			return nil, p.errorf("function <%s> expects a field path", name)
+		}
+	case "matches": // compile the regular expression once if possible
+		if lit, isLit := call.args[1].(*exprLiteral); isLit && lit.isString {
+			if call.regex, err = regexp.Compile(lit.val.(string)); err != nil {
+				return nil, fmt.Errorf("%v in expression <%s>", err, p.src)
+			}
+		}
+	}
+	return call, nil
+}
+
+type exprNode interface {
+	eval(dDP utils.DataProvider) (any, error)
+}
+
+type exprLiteral struct {
+	val      any
+	isString bool
+}
+
+func (e *exprLiteral) eval(utils.DataProvider) (any, error) {
+	return e.val, nil
+}
+
+// exprPath is a field of the event, null if missing
+type exprPath struct {
+	path string
+	rsr  *config.RSRParser
+}
+
+func (e *exprPath) eval(dDP utils.DataProvider) (any, error) {
+	out, err := e.rsr.ParseDataProviderWithInterfaces(dDP)
+	if err != nil {
+		if err == utils.ErrNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return out, nil
+}
+
+type exprList struct {
+	items []exprNode
+}
+
+func (e *exprList) eval(dDP utils.DataProvider) (any, error) {
+	vals := make([]any, len(e.items))
+	for i, itm := range e.items {
+		var err error
+		if vals[i], err = itm.eval(dDP); err != nil {
+			return nil, err
+		}
+	}
+	return vals, nil
+}
+
+// exprLogic is the short-circuited && and ||
+type exprLogic struct {
+	or   bool
+	x, y exprNode
+}
+
+func (e *exprLogic) eval(dDP utils.DataProvider) (any, error) {
+	for _, n := range []exprNode{e.x, e.y} {
+		val, err := n.eval(dDP)
+		if err != nil {
+			return nil, err
+		}
+		var b bool
+		if b, err = exprBool(val); err != nil {
+			return nil, err
+		}
+		if b == e.or {
+			return b, nil
+		}
+	}
+	return !e.or, nil
+}
+
+type exprUnary struct {
+	op string
+	x  exprNode
+}
+
+func (e *exprUnary) eval(dDP utils.DataProvider) (any, error) {
+	val, err := e.x.eval(dDP)
+	if err != nil {
+		return nil, err
+	}
+	if e.op == exprOpNot {
+		var b bool
+		if b, err = exprBool(val); err != nil {
+			return nil, err
+		}
+		return !b, nil
+	}
+	if d, isDur := val.(time.Duration); isDur {
+		return -d, nil
+	}
+	return exprArithmetic(exprOpSub, int64(0), val)
+}
+
+type exprBinary struct {
+	op   string
+	x, y exprNode
+}
+
+func (e *exprBinary) eval(dDP utils.DataProvider) (_ any, err error) {
+	var x, y any
+	if x, err = e.x.eval(dDP); err != nil {
+		return
+	}
+	if y, err = e.y.eval(dDP); err != nil {
+		return
+	}
+	switch e.op {
+	case exprOpEq:
+		return exprEqual(x, y)
+	case exprOpNe:
+		var eq bool
+		eq, err = exprEqual(x, y)
+		return !eq, err
+	case exprOpLt, exprOpLe, exprOpGt, exprOpGe:
+		return exprCompare(e.op, x, y)
+	case exprOpIn:
+		for _, itm := range y.([]any) {
+			if eq, err := exprEqual(x, itm); err != nil || eq {
+				return eq, err
+			}
+		}
+		return false, nil
+	}
+	return exprArithmetic(e.op, x, y)
+}
+
+type exprCall struct {
+	name  string
+	args  []exprNode
+	regex *regexp.Regexp
+}
+
+func (e *exprCall) eval(dDP utils.DataProvider) (_ any, err error) {
+	if e.name == "has" {
+		if _, err = e.args[0].(*exprPath).rsr.ParseDataProviderWithInterfaces(dDP); err != nil {
+			if err == utils.ErrNotFound {
+				return false, nil
+			}
+			return nil, err
+		}
+		return true, nil
+	}
+	args := make([]any, len(e.args))
+	for i, arg := range e.args {
+		if args[i], err = arg.eval(dDP); err != nil {
+			return
+		}
+	}
+	if e.name == "len" {
+		if args[0] == nil {
+			return int64(0), nil
+		}
+		return int64(len(utils.IfaceAsString(args[0]))), nil
+	}
+	if args[0] == nil || args[1] == nil {
+		return false, nil
+	}
+	str, arg := utils.IfaceAsString(args[0]), utils.IfaceAsString(args[1])
+	switch e.name {
+	case "startsWith":
+		return strings.HasPrefix(str, arg), nil
+	case "endsWith":
+		return strings.HasSuffix(str, arg), nil
+	case "contains":
+		return strings.Contains(str, arg), nil
+	}
+	regex := e.regex
+	if regex == nil {
+		if regex, err = regexp.Compile(arg); err != nil {
+			return
+		}
+	}
+	return regex.MatchString(str), nil
+}
+
+// exprBool returns the boolean value, null being false
+func exprBool(val any) (bool, error) {
+	switch v := val.(type) {
+	case nil:
+		return false, nil
+	case bool:
+		return v, nil
+	case string:
+		if b, err := strconv.ParseBool(v); err == nil {
+			return b, nil
+		}
+	}
+	return false, fmt.Errorf("not a boolean: <%v>", val)
+}
+
+// exprValue converts the strings out of the event into their typed value
+func exprValue(val any) any {
+	if str, isStr := val.(string); isStr {
+		return utils.StringToInterface(str)
+	}
+	return val
+}
+
+// exprEqual compares the strings as they are and converts them only when compared with other types
+func exprEqual(x, y any) (bool, error) {
+	if x == nil || y == nil {
+		return x == nil && y == nil, nil
+	}
+	xStr, xIsStr := x.(string)
+	yStr, yIsStr := y.(string)
+	if xIsStr && yIsStr {
+		return xStr == yStr, nil
+	}
+	x, y = exprValue(x), exprValue(y)
+	if _, isStr := x.(string); isStr {
+		return false, nil
+	}
+	if _, isStr := y.(string); isStr {
+		return false, nil
+	}
+	if xB, isBool := x.(bool); isBool {
+		yB, isBool := y.(bool)
+		return isBool && xB == yB, nil
+	}
+	if _, isBool := y.(bool); isBool {
+		return false, nil
+	}
+	return utils.EqualTo(x, y)
+}
+
+// exprCompare orders the values, null not being comparable
+func exprCompare(op string, x, y any) (bool, error) {
+	if x == nil || y == nil {
+		return false, nil
+	}
+	x, y = exprValue(x), exprValue(y)
+	xStr, xIsStr := x.(string)
+	yStr, yIsStr := y.(string)
+	if xIsStr && yIsStr {
+		switch op {
+		case exprOpLt:
+			return xStr < yStr, nil
+		case exprOpLe:
+			return xStr <= yStr, nil
+		case exprOpGt:
+			return xStr > yStr, nil
+		}
+		return xStr >= yStr, nil
+	}
+	switch op {
+	case exprOpLt:
+		gte, err := utils.GreaterThan(x, y, true)
+		return !gte, err
+	case exprOpLe:
+		gt, err := utils.GreaterThan(x, y, false)
+		return !gt, err
+	}
+	return utils.GreaterThan(x, y, op == exprOpGe)
+}
+
+// exprNumber returns the numeric value, as float64 if not integer
+func exprNumber(val any) (i int64, f float64, isFloat, ok bool) {
+	switch v := utils.GetBasicType(val).(type) {
+	case int64:
+		return v, float64(v), false, true
+	case uint64:
+		return int64(v), float64(v), false, true
+	case float64:
+		return 0, v, true, true
+	}
+	return
+}
+
+// exprArithmetic applies the arithmetic operator on numbers, durations and times,
+// the + concatenating the strings
+func exprArithmetic(op string, x, y any) (any, error) {
+	if x == nil || y == nil {
+		return nil, nil
+	}
+	x, y = exprValue(x), exprValue(y)
+	if xStr, isStr := x.(string); isStr {
+		if yStr, isStr := y.(string); isStr && op == exprOpAdd {
+			return xStr + yStr, nil
+		}
+	}
+	if _, isDur := y.(time.Duration); isDur && op == exprOpMul {
+		x, y = y, x
+	}
+	switch xv := x.(type) {
+	case time.Time:
+		if yTm, isTm := y.(time.Time); isTm && op == exprOpSub {
+			return xv.Sub(yTm), nil
+		}
+		if d, err := utils.IfaceAsDuration(y); err == nil {
+			switch op {
+			case exprOpAdd:
+				return xv.Add(d), nil
+			case exprOpSub:
+				return xv.Add(-d), nil
+			}
+		}
+	case time.Duration:
+		if yDur, isDur := y.(time.Duration); isDur {
+			switch op {
+			case exprOpAdd:
+				return xv + yDur, nil
+			case exprOpSub:
+				return xv - yDur, nil
+			case exprOpDiv:
+				if yDur == 0 {
+					return nil, errExprDivisionByZero
+				}
+				return float64(xv) / float64(yDur), nil
+			}
+			break
+		}
+		yi, yf, yIsFloat, ok := exprNumber(y)
+		if !ok {
+			break
+		}
+		switch op {
+		case exprOpAdd:
+			return xv + time.Duration(yi), nil
+		case exprOpSub:
+			return xv - time.Duration(yi), nil
+		case exprOpMul:
+			if yIsFloat {
+				return time.Duration(float64(xv) * yf), nil
+			}
+			return xv * time.Duration(yi), nil
+		case exprOpDiv:
+			if yf == 0 {
+				return nil, errExprDivisionByZero
+			}
+			return time.Duration(float64(xv) / yf), nil
+		}
+	default:
+		xi, xf, xIsFloat, xOk := exprNumber(x)
+		yi, yf, yIsFloat, yOk := exprNumber(y)
+		if !xOk || !yOk {
+			break
+		}
+		if xIsFloat || yIsFloat {
+			switch op {
+			case exprOpAdd:
+				return xf + yf, nil
+			case exprOpSub:
+				return xf - yf, nil
+			case exprOpMul:
+				return xf * yf, nil
+			case exprOpDiv:
+				if yf == 0 {
+					return nil, errExprDivisionByZero
+				}
+				return xf / yf, nil
+			}
+			break
+		}
+		if yi == 0 && (op == exprOpDiv || op == exprOpMod) {
+			return nil, errExprDivisionByZero
+		}
+		switch op {
+		case exprOpAdd:
+			return xi + yi, nil
+		case exprOpSub:
+			return xi - yi, nil
+		case exprOpMul:
+			return xi * yi, nil
+		case exprOpDiv:
+			return xi / yi, nil
+		case exprOpMod:
+			return xi % yi, nil
+		}
+	}
+	return nil, fmt.Errorf("unsupported operation: <%v> %s <%v>", x, op, y)
+}
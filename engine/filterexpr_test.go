@@ -0,0 +1,176 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/utils"
+)
+
+func TestFilterExprPass(t *testing.T) {
+	dDP := utils.MapStorage{
+		utils.MetaReq: utils.MapStorage{
+			utils.AccountField: "1001",
+			utils.Category:     "call",
+			utils.Usage:        "1m10s",
+			utils.Cost:         "1.25",
+			utils.Destination:  "+4986517174963",
+			utils.AnswerTime:   time.Date(2026, 1, 10, 10, 0, 0, 0, time.UTC),
+		},
+		utils.MetaOpts: utils.MapStorage{
+			"Priority": 3,
+		},
+	}
+	for expr, exp := range map[string]bool{
+		`~*req.Usage > 60s && (~*req.Category == "call" || ~*opts.Priority >= 5)`:                 true,
+		`~*req.Usage > 60s && (~*req.Category == "sms" || ~*opts.Priority >= 5)`:                  false,
+		`~*req.Usage>1m && ~*req.Usage<=70s`:                                                      true,
+		`~*req.Account == "1001" && ~*req.Account == 1001`:                                        true,
+		`~*req.Account != '1002'`:                                                                 true,
+		`~*req.Cost * 2 == 2.5`:                                                                   true,
+		`~*req.Usage + 50s == 2m`:                                                                 true,
+		`(~*opts.Priority + 4) % 5 == 2 && -~*opts.Priority < 0`:                                  true,
+		`~*req.Category in ["sms", "call"] && !(~*req.Category in ["data"])`:                      true,
+		`~*req.AnswerTime >= "2026-01-10T10:00:00Z" && ~*req.AnswerTime < "2026-01-11T00:00:00Z"`: true,
+		`startsWith(~*req.Destination, "+49") && endsWith(~*req.Destination, "963")`:              true,
+		`contains(~*req.Destination, "8651") && matches(~*req.Destination, "^\\+49")`:             true,
+		`len(~*req.Account) == 4 && has(~*req.Account) && !has(~*req.Subject)`:                    true,
+		`~*req.Subject == null && ~*req.Subject != "1001"`:                                        true,
+		`~*req.Subject > 10 || ~*req.Subject == "1001"`:                                           false,
+		`~*req.Usage{*duration_seconds} == 70`:                                                    true,
+	} {
+		fe, err := newFilterExpr(expr)
+		if err != nil {
+			t.Errorf("compiling <%s>: %v", expr, err)
+			continue
+		}
+		if pass, err := fe.pass(dDP); err != nil {
+			t.Errorf("evaluating <%s>: %v", expr, err)
+		} else if pass != exp {
+			t.Errorf("evaluating <%s> expected %v, received %v", expr, exp, pass)
+		}
+	}
+	for _, expr := range []string{
+		`~*req.Usage > `,
+		`(~*req.Usage > 60s`,
+		`~*req.Category == "call`,
+		`~*req.Category in "call"`,
+		`unknown(~*req.Category)`,
+		`has("call")`,
+		`matches(~*req.Category, "[")`,
+		`~*req.Usage > 60s ~*req.Cost`,
+		`1x2 > 3`,
+		strings.Repeat("(", exprMaxDepth) + "true" + strings.Repeat(")", exprMaxDepth),
+		strings.Repeat("[", exprMaxDepth) + "1" + strings.Repeat("]", exprMaxDepth),
+		strings.Repeat("!", exprMaxDepth) + "true",
+		`~*req.Category == "` + strings.Repeat("a", exprMaxLen) + `"`,
+	} {
+		if _, err := newFilterExpr(expr); err == nil {
+			t.Errorf("expected error compiling <%s>", expr)
+		}
+	}
+	for _, expr := range []string{ // within the limits
+		strings.Repeat("(", exprMaxDepth-1) + "true" + strings.Repeat(")", exprMaxDepth-1),
+		strings.Repeat("!", exprMaxDepth-1) + "true",
+	} {
+		if _, err := newFilterExpr(expr); err != nil {
+			t.Errorf("compiling <%s>: %v", expr, err)
+		}
+	}
+	for _, expr := range []string{
+		`~*req.Category`,
+		`~*req.Cost / 0 > 1`,
+		`~*req.Category - 1 > 1`,
+	} {
+		fe, err := newFilterExpr(expr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := fe.pass(dDP); err == nil {
+			t.Errorf("expected error evaluating <%s>", expr)
+		}
+	}
+}
+
+func TestFilterExprRule(t *testing.T) {
+	cfg := config.NewDefaultCGRConfig()
+	data, err := NewInternalDB(nil, nil, true, nil, cfg.DataDbCfg().Items)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dmFltr := NewDataManager(data, cfg.CacheCfg(), nil)
+	fS := NewFilterS(cfg, nil, dmFltr)
+	ev := utils.MapStorage{
+		utils.MetaReq: utils.MapStorage{
+			utils.Category: "call",
+			utils.Usage:    "2m",
+		},
+	}
+	for fltrID, exp := range map[string]bool{
+		`*expr::~*req.Usage > 60s && (~*req.Category == "call" || ~*opts.Priority >= 5)`: true,
+		`*expr::~*req.Category == "sms" || ~*req.Usage < 60s`:                            false,
+		`*notexpr::~*req.Category == "sms" || ~*req.Usage < 60s`:                         true,
+	} {
+		if pass, err := fS.Pass("cgrates.org", []string{fltrID}, ev); err != nil {
+			t.Errorf("%s: %v", fltrID, err)
+		} else if pass != exp {
+			t.Errorf("%s expected %v, received %v", fltrID, exp, pass)
+		}
+	}
+	if _, err = NewFilterFromInline("cgrates.org", `*expr::~*req.Usage >`); err == nil {
+		t.Error("expected error for invalid expression")
+	}
+	if err = CheckFilter(&Filter{Tenant: "cgrates.org", ID: "FLTR_EXPR", Rules: []*FilterRule{
+		{Type: utils.MetaExpr, Values: []string{`~*req.Usage >`}}}}); err == nil {
+		t.Error("expected error for invalid expression")
+	}
+
+	fltr := &Filter{
+		Tenant: "cgrates.org",
+		ID:     "FLTR_EXPR",
+		Rules: []*FilterRule{
+			{Type: utils.MetaPrefix, Element: "~*req.Destination", Values: []string{"+49"}},
+			{Type: utils.MetaExpr, Values: []string{
+				`~*req.Category == "call" && ("1001" == ~*req.Account || ~*req.Subject == "1001") && ~*req.Tenant in ["cgrates.org", "itsyscom.com"] && ~*req.Usage > 60s`}},
+			{Type: utils.MetaExpr, Values: []string{`~*req.ToR == "*voice"`, `~*req.ToR == "*data"`}},
+		},
+	}
+	if err = fltr.Compile(); err != nil {
+		t.Fatal(err)
+	}
+	exp := []*FilterRule{
+		fltr.Rules[0], fltr.Rules[1], fltr.Rules[2],
+		{Type: utils.MetaString, Element: "~*req.Category", Values: []string{"call"}},
+		{Type: utils.MetaString, Element: "~*req.Tenant", Values: []string{"cgrates.org", "itsyscom.com"}},
+	}
+	if rcv := fltr.IndexRules(); !reflect.DeepEqual(exp, rcv) {
+		t.Errorf("expected %s, received %s", utils.ToJSON(exp), utils.ToJSON(rcv))
+	}
+	if len(fltr.Rules) != 3 {
+		t.Errorf("expected the rules of the filter unchanged, received %s", utils.ToJSON(fltr.Rules))
+	}
+	if cln := fltr.Clone(); len(cln.Rules[1].exprValues) != 1 {
+		t.Errorf("expected the compiled expressions to be cloned, received %+v", cln.Rules[1])
+	}
+}
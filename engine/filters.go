@@ -23,6 +23,7 @@ import (
 	"net"
 	"reflect"
 	"regexp"
+	"slices"
 	"strings"
 	"time"
 
@@ -178,7 +179,11 @@ func NewFilterFromInline(tenant, inlnRule string) (f *Filter, err error) {
 	}
 	var vals []string
 	if ruleSplt[2] != utils.EmptyString {
-		vals = splitDynFltrValues(ruleSplt[2], utils.PipeSep)
+		if ruleSplt[0] == utils.MetaExpr || ruleSplt[0] == utils.MetaNotExpr {
+			vals = []string{ruleSplt[2]} // the expressions contain the || operator
+		} else {
+			vals = splitDynFltrValues(ruleSplt[2], utils.PipeSep)
+		}
 	}
 	f = &Filter{
 		Tenant: tenant,
@@ -229,6 +234,30 @@ func (fltr *Filter) CacheClone() any {
 	return fltr.Clone()
 }
 
+// IndexRules returns the rules used to build the filter indexes, adding to the Rules
+// the *string conditions the *expr rules with one expression cannot pass without
+func (fltr *Filter) IndexRules() []*FilterRule {
+	var exprRules []*FilterRule
+	for _, rule := range fltr.Rules {
+		if rule.Type != utils.MetaExpr || len(rule.Values) != 1 {
+			continue
+		}
+		var expr *filterExpr
+		if len(rule.exprValues) == 1 {
+			expr = rule.exprValues[0]
+		} else if cmpl, err := newFilterExpr(rule.Values[0]); err == nil {
+			expr = cmpl
+		} else {
+			continue // invalid expressions are not indexed
+		}
+		exprRules = expr.stringRules(exprRules)
+	}
+	if len(exprRules) == 0 {
+		return fltr.Rules
+	}
+	return append(slices.Clone(fltr.Rules), exprRules...)
+}
+
 // FilterWithOpts the arguments for the replication
 type FilterWithAPIOpts struct {
 	*Filter
@@ -256,19 +285,19 @@ var supportedFiltersType utils.StringSet = utils.NewStringSet([]string{
 	utils.MetaEmpty, utils.MetaExists, utils.MetaLessThan, utils.MetaLessOrEqual,
 	utils.MetaGreaterThan, utils.MetaGreaterOrEqual, utils.MetaEqual,
 	utils.MetaIPNet, utils.MetaAPIBan, utils.MetaSentryPeer, utils.MetaActivationInterval,
-	utils.MetaRegex})
+	utils.MetaRegex, utils.MetaPorted, utils.MetaExpr})
 var needsFieldName utils.StringSet = utils.NewStringSet([]string{
 	utils.MetaString, utils.MetaContains, utils.MetaPrefix, utils.MetaSuffix,
 	utils.MetaTimings, utils.MetaRSR, utils.MetaDestinations, utils.MetaLessThan,
 	utils.MetaEmpty, utils.MetaExists, utils.MetaLessOrEqual, utils.MetaGreaterThan,
 	utils.MetaGreaterOrEqual, utils.MetaEqual, utils.MetaIPNet, utils.MetaAPIBan, utils.MetaSentryPeer,
 	utils.MetaActivationInterval,
-	utils.MetaRegex})
+	utils.MetaRegex, utils.MetaPorted})
 var needsValues utils.StringSet = utils.NewStringSet([]string{utils.MetaString, utils.MetaContains, utils.MetaPrefix,
 	utils.MetaSuffix, utils.MetaTimings, utils.MetaRSR, utils.MetaDestinations,
 	utils.MetaLessThan, utils.MetaLessOrEqual, utils.MetaGreaterThan, utils.MetaGreaterOrEqual,
 	utils.MetaEqual, utils.MetaIPNet, utils.MetaAPIBan, utils.MetaSentryPeer, utils.MetaActivationInterval,
-	utils.MetaRegex})
+	utils.MetaRegex, utils.MetaExpr})
 
 // NewFilterRule returns a new filter
 func NewFilterRule(rfType, fieldName string, vals []string) (*FilterRule, error) {
@@ -312,6 +341,7 @@ type FilterRule struct {
 	rsrElement  *config.RSRParser // Cache here the
 	rsrFilters  utils.RSRFilters  // Cache here the RSRFilter Values
 	regexValues []*regexp.Regexp
+	exprValues  []*filterExpr // compiled *expr values
 	negative    *bool
 }
 
@@ -348,6 +378,10 @@ func (fltr *FilterRule) Clone() *FilterRule {
 			clone.regexValues[i] = regex.Copy()
 		}
 	}
+	if fltr.exprValues != nil { // the compiled expressions are not modified so we can share them
+		clone.exprValues = make([]*filterExpr, len(fltr.exprValues))
+		copy(clone.exprValues, fltr.exprValues)
+	}
 	return clone
 }
 
@@ -365,6 +399,14 @@ func (fltr *FilterRule) CompileValues() (err error) {
 		if fltr.rsrFilters, err = utils.ParseRSRFiltersFromSlice(fltr.Values); err != nil {
 			return
 		}
+	case utils.MetaExpr, utils.MetaNotExpr: // the fields are part of the expressions so no element to build
+		fltr.exprValues = make([]*filterExpr, len(fltr.Values))
+		for i, val := range fltr.Values {
+			if fltr.exprValues[i], err = newFilterExpr(val); err != nil {
+				return
+			}
+		}
+		return
 	case utils.MetaExists, utils.MetaNotExists, utils.MetaEmpty, utils.MetaNotEmpty: // only the element is builded
 	case utils.MetaActivationInterval, utils.MetaNotActivationInterval:
 		fltr.rsrValues = make(config.RSRParsers, len(fltr.Values))
@@ -409,6 +451,10 @@ func (fltr *FilterRule) Pass(dDP utils.DataProvider) (result bool, err error) {
 		result, err = fltr.passTimings(dDP)
 	case utils.MetaDestinations, utils.MetaNotDestinations:
 		result, err = fltr.passDestinations(dDP)
+	case utils.MetaPorted, utils.MetaNotPorted:
+		result, err = fltr.passPorted(dDP)
+	case utils.MetaExpr, utils.MetaNotExpr:
+		result, err = fltr.passExpr(dDP)
 	case utils.MetaRSR, utils.MetaNotRSR:
 		result, err = fltr.passRSR(dDP)
 	case utils.MetaLessThan, utils.MetaLessOrEqual, utils.MetaGreaterThan, utils.MetaGreaterOrEqual:
@@ -610,6 +656,39 @@ func (fltr *FilterRule) passTimings(dDP utils.DataProvider) (bool, error) {
 	return false, nil
 }
 
+// passPorted checks if the number was ported, optionally to one of the
+// recipient operators or routing numbers within the values
+func (fltr *FilterRule) passPorted(dDP utils.DataProvider) (bool, error) {
+	number, err := fltr.rsrElement.ParseDataProvider(dDP)
+	if err != nil {
+		if err == utils.ErrNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+	pn, err := getPortedNumber(number)
+	if err != nil {
+		if err == utils.ErrNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+	if len(fltr.rsrValues) == 0 {
+		return true, nil
+	}
+	for _, valRSR := range fltr.rsrValues {
+		val, err := valRSR.ParseDataProvider(dDP)
+		if err != nil {
+			continue
+		}
+		if val == pn.RecipientOperator ||
+			val == pn.RoutingNumber {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 func (fltr *FilterRule) passDestinations(dDP utils.DataProvider) (bool, error) {
 	dst, err := fltr.rsrElement.ParseDataProvider(dDP)
 	if err != nil {
@@ -815,6 +894,14 @@ func verifyInlineFilterS(fltrs []string) (err error) {
 
 func CheckFilter(fltr *Filter) (err error) {
 	for _, rls := range fltr.Rules {
+		if rls.Type == utils.MetaExpr || rls.Type == utils.MetaNotExpr {
+			for _, val := range rls.Values {
+				if _, err = newFilterExpr(val); err != nil {
+					return fmt.Errorf("%s for filter <%v>", err, fltr) //encapsulated error
+				}
+			}
+			continue
+		}
 		valFunc := utils.IsPathValid
 		if rls.Type == utils.MetaEmpty || rls.Type == utils.MetaExists {
 			valFunc = utils.IsPathValidForExporters
@@ -853,6 +940,15 @@ func (fltr *FilterRule) passRegex(dDP utils.DataProvider) (bool, error) {
 	return false, nil
 }
 
+func (fltr *FilterRule) passExpr(dDP utils.DataProvider) (bool, error) {
+	for _, expr := range fltr.exprValues {
+		if pass, err := expr.pass(dDP); err != nil || pass {
+			return pass, err
+		}
+	}
+	return false, nil
+}
+
 func (fltr *FilterRule) passHttp(dDP utils.DataProvider) (bool, error) {
 	strVal, err := fltr.rsrElement.ParseDataProvider(dDP)
 	if err != nil {
@@ -0,0 +1,567 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/cgrates/birpc/context"
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/utils"
+)
+
+const (
+	// fraudCleanupInterval is how often the expired fraud counters and scores are removed from memory
+	fraudCleanupInterval = time.Minute
+	// fraudCounterBuckets is the number of intervals a *velocity window is split into, bounding the memory of a counter
+	fraudCounterBuckets = 60
+)
+
+// FraudProfile groups the rules scoring the risk of the events matching its filters
+type FraudProfile struct {
+	Tenant             string
+	ID                 string
+	FilterIDs          []string
+	ActivationInterval *utils.ActivationInterval // active only within this interval
+	Weight             float64                   // the heaviest profile matching the event is applied
+	Rules              []*FraudRule
+	BlockScore         float64 // AuthorizeEvent is denied starting with this score, 0 to disable
+	AlertScore         float64 // an alert is sent to EEs when reaching this score, 0 to disable
+	QuarantineScore    float64 // the account is disabled when reaching this score, 0 to disable
+}
+
+// FraudRule adds its Score to the risk of the event when hit
+type FraudRule struct {
+	ID             string
+	Type           string        // *match, *velocity or *concurrent
+	FilterIDs      []string      // the event needs to pass these to be checked by the rule
+	DestinationIDs []string      // the Destination of the event needs to be part of one of these, the matched one grouping the *velocity counters
+	Metric         string        // *velocity only: *calls, *usage (in seconds) or *cost
+	Window         time.Duration // *velocity only: the sliding window the metric is summed on
+	Threshold      float64       // *velocity and *concurrent: the rule is hit when the value goes above it
+	Score          float64
+}
+
+// FraudProfileWithAPIOpts is used in replicatorV1 for dispatcher
+type FraudProfileWithAPIOpts struct {
+	*FraudProfile
+	APIOpts map[string]any
+}
+
+// FraudReply is the risk computed for an event
+type FraudReply struct {
+	ProfileID string
+	Score     float64
+	RuleIDs   []string // the rules hit by the event
+	Blocked   bool
+}
+
+// TenantID returns the concatenated key between tenant and ID
+func (fp *FraudProfile) TenantID() string {
+	return utils.ConcatenatedKey(fp.Tenant, fp.ID)
+}
+
+// Clone returns a clone of FraudProfile
+func (fp *FraudProfile) Clone() *FraudProfile {
+	if fp == nil {
+		return nil
+	}
+	cln := &FraudProfile{
+		Tenant:             fp.Tenant,
+		ID:                 fp.ID,
+		FilterIDs:          slices.Clone(fp.FilterIDs),
+		ActivationInterval: fp.ActivationInterval.Clone(),
+		Weight:             fp.Weight,
+		BlockScore:         fp.BlockScore,
+		AlertScore:         fp.AlertScore,
+		QuarantineScore:    fp.QuarantineScore,
+	}
+	if fp.Rules != nil {
+		cln.Rules = make([]*FraudRule, len(fp.Rules))
+		for i, fr := range fp.Rules {
+			frCln := *fr
+			frCln.FilterIDs = slices.Clone(fr.FilterIDs)
+			frCln.DestinationIDs = slices.Clone(fr.DestinationIDs)
+			cln.Rules[i] = &frCln
+		}
+	}
+	return cln
+}
+
+// CacheClone returns a clone of FraudProfile used by ltcache CacheCloner
+func (fp *FraudProfile) CacheClone() any {
+	return fp.Clone()
+}
+
+// Validate checks the rules of the profile
+func (fp *FraudProfile) Validate() error {
+	if fp.BlockScore < 0 || fp.AlertScore < 0 || fp.QuarantineScore < 0 {
+		return fmt.Errorf("%w score for profile <%s>", utils.ErrNegative, fp.ID)
+	}
+	for _, fr := range fp.Rules {
+		if fr == nil || fr.ID == utils.EmptyString {
+			return utils.NewErrMandatoryIeMissing(utils.ID)
+		}
+		switch fr.Type {
+		case utils.MetaMatch, utils.MetaConcurrent:
+		case utils.MetaVelocity:
+			switch fr.Metric {
+			case utils.MetaCalls, utils.MetaUsage, utils.MetaCost:
+			default:
+				return fmt.Errorf("unsupported metric <%s> for rule <%s>", fr.Metric, fr.ID)
+			}
+			if fr.Window <= 0 {
+				return fmt.Errorf("missing window for rule <%s>", fr.ID)
+			}
+		default:
+			return fmt.Errorf("unsupported type <%s> for rule <%s>", fr.Type, fr.ID)
+		}
+		if fr.Threshold < 0 {
+			return fmt.Errorf("%w threshold for rule <%s>", utils.ErrNegative, fr.ID)
+		}
+	}
+	return nil
+}
+
+// maxWindow returns the longest window of the *velocity rules
+func (fp *FraudProfile) maxWindow() (w time.Duration) {
+	for _, fr := range fp.Rules {
+		if fr.Window > w {
+			w = fr.Window
+		}
+	}
+	return
+}
+
+// fraudBucket sums the values recorded by a *velocity counter within one interval of its window
+type fraudBucket struct {
+	start time.Time
+	value float64
+}
+
+// newFraudCounter returns a fraudCounter splitting the window into fraudCounterBuckets intervals
+func newFraudCounter(window time.Duration) *fraudCounter {
+	return &fraudCounter{
+		window:   window,
+		interval: max(window/fraudCounterBuckets, 1),
+	}
+}
+
+// fraudCounter sums the values recorded within the sliding window, grouped in buckets per interval
+type fraudCounter struct {
+	window   time.Duration
+	interval time.Duration
+	buckets  []fraudBucket // ordered by start
+}
+
+// add records the value within the bucket of the interval containing t
+func (fc *fraudCounter) add(t time.Time, val float64) {
+	start := t.Truncate(fc.interval)
+	i := len(fc.buckets)
+	for i > 0 && fc.buckets[i-1].start.After(start) { // events can come out of order
+		i--
+	}
+	if i > 0 && fc.buckets[i-1].start.Equal(start) {
+		fc.buckets[i-1].value += val
+		return
+	}
+	fc.buckets = slices.Insert(fc.buckets, i, fraudBucket{start: start, value: val})
+}
+
+// sum drops the buckets outside the window ending at t and returns the sum of the remaining ones
+// the bucket partially within the window is summed entirely
+func (fc *fraudCounter) sum(t time.Time) (s float64) {
+	start := t.Add(-fc.window)
+	var i int
+	for i < len(fc.buckets) && !fc.buckets[i].start.Add(fc.interval).After(start) {
+		i++
+	}
+	fc.buckets = fc.buckets[i:]
+	for _, b := range fc.buckets {
+		s += b.value
+	}
+	return
+}
+
+// fraudScore is the last score of an account, remembered until the windows of its profile pass
+type fraudScore struct {
+	value  float64
+	expiry time.Time
+}
+
+// NewFraudService returns a new FraudService
+func NewFraudService(dm *DataManager, cgrcfg *config.CGRConfig,
+	filterS *FilterS, connMgr *ConnManager) *FraudService {
+	return &FraudService{
+		dm:          dm,
+		cgrcfg:      cgrcfg,
+		filterS:     filterS,
+		connMgr:     connMgr,
+		counters:    make(map[string]*fraudCounter),
+		scores:      make(map[string]*fraudScore),
+		stopCleanup: make(chan struct{}),
+		loopStopped: make(chan struct{}),
+	}
+}
+
+// FraudService scores the risk of the events based on the FraudProfiles
+type FraudService struct {
+	dm          *DataManager
+	cgrcfg      *config.CGRConfig
+	filterS     *FilterS
+	connMgr     *ConnManager
+	cMux        sync.Mutex               // protects counters and scores
+	counters    map[string]*fraudCounter // the *velocity counters indexed on tenant:profile:rule:account[:destinationID]
+	scores      map[string]*fraudScore   // the last scores indexed on tenant:profile:account, acting only when going above the limits
+	stopCleanup chan struct{}
+	loopStopped chan struct{}
+}
+
+// StartLoop starts the gorutine removing the expired counters
+func (fS *FraudService) StartLoop() {
+	go fS.runCleanup()
+}
+
+// Shutdown is called to shutdown the service
+func (fS *FraudService) Shutdown() {
+	utils.Logger.Info(fmt.Sprintf("<%s> service shutdown initialized", utils.FraudS))
+	close(fS.stopCleanup)
+	<-fS.loopStopped
+	utils.Logger.Info(fmt.Sprintf("<%s> service shutdown complete", utils.FraudS))
+}
+
+// runCleanup will regularly remove the expired counters and scores
+func (fS *FraudService) runCleanup() {
+	for {
+		select {
+		case <-fS.stopCleanup:
+			fS.loopStopped <- struct{}{}
+			return
+		case <-time.After(fraudCleanupInterval):
+			fS.cleanup(time.Now())
+		}
+	}
+}
+
+// cleanup removes the counters without samples within their window and the expired scores
+func (fS *FraudService) cleanup(t time.Time) {
+	fS.cMux.Lock()
+	for key, fc := range fS.counters {
+		if fc.sum(t); len(fc.buckets) == 0 {
+			delete(fS.counters, key)
+		}
+	}
+	for key, sc := range fS.scores {
+		if !sc.expiry.After(t) {
+			delete(fS.scores, key)
+		}
+	}
+	fS.cMux.Unlock()
+}
+
+// matchingFraudProfile returns the heaviest FraudProfile of the tenant active at the time and matching the event
+func (fS *FraudService) matchingFraudProfile(tnt string, t time.Time, evNm utils.MapStorage) (mfp *FraudProfile, err error) {
+	fpIDs, err := MatchingItemIDsForEvent(evNm,
+		fS.cgrcfg.FraudSCfg().StringIndexedFields,
+		fS.cgrcfg.FraudSCfg().PrefixIndexedFields,
+		fS.cgrcfg.FraudSCfg().SuffixIndexedFields,
+		fS.cgrcfg.FraudSCfg().ExistsIndexedFields,
+		fS.dm, utils.CacheFraudFilterIndexes, tnt,
+		fS.cgrcfg.FraudSCfg().IndexedSelects,
+		fS.cgrcfg.FraudSCfg().NestedFields,
+	)
+	if err != nil {
+		return nil, err
+	}
+	for fpID := range fpIDs {
+		var fp *FraudProfile
+		if fp, err = fS.dm.GetFraudProfile(tnt, fpID, true, true, utils.NonTransactional); err != nil {
+			if err == utils.ErrNotFound {
+				continue
+			}
+			return nil, err
+		}
+		if fp.ActivationInterval != nil && !fp.ActivationInterval.IsActiveAtTime(t) {
+			continue
+		}
+		if mfp != nil && mfp.Weight >= fp.Weight {
+			continue
+		}
+		var pass bool
+		if pass, err = fS.filterS.Pass(tnt, fp.FilterIDs, evNm); err != nil {
+			return nil, err
+		} else if pass {
+			mfp = fp
+		}
+	}
+	if mfp == nil {
+		return nil, utils.ErrNotFound
+	}
+	return mfp, nil
+}
+
+// matchingDestinationID returns the first of the dstIDs containing the destination
+func (fS *FraudService) matchingDestinationID(dst string, dstIDs []string) string {
+	for _, p := range utils.SplitPrefix(dst, MIN_PREFIX_MATCH) {
+		if ids, err := fS.dm.GetReverseDestination(p, true, true, utils.NonTransactional); err == nil {
+			for _, id := range ids {
+				if slices.Contains(dstIDs, id) {
+					return id
+				}
+			}
+		}
+	}
+	return utils.EmptyString
+}
+
+// metricValue returns the value of the event added to the *velocity counter
+func (fr *FraudRule) metricValue(ev *utils.CGREvent) (float64, error) {
+	switch fr.Metric {
+	case utils.MetaUsage:
+		usage, err := ev.FieldAsDuration(utils.Usage)
+		if err != nil {
+			if err == utils.ErrNotFound {
+				return 0, nil
+			}
+			return 0, err
+		}
+		return usage.Seconds(), nil
+	case utils.MetaCost:
+		cost, err := ev.FieldAsFloat64(utils.Cost)
+		if err != nil {
+			if err == utils.ErrNotFound {
+				return 0, nil
+			}
+			return 0, err
+		}
+		return cost, nil
+	default:
+		return 1, nil
+	}
+}
+
+// hit checks the rule against the event, recording it in the *velocity counter if requested
+func (fS *FraudService) hit(fp *FraudProfile, fr *FraudRule, acnt, dst string, t time.Time,
+	ev *utils.CGREvent, evDP utils.DataProvider, record bool) (_ bool, err error) {
+	var pass bool
+	if pass, err = fS.filterS.Pass(fp.Tenant, fr.FilterIDs, evDP); err != nil || !pass {
+		return
+	}
+	var dstID string
+	if len(fr.DestinationIDs) != 0 {
+		if dstID = fS.matchingDestinationID(dst, fr.DestinationIDs); dstID == utils.EmptyString {
+			return
+		}
+	}
+	switch fr.Type {
+	case utils.MetaConcurrent:
+		var active int64
+		if actIface, has := ev.APIOpts[utils.OptsFraudsActiveSessions]; has {
+			if active, err = utils.IfaceAsTInt64(actIface); err != nil {
+				return
+			}
+		}
+		return float64(active+1) > fr.Threshold, nil // the event is one more session
+	case utils.MetaVelocity:
+		var val float64
+		if val, err = fr.metricValue(ev); err != nil {
+			return
+		}
+		key := utils.ConcatenatedKey(fp.Tenant, fp.ID, fr.ID, acnt)
+		if dstID != utils.EmptyString {
+			key = utils.ConcatenatedKey(key, dstID)
+		}
+		fS.cMux.Lock()
+		defer fS.cMux.Unlock()
+		fc, has := fS.counters[key]
+		if !has || fc.window != fr.Window { // the buckets do not match a changed window
+			fc = newFraudCounter(fr.Window)
+		}
+		sum := fc.sum(t) + val
+		if record {
+			fc.add(t, val)
+			fS.counters[key] = fc
+		}
+		return sum > fr.Threshold, nil
+	default: // *match
+		return true, nil
+	}
+}
+
+// scoreEvent computes the risk of the event using the matching FraudProfile
+func (fS *FraudService) scoreEvent(args *utils.CGREvent, record bool) (fp *FraudProfile, acnt string, rply *FraudReply, err error) {
+	tnt := args.Tenant
+	if tnt == utils.EmptyString {
+		tnt = fS.cgrcfg.GeneralCfg().DefaultTenant
+	}
+	t := time.Now()
+	if args.Time != nil {
+		t = *args.Time
+	}
+	evDP := utils.MapStorage{
+		utils.MetaReq:  args.Event,
+		utils.MetaOpts: args.APIOpts,
+	}
+	if fp, err = fS.matchingFraudProfile(tnt, t, evDP); err != nil {
+		return
+	}
+	acnt = utils.IfaceAsString(args.Event[utils.AccountField])
+	dst := utils.IfaceAsString(args.Event[utils.Destination])
+	rply = &FraudReply{
+		ProfileID: fp.ID,
+		RuleIDs:   make([]string, 0, len(fp.Rules)),
+	}
+	for _, fr := range fp.Rules {
+		var hit bool
+		if hit, err = fS.hit(fp, fr, acnt, dst, t, args, evDP, record); err != nil {
+			return nil, utils.EmptyString, nil, err
+		}
+		if hit {
+			rply.Score += fr.Score
+			rply.RuleIDs = append(rply.RuleIDs, fr.ID)
+		}
+	}
+	rply.Blocked = fp.BlockScore > 0 && rply.Score >= fp.BlockScore
+	return
+}
+
+// updateScore remembers the score of the account, returning the previous one
+func (fS *FraudService) updateScore(fp *FraudProfile, acnt string, score float64, t time.Time) (prev float64) {
+	key := utils.ConcatenatedKey(fp.Tenant, fp.ID, acnt)
+	fS.cMux.Lock()
+	if sc, has := fS.scores[key]; has && sc.expiry.After(t) {
+		prev = sc.value
+	}
+	fS.scores[key] = &fraudScore{
+		value:  score,
+		expiry: t.Add(fp.maxWindow()),
+	}
+	fS.cMux.Unlock()
+	return
+}
+
+// processEEs sends the fraud alert to EEs
+func (fS *FraudService) processEEs(tnt, acnt string, rply *FraudReply, args *utils.CGREvent) (err error) {
+	if len(fS.cgrcfg.FraudSCfg().EEsConns) == 0 {
+		return
+	}
+	ev := make(map[string]any, len(args.Event)+3)
+	for k, v := range args.Event {
+		ev[k] = v
+	}
+	ev[utils.FraudProfileID] = rply.ProfileID
+	ev[utils.RiskScore] = rply.Score
+	ev[utils.FraudRuleIDs] = rply.RuleIDs
+	cgrEv := &CGREventWithEeIDs{
+		EeIDs: fS.cgrcfg.FraudSCfg().EEsExporterIDs,
+		CGREvent: &utils.CGREvent{
+			Tenant: tnt,
+			ID:     utils.GenUUID(),
+			Event:  ev,
+			APIOpts: map[string]any{
+				utils.MetaEventType: utils.FraudAlert,
+			},
+		},
+	}
+	var reply map[string]map[string]any
+	if err = fS.connMgr.Call(context.TODO(), fS.cgrcfg.FraudSCfg().EEsConns,
+		utils.EeSv1ProcessEvent, cgrEv, &reply); err != nil &&
+		err.Error() != utils.ErrNotFound.Error() {
+		utils.Logger.Warning(
+			fmt.Sprintf("<%s> error: %q sending alert for account <%s> to EEs.", utils.FraudS, err.Error(), acnt))
+		return utils.ErrPartiallyExecuted
+	}
+	return nil
+}
+
+// quarantine disables the account, ignoring the ones not found
+func (fS *FraudService) quarantine(tnt, acnt string) (err error) {
+	tntAcnt := utils.ConcatenatedKey(tnt, acnt)
+	if _, err = fS.dm.GetAccount(tntAcnt); err != nil {
+		if err == utils.ErrNotFound {
+			return nil
+		}
+		return
+	}
+	at := &ActionTiming{
+		Uuid: utils.GenUUID(),
+	}
+	at.SetActions(Actions{{Id: utils.FraudS, ActionType: utils.MetaDisableAccount}})
+	at.SetAccountIDs(utils.NewStringMap(tntAcnt))
+	if err = at.Execute(fS.filterS, utils.FraudS, nil); err != nil {
+		utils.Logger.Warning(
+			fmt.Sprintf("<%s> error: %q quarantining account <%s>.", utils.FraudS, err.Error(), tntAcnt))
+		return utils.ErrPartiallyExecuted
+	}
+	return
+}
+
+// V1AuthorizeEvent returns the risk of the event without recording it
+func (fS *FraudService) V1AuthorizeEvent(ctx *context.Context, args *utils.CGREvent, reply *FraudReply) (err error) {
+	if args == nil {
+		return utils.NewErrMandatoryIeMissing(utils.CGREventString)
+	}
+	var rply *FraudReply
+	if _, _, rply, err = fS.scoreEvent(args, false); err != nil {
+		if err != utils.ErrNotFound {
+			err = utils.NewErrServerError(err)
+		}
+		return
+	}
+	*reply = *rply
+	return
+}
+
+// V1ProcessEvent records the event, alerting and quarantining the account when its risk reaches the limits of the profile
+func (fS *FraudService) V1ProcessEvent(ctx *context.Context, args *utils.CGREvent, reply *FraudReply) (err error) {
+	if args == nil {
+		return utils.NewErrMandatoryIeMissing(utils.CGREventString)
+	}
+	var fp *FraudProfile
+	var acnt string
+	var rply *FraudReply
+	if fp, acnt, rply, err = fS.scoreEvent(args, true); err != nil {
+		if err != utils.ErrNotFound {
+			err = utils.NewErrServerError(err)
+		}
+		return
+	}
+	t := time.Now()
+	if args.Time != nil {
+		t = *args.Time
+	}
+	prev := fS.updateScore(fp, acnt, rply.Score, t)
+	var withErrs bool
+	if fp.AlertScore > 0 && rply.Score >= fp.AlertScore && prev < fp.AlertScore &&
+		fS.processEEs(fp.Tenant, acnt, rply, args) != nil {
+		withErrs = true
+	}
+	if fp.QuarantineScore > 0 && rply.Score >= fp.QuarantineScore && prev < fp.QuarantineScore &&
+		acnt != utils.EmptyString && fS.quarantine(fp.Tenant, acnt) != nil {
+		withErrs = true
+	}
+	*reply = *rply
+	if withErrs {
+		err = utils.ErrPartiallyExecuted
+	}
+	return
+}
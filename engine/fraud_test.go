@@ -0,0 +1,191 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cgrates/birpc/context"
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/utils"
+)
+
+func TestFraudProfileValidate(t *testing.T) {
+	fp := &FraudProfile{ID: "FRP1", Rules: []*FraudRule{
+		{ID: "CALLS", Type: utils.MetaVelocity, Metric: utils.MetaCalls, Window: time.Hour, Threshold: 10},
+		{ID: "IRSF", Type: utils.MetaMatch, DestinationIDs: []string{"DST_IRSF"}},
+	}}
+	if err := fp.Validate(); err != nil {
+		t.Error(err)
+	}
+	fp.Rules[0].Window = 0
+	if err := fp.Validate(); err == nil {
+		t.Error("expected error for missing window")
+	}
+	fp.Rules[0].Window = time.Hour
+	fp.Rules[0].Metric = "*unknown"
+	if err := fp.Validate(); err == nil {
+		t.Error("expected error for unsupported metric")
+	}
+	fp.Rules[0].Metric = utils.MetaUsage
+	fp.Rules[1].Type = "*unknown"
+	if err := fp.Validate(); err == nil {
+		t.Error("expected error for unsupported type")
+	}
+	fp.Rules[1].Type = utils.MetaConcurrent
+	fp.BlockScore = -1
+	if err := fp.Validate(); err == nil {
+		t.Error("expected error for negative score")
+	}
+}
+
+func TestFraudCounterSum(t *testing.T) {
+	t0 := time.Date(2026, 1, 10, 10, 0, 0, 0, time.UTC)
+	fc := newFraudCounter(time.Hour)
+	fc.add(t0, 1)
+	fc.add(t0.Add(50*time.Minute), 4)
+	fc.add(t0.Add(30*time.Minute), 2) // out of order
+	for i := range 100 {              // grouped within the bucket of the minute
+		fc.add(t0.Add(50*time.Minute+time.Duration(i)*time.Millisecond), 0.5)
+	}
+	if sum := fc.sum(t0.Add(55 * time.Minute)); sum != 57 {
+		t.Errorf("expected 57, received %v", sum)
+	}
+	if len(fc.buckets) != 3 {
+		t.Errorf("expected 3 buckets, received %+v", fc.buckets)
+	}
+	if sum := fc.sum(t0.Add(time.Hour + 40*time.Minute)); sum != 54 {
+		t.Errorf("expected 54, received %v", sum)
+	}
+	if len(fc.buckets) != 1 {
+		t.Errorf("expected the expired buckets to be dropped, received %+v", fc.buckets)
+	}
+}
+
+func TestFraudServiceProcessEvent(t *testing.T) {
+	tmpDm := dm
+	defer func() {
+		dm = tmpDm
+	}()
+	cfg := config.NewDefaultCGRConfig()
+	data, err := NewInternalDB(nil, nil, true, nil, cfg.DataDbCfg().Items)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dmFrd := NewDataManager(data, cfg.CacheCfg(), nil)
+	SetDataStorage(dmFrd) // the quarantine actions are executed on the global DataManager
+	fltrS := NewFilterS(cfg, nil, dmFrd)
+	if err = dmFrd.SetDestination(&Destination{Id: "DST_IRSF", Prefixes: []string{"+882"}},
+		utils.NonTransactional); err != nil {
+		t.Fatal(err)
+	}
+	if err = dmFrd.SetReverseDestination("DST_IRSF", []string{"+882"}, utils.NonTransactional); err != nil {
+		t.Fatal(err)
+	}
+	if err = dmFrd.SetAccount(&Account{ID: "cgrates.org:1001"}); err != nil {
+		t.Fatal(err)
+	}
+	if err = dmFrd.SetFraudProfile(&FraudProfile{
+		Tenant: "cgrates.org",
+		ID:     "FRP_DEFAULT",
+		Rules: []*FraudRule{
+			{ID: "IRSF", Type: utils.MetaMatch, DestinationIDs: []string{"DST_IRSF"}, Score: 40},
+			{ID: "IRSF_CALLS", Type: utils.MetaVelocity, DestinationIDs: []string{"DST_IRSF"},
+				Metric: utils.MetaCalls, Window: time.Hour, Threshold: 2, Score: 30},
+			{ID: "NIGHT", Type: utils.MetaMatch, FilterIDs: []string{"*string:~*req.Category:night"}, Score: 10},
+			{ID: "SPIKE", Type: utils.MetaConcurrent, Threshold: 3, Score: 50},
+		},
+		BlockScore:      80,
+		QuarantineScore: 70,
+	}, true); err != nil {
+		t.Fatal(err)
+	}
+	// heavier but indexed on another account, never selected for 1001
+	if err = dmFrd.SetFraudProfile(&FraudProfile{
+		Tenant:    "cgrates.org",
+		ID:        "FRP_1002",
+		FilterIDs: []string{"*string:~*req.Account:1002"},
+		Rules:     []*FraudRule{{ID: "ANY", Type: utils.MetaMatch, Score: 100}},
+		Weight:    10,
+	}, true); err != nil {
+		t.Fatal(err)
+	}
+	fS := NewFraudService(dmFrd, cfg, fltrS, nil)
+	t0 := time.Date(2026, 1, 10, 3, 0, 0, 0, time.UTC)
+	ev := &utils.CGREvent{
+		Tenant: "cgrates.org",
+		ID:     "ev1",
+		Time:   &t0,
+		Event: map[string]any{
+			utils.AccountField: "1001",
+			utils.Destination:  "+8821234",
+			utils.Category:     "night",
+		},
+		APIOpts: map[string]any{
+			utils.OptsFraudsActiveSessions: 1,
+		},
+	}
+	var rply FraudReply
+	for i := range 2 {
+		if err = fS.V1ProcessEvent(context.Background(), ev, &rply); err != nil {
+			t.Fatal(err)
+		}
+		if exp := (FraudReply{ProfileID: "FRP_DEFAULT", Score: 50, RuleIDs: []string{"IRSF", "NIGHT"}}); !reflect.DeepEqual(exp, rply) {
+			t.Errorf("event %d expected %+v, received %+v", i, exp, rply)
+		}
+	}
+	// the third call within the window goes above the velocity threshold
+	if err = fS.V1AuthorizeEvent(context.Background(), ev, &rply); err != nil {
+		t.Fatal(err)
+	}
+	if exp := (FraudReply{ProfileID: "FRP_DEFAULT", Score: 80, RuleIDs: []string{"IRSF", "IRSF_CALLS", "NIGHT"},
+		Blocked: true}); !reflect.DeepEqual(exp, rply) {
+		t.Errorf("expected %+v, received %+v", exp, rply)
+	}
+	// authorization does not record the event, the processing does and quarantines the account
+	if err = fS.V1ProcessEvent(context.Background(), ev, &rply); err != nil {
+		t.Fatal(err)
+	}
+	if rply.Score != 80 {
+		t.Errorf("expected score 80, received %+v", rply)
+	}
+	if acc, err := dmFrd.GetAccount("cgrates.org:1001"); err != nil {
+		t.Fatal(err)
+	} else if !acc.Disabled {
+		t.Error("expected the account to be disabled")
+	}
+	// other destinations are neither hit nor counted, the concurrent sessions are
+	t1 := t0.Add(2 * time.Hour)
+	ev.Time = &t1
+	ev.Event[utils.Destination] = "+4912345"
+	ev.Event[utils.Category] = "call"
+	ev.APIOpts[utils.OptsFraudsActiveSessions] = 3
+	if err = fS.V1AuthorizeEvent(context.Background(), ev, &rply); err != nil {
+		t.Fatal(err)
+	}
+	if exp := (FraudReply{ProfileID: "FRP_DEFAULT", Score: 50, RuleIDs: []string{"SPIKE"}}); !reflect.DeepEqual(exp, rply) {
+		t.Errorf("expected %+v, received %+v", exp, rply)
+	}
+	fS.cleanup(t1)
+	if len(fS.counters) != 0 || len(fS.scores) != 0 {
+		t.Errorf("expected the expired counters and scores to be removed, received %+v %+v", fS.counters, fS.scores)
+	}
+}
@@ -0,0 +1,367 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/cgrates/cgrates/utils"
+)
+
+// ErrInvoiceFinal is returned when trying to modify a final invoice
+var ErrInvoiceFinal = errors.New("INVOICE_FINAL")
+
+// Invoice is the billing document of an account for a period, aggregating the rated CDRs
+// together with the balance changes done outside of them (recurring debits and top-ups)
+type Invoice struct {
+	Tenant         string
+	Account        string
+	ID             string
+	PeriodStart    time.Time
+	PeriodEnd      time.Time
+	UsageLines     []*InvoiceUsageLine
+	BalanceLines   []*InvoiceBalanceLine
+	TaxLines       []*InvoiceTaxLine
+	Subtotal       float64 // usage and debits, without taxes
+	TaxTotal       float64
+	Total          float64
+	TopUps         float64 // credited to the account within the period, not part of the total
+	LastCDROrderID int64   // CDRs stored after it and answered before the period end are late, going to the next invoice
+	Final          bool
+	GenerationTime time.Time
+}
+
+// InvoiceUsageLine aggregates the CDR costs of a category and destination
+type InvoiceUsageLine struct {
+	Category    string
+	Destination string // the matched destination ID, the CDR destination when not rated on one
+	CDRs        int
+	Usage       time.Duration
+	Cost        float64 // without taxes
+}
+
+// InvoiceBalanceLine aggregates the *monetary balance changes done by the same actions or API
+type InvoiceBalanceLine struct {
+	Type        string // *debit or *topup
+	Description string // the ActionsID or the source of the change
+	Count       int
+	Amount      float64
+}
+
+// InvoiceTaxLine aggregates the taxes of the same type
+type InvoiceTaxLine struct {
+	Code        string
+	Description string
+	Amount      float64
+}
+
+// InvoiceID returns the ID of the account invoice starting at the given time
+func InvoiceID(acnt string, start time.Time) string {
+	return acnt + utils.Underline + start.UTC().Format("20060102150405")
+}
+
+// cdrDestination returns the destination ID matched when rating the CDR, falling back on its Destination
+func cdrDestination(cdr *CDR) string {
+	if ec := cdr.CostDetails; ec != nil && len(ec.Charges) != 0 {
+		if ru, has := ec.Rating[ec.Charges[0].RatingID]; has && ru.RatingFiltersID != utils.EmptyString {
+			if dstID := utils.IfaceAsString(ec.RatingFilters[ru.RatingFiltersID][utils.DestinationID]); dstID != utils.EmptyString &&
+				dstID != utils.MetaAny {
+				return dstID
+			}
+		}
+	}
+	return cdr.Destination
+}
+
+// cdrTaxLines returns the taxes attached to the CDR, by the TaxProfiles or by SureTax
+func cdrTaxLines(cdr *CDR) (tls []*InvoiceTaxLine, err error) {
+	if txsJsn, has := cdr.ExtraFields[utils.MetaTaxes]; has && txsJsn != utils.EmptyString {
+		var cdrTxs CDRTaxes
+		if err = json.Unmarshal([]byte(txsJsn), &cdrTxs); err != nil {
+			return nil, fmt.Errorf("invalid %s data of CDR <%s>: %w", utils.MetaTaxes, cdr.CGRID, err)
+		}
+		for _, tx := range cdrTxs.Taxes {
+			tls = append(tls, &InvoiceTaxLine{
+				Code:        tx.Code,
+				Description: tx.Description,
+				Amount:      tx.Amount,
+			})
+		}
+	}
+	if stJsn, has := cdr.ExtraFields[utils.MetaSureTax]; has && stJsn != utils.EmptyString {
+		var stResp STResponse
+		if err = json.Unmarshal([]byte(stJsn), &stResp); err != nil {
+			return nil, fmt.Errorf("invalid %s data of CDR <%s>: %w", utils.MetaSureTax, cdr.CGRID, err)
+		}
+		for _, grp := range stResp.GroupList {
+			for _, tx := range grp.TaxList {
+				var amount float64
+				if amount, err = strconv.ParseFloat(tx.TaxAmount, 64); err != nil {
+					return nil, fmt.Errorf("invalid %s amount of CDR <%s>: %w", utils.MetaSureTax, cdr.CGRID, err)
+				}
+				tls = append(tls, &InvoiceTaxLine{
+					Code:        tx.TaxTypeCode,
+					Description: tx.TaxTypeDesc,
+					Amount:      amount,
+				})
+			}
+		}
+	}
+	return
+}
+
+// getInvoiceCDRs returns the rated CDRs matching the filter, ErrNotFound being ignored
+func getInvoiceCDRs(cdrDB CdrStorage, fltr *utils.CDRsFilter) (cdrs []*CDR, err error) {
+	if cdrs, _, err = cdrDB.GetCDRs(fltr, false); err == utils.ErrNotFound {
+		err = nil
+	}
+	return
+}
+
+// NewInvoice aggregates the activity of the account within [start, end) into an invoice.
+// The late CDRs of prev, the last final invoice before the period, are included as well when
+// prev ends where the period starts, otherwise the periods in between would be billed twice.
+func NewInvoice(cdrDB CdrStorage, tnt, acnt string, start, end time.Time, runIDs []string, prev *Invoice) (inv *Invoice, err error) {
+	if !start.Before(end) {
+		return nil, fmt.Errorf("invalid billing period: [%s, %s)",
+			start.Format(time.RFC3339), end.Format(time.RFC3339))
+	}
+	inv = &Invoice{
+		Tenant:         tnt,
+		Account:        acnt,
+		ID:             InvoiceID(acnt, start),
+		PeriodStart:    start,
+		PeriodEnd:      end,
+		GenerationTime: time.Now(),
+	}
+	cdrFltr := &utils.CDRsFilter{
+		Tenants:         []string{tnt},
+		Accounts:        []string{acnt},
+		RunIDs:          runIDs,
+		AnswerTimeStart: &start,
+		AnswerTimeEnd:   &end,
+	}
+	var cdrs []*CDR
+	if cdrs, err = getInvoiceCDRs(cdrDB, cdrFltr); err != nil {
+		return nil, err
+	}
+	if prev != nil && !prev.PeriodEnd.Equal(start) {
+		prev = nil
+	}
+	if prev != nil {
+		lateFltr := &utils.CDRsFilter{
+			Tenants:       []string{tnt},
+			Accounts:      []string{acnt},
+			RunIDs:        runIDs,
+			AnswerTimeEnd: &start,
+			OrderIDStart:  utils.Int64Pointer(prev.LastCDROrderID + 1),
+		}
+		var lateCDRs []*CDR
+		if lateCDRs, err = getInvoiceCDRs(cdrDB, lateFltr); err != nil {
+			return nil, err
+		}
+		cdrs = append(cdrs, lateCDRs...)
+	}
+	// everything answered within the period and stored until now is covered by the invoice
+	var lastCDRs []*CDR
+	if lastCDRs, err = getInvoiceCDRs(cdrDB, &utils.CDRsFilter{
+		Tenants:       []string{tnt},
+		Accounts:      []string{acnt},
+		RunIDs:        runIDs,
+		AnswerTimeEnd: &end,
+		OrderBy:       utils.OrderID + utils.InfieldSep + "desc",
+		Paginator:     utils.Paginator{Limit: utils.IntPointer(1)},
+	}); err != nil {
+		return nil, err
+	}
+	if len(lastCDRs) != 0 {
+		inv.LastCDROrderID = lastCDRs[0].OrderID
+	}
+	if prev != nil {
+		inv.LastCDROrderID = max(inv.LastCDROrderID, prev.LastCDROrderID)
+	}
+
+	usageLines := make(map[string]*InvoiceUsageLine)
+	taxLines := make(map[string]*InvoiceTaxLine)
+	for _, cdr := range cdrs {
+		if cdr.Cost < 0 { // not rated
+			continue
+		}
+		var tls []*InvoiceTaxLine
+		if tls, err = cdrTaxLines(cdr); err != nil {
+			return nil, err
+		}
+		cost := cdr.Cost
+		for _, tl := range tls { // the cost of the CDR includes its taxes
+			cost -= tl.Amount
+			if itl, has := taxLines[tl.Code]; has {
+				itl.Amount += tl.Amount
+			} else {
+				taxLines[tl.Code] = tl
+			}
+		}
+		dst := cdrDestination(cdr)
+		lnKey := utils.ConcatenatedKey(cdr.Category, dst)
+		ln, has := usageLines[lnKey]
+		if !has {
+			ln = &InvoiceUsageLine{
+				Category:    cdr.Category,
+				Destination: dst,
+			}
+			usageLines[lnKey] = ln
+		}
+		ln.CDRs++
+		ln.Usage += cdr.Usage
+		ln.Cost += cost
+	}
+
+	var jes []*BalanceJournalEntry
+	if jes, err = cdrDB.GetBalanceJournal(&utils.BalanceJournalFilter{
+		Tenant:  tnt,
+		Account: acnt,
+		Time:    utils.TimeInterval{Begin: &start, End: &end},
+	}); err != nil && err != utils.ErrNotFound {
+		return nil, err
+	}
+	err = nil
+	blcLines := make(map[string]*InvoiceBalanceLine)
+	for _, je := range jes {
+		if je.BalanceType != utils.MetaMonetary ||
+			je.CGRID != utils.EmptyString || // debited by the CDRs
+			je.Delta == 0 {
+			continue
+		}
+		lnType := utils.MetaDebit
+		if je.Delta > 0 {
+			lnType = utils.MetaTopUp
+		}
+		desc := utils.FirstNonEmpty(je.ActionsID, je.Source)
+		lnKey := utils.ConcatenatedKey(lnType, desc)
+		ln, has := blcLines[lnKey]
+		if !has {
+			ln = &InvoiceBalanceLine{
+				Type:        lnType,
+				Description: desc,
+			}
+			blcLines[lnKey] = ln
+		}
+		ln.Count++
+		if je.Delta > 0 {
+			ln.Amount += je.Delta
+		} else {
+			ln.Amount -= je.Delta
+		}
+	}
+
+	for _, ln := range usageLines {
+		ln.Cost = utils.Round(ln.Cost, globalRoundingDecimals, utils.MetaRoundingMiddle)
+		inv.UsageLines = append(inv.UsageLines, ln)
+		inv.Subtotal += ln.Cost
+	}
+	sort.Slice(inv.UsageLines, func(i, j int) bool {
+		if inv.UsageLines[i].Category == inv.UsageLines[j].Category {
+			return inv.UsageLines[i].Destination < inv.UsageLines[j].Destination
+		}
+		return inv.UsageLines[i].Category < inv.UsageLines[j].Category
+	})
+	for _, ln := range blcLines {
+		ln.Amount = utils.Round(ln.Amount, globalRoundingDecimals, utils.MetaRoundingMiddle)
+		inv.BalanceLines = append(inv.BalanceLines, ln)
+		if ln.Type == utils.MetaDebit {
+			inv.Subtotal += ln.Amount
+		} else {
+			inv.TopUps += ln.Amount
+		}
+	}
+	sort.Slice(inv.BalanceLines, func(i, j int) bool {
+		if inv.BalanceLines[i].Type == inv.BalanceLines[j].Type {
+			return inv.BalanceLines[i].Description < inv.BalanceLines[j].Description
+		}
+		return inv.BalanceLines[i].Type < inv.BalanceLines[j].Type
+	})
+	for _, tl := range taxLines {
+		tl.Amount = utils.Round(tl.Amount, globalRoundingDecimals, utils.MetaRoundingMiddle)
+		inv.TaxLines = append(inv.TaxLines, tl)
+		inv.TaxTotal += tl.Amount
+	}
+	sort.Slice(inv.TaxLines, func(i, j int) bool {
+		return inv.TaxLines[i].Code < inv.TaxLines[j].Code
+	})
+	inv.Subtotal = utils.Round(inv.Subtotal, globalRoundingDecimals, utils.MetaRoundingMiddle)
+	inv.TaxTotal = utils.Round(inv.TaxTotal, globalRoundingDecimals, utils.MetaRoundingMiddle)
+	inv.TopUps = utils.Round(inv.TopUps, globalRoundingDecimals, utils.MetaRoundingMiddle)
+	inv.Total = utils.Round(inv.Subtotal+inv.TaxTotal, globalRoundingDecimals, utils.MetaRoundingMiddle)
+	return
+}
+
+// AsCGREvents returns the invoice as one event per line, ending with the totals, to be exported by EEs
+func (inv *Invoice) AsCGREvents() (cgrEvs []*utils.CGREvent) {
+	newEv := func(lnType string, fields map[string]any) *utils.CGREvent {
+		fields[utils.Tenant] = inv.Tenant
+		fields[utils.AccountField] = inv.Account
+		fields[utils.InvoiceID] = inv.ID
+		fields[utils.PeriodStart] = inv.PeriodStart
+		fields[utils.PeriodEnd] = inv.PeriodEnd
+		fields[utils.LineType] = lnType
+		return &utils.CGREvent{
+			Tenant: inv.Tenant,
+			ID:     utils.GenUUID(),
+			Time:   utils.TimePointer(inv.GenerationTime),
+			Event:  fields,
+			APIOpts: map[string]any{
+				utils.MetaEventType: utils.InvoiceLine,
+			},
+		}
+	}
+	for _, ln := range inv.UsageLines {
+		cgrEvs = append(cgrEvs, newEv(utils.MetaUsage, map[string]any{
+			utils.Category:    ln.Category,
+			utils.Destination: ln.Destination,
+			utils.Count:       ln.CDRs,
+			utils.Usage:       ln.Usage,
+			utils.Amount:      ln.Cost,
+		}))
+	}
+	for _, ln := range inv.BalanceLines {
+		cgrEvs = append(cgrEvs, newEv(ln.Type, map[string]any{
+			utils.Description: ln.Description,
+			utils.Count:       ln.Count,
+			utils.Amount:      ln.Amount,
+		}))
+	}
+	for _, tl := range inv.TaxLines {
+		cgrEvs = append(cgrEvs, newEv(utils.MetaTax, map[string]any{
+			utils.Code:        tl.Code,
+			utils.Description: tl.Description,
+			utils.Amount:      tl.Amount,
+		}))
+	}
+	return append(cgrEvs, newEv(utils.MetaTotal, map[string]any{
+		utils.Subtotal: inv.Subtotal,
+		utils.TaxTotal: inv.TaxTotal,
+		utils.Amount:   inv.Total,
+		utils.TopUps:   inv.TopUps,
+		utils.Final:    inv.Final,
+	}))
+}
@@ -0,0 +1,154 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/utils"
+)
+
+func TestNewInvoice(t *testing.T) {
+	cfg := config.NewDefaultCGRConfig()
+	db, err := NewInternalDB(nil, nil, false, nil, cfg.StorDbCfg().Items)
+	if err != nil {
+		t.Fatal(err)
+	}
+	jan := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	feb := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	mar := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	setCDR := func(cgrID, category, dst string, answer time.Time, usage time.Duration, cost float64, extra map[string]string) {
+		t.Helper()
+		if err := db.SetCDR(&CDR{
+			CGRID:       cgrID,
+			RunID:       utils.MetaDefault,
+			Tenant:      "cgrates.org",
+			Account:     "1001",
+			Category:    category,
+			Destination: dst,
+			AnswerTime:  answer,
+			Usage:       usage,
+			Cost:        cost,
+			ExtraFields: extra,
+		}, false); err != nil {
+			t.Fatal(err)
+		}
+	}
+	setCDR("cgrid0", "call", "1002", jan.Add(-time.Hour), time.Minute, 1, nil) // previous period
+	setCDR("cgrid1", "call", "1002", jan.Add(time.Hour), time.Minute, 1.5, nil)
+	setCDR("cgrid2", "call", "1002", jan.Add(2*time.Hour), 2*time.Minute, 2.5, nil)
+	setCDR("cgrid3", "sms", "1003", jan.Add(3*time.Hour), 0, 1.1, map[string]string{
+		utils.MetaSureTax: `{"TotalTax":"0.1","GroupList":[{"TaxList":[{"TaxTypeCode":"035","TaxTypeDesc":"FEDERAL EXCISE TAX","TaxAmount":"0.1"}]}]}`,
+	})
+	setCDR("cgrid4", "call", "1002", jan.Add(4*time.Hour), time.Minute, -1, nil) // not rated
+	if err = db.SetBalanceJournalEntries([]*BalanceJournalEntry{
+		{Tenant: "cgrates.org", Account: "1001", BalanceType: utils.MetaMonetary, Delta: -10,
+			Source: utils.MetaScheduler, ActionsID: "SUBSCRIPTION", Time: jan.Add(time.Hour)},
+		{Tenant: "cgrates.org", Account: "1001", BalanceType: utils.MetaMonetary, Delta: -10,
+			Source: utils.MetaScheduler, ActionsID: "SUBSCRIPTION", Time: jan.Add(2 * time.Hour)},
+		{Tenant: "cgrates.org", Account: "1001", BalanceType: utils.MetaMonetary, Delta: 50,
+			Source: utils.APIerSv1SetBalance, Time: jan.Add(3 * time.Hour)},
+		{Tenant: "cgrates.org", Account: "1001", BalanceType: utils.MetaMonetary, Delta: -1.5,
+			Source: utils.RALs, CGRID: "cgrid1", Time: jan.Add(time.Hour)}, // part of the CDR cost
+		{Tenant: "cgrates.org", Account: "1001", BalanceType: utils.MetaVoice, Delta: -60,
+			Source: utils.MetaScheduler, ActionsID: "SUBSCRIPTION", Time: jan.Add(time.Hour)},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	inv, err := NewInvoice(db, "cgrates.org", "1001", jan, feb, []string{utils.MetaDefault}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if inv.ID != "1001_20260101000000" {
+		t.Errorf("unexpected ID: %q", inv.ID)
+	}
+	expUsage := []*InvoiceUsageLine{
+		{Category: "call", Destination: "1002", CDRs: 2, Usage: 3 * time.Minute, Cost: 4},
+		{Category: "sms", Destination: "1003", CDRs: 1, Cost: 1},
+	}
+	if !reflect.DeepEqual(expUsage, inv.UsageLines) {
+		t.Errorf("expected %s, received %s", utils.ToJSON(expUsage), utils.ToJSON(inv.UsageLines))
+	}
+	expBlcs := []*InvoiceBalanceLine{
+		{Type: utils.MetaDebit, Description: "SUBSCRIPTION", Count: 2, Amount: 20},
+		{Type: utils.MetaTopUp, Description: utils.APIerSv1SetBalance, Count: 1, Amount: 50},
+	}
+	if !reflect.DeepEqual(expBlcs, inv.BalanceLines) {
+		t.Errorf("expected %s, received %s", utils.ToJSON(expBlcs), utils.ToJSON(inv.BalanceLines))
+	}
+	expTaxes := []*InvoiceTaxLine{{Code: "035", Description: "FEDERAL EXCISE TAX", Amount: 0.1}}
+	if !reflect.DeepEqual(expTaxes, inv.TaxLines) {
+		t.Errorf("expected %s, received %s", utils.ToJSON(expTaxes), utils.ToJSON(inv.TaxLines))
+	}
+	if inv.Subtotal != 25 || inv.TaxTotal != 0.1 || inv.Total != 25.1 || inv.TopUps != 50 {
+		t.Errorf("unexpected totals: %s", utils.ToJSON(inv))
+	}
+	if evs := inv.AsCGREvents(); len(evs) != 6 ||
+		evs[5].Event[utils.LineType] != utils.MetaTotal ||
+		evs[5].Event[utils.Amount] != 25.1 {
+		t.Errorf("unexpected events: %s", utils.ToJSON(evs))
+	}
+
+	// late CDR after finalizing, going to the next invoice
+	inv.Final = true
+	if err = db.SetInvoice(inv); err != nil {
+		t.Fatal(err)
+	}
+	setCDR("cgrid5", "call", "1002", feb.Add(-time.Hour), time.Minute, 0.5, nil)
+	setCDR("cgrid6", "call", "1002", feb.Add(time.Hour), time.Minute, 1, nil)
+	next, err := NewInvoice(db, "cgrates.org", "1001", feb, mar, []string{utils.MetaDefault}, inv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expUsage = []*InvoiceUsageLine{{Category: "call", Destination: "1002", CDRs: 2, Usage: 2 * time.Minute, Cost: 1.5}}
+	if !reflect.DeepEqual(expUsage, next.UsageLines) {
+		t.Errorf("expected %s, received %s", utils.ToJSON(expUsage), utils.ToJSON(next.UsageLines))
+	}
+	if next.LastCDROrderID <= inv.LastCDROrderID {
+		t.Errorf("expected LastCDROrderID over %d, received %d", inv.LastCDROrderID, next.LastCDROrderID)
+	}
+	if err = db.SetInvoice(next); err != nil {
+		t.Fatal(err)
+	}
+	if invs, err := db.GetInvoices(&utils.InvoiceFilter{Tenant: "cgrates.org", Account: "1001"}); err != nil {
+		t.Error(err)
+	} else if len(invs) != 2 || invs[0].ID != inv.ID || !invs[0].Final || invs[1].ID != next.ID {
+		t.Errorf("unexpected invoices: %s", utils.ToJSON(invs))
+	}
+	if _, err = db.GetInvoices(&utils.InvoiceFilter{Tenant: "cgrates.org", Account: "1001",
+		IDs: []string{"unknown"}}); err != utils.ErrNotFound {
+		t.Errorf("expected %v, received %v", utils.ErrNotFound, err)
+	}
+	// the last final invoice is not right before the period, the one in between being still open
+	apr := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+	setCDR("cgrid7", "call", "1002", mar.Add(-time.Hour), time.Minute, 2, nil)
+	setCDR("cgrid8", "call", "1002", mar.Add(time.Hour), time.Minute, 3, nil)
+	after, err := NewInvoice(db, "cgrates.org", "1001", mar, apr, []string{utils.MetaDefault}, inv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expUsage = []*InvoiceUsageLine{{Category: "call", Destination: "1002", CDRs: 1, Usage: time.Minute, Cost: 3}}
+	if !reflect.DeepEqual(expUsage, after.UsageLines) {
+		t.Errorf("expected %s, received %s", utils.ToJSON(expUsage), utils.ToJSON(after.UsageLines))
+	}
+}
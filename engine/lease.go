@@ -0,0 +1,58 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"time"
+)
+
+// Lease grants one of the engines sharing the DataDB the exclusive right to run a task
+type Lease struct {
+	ID         string
+	Holder     string    // NodeID of the engine holding the lease
+	ExpiryTime time.Time // the lease can be taken by other holders after this time
+	Checkpoint time.Time // progress recorded by the holder, kept when the holder changes
+}
+
+// IsHeldBy checks if the lease is held by the holder at the given time
+func (ls *Lease) IsHeldBy(holder string, t time.Time) bool {
+	return ls != nil && ls.Holder == holder && ls.ExpiryTime.After(t)
+}
+
+// Clone returns a copy of the Lease
+func (ls *Lease) Clone() *Lease {
+	if ls == nil {
+		return nil
+	}
+	cln := *ls
+	return &cln
+}
+
+// acquireLease is the logic shared by the drivers storing the lease in memory:
+// the lease is taken if it is free at the given time or already held by the same holder
+func acquireLease(stored, lease *Lease, now time.Time) (*Lease, bool) {
+	if stored != nil && stored.Holder != lease.Holder && stored.ExpiryTime.After(now) {
+		return stored.Clone(), false
+	}
+	nLs := lease.Clone()
+	if nLs.Checkpoint.IsZero() && stored != nil {
+		nLs.Checkpoint = stored.Checkpoint
+	}
+	return nLs, true
+}
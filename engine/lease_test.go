@@ -0,0 +1,53 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAcquireLease(t *testing.T) {
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	chk := now.Add(-time.Hour)
+	stored := &Lease{ID: "SchedulerS", Holder: "node1", ExpiryTime: now.Add(time.Second), Checkpoint: chk}
+
+	// held by another holder
+	if ls, acquired := acquireLease(stored, &Lease{ID: "SchedulerS", Holder: "node2",
+		ExpiryTime: now.Add(10 * time.Second)}, now); acquired || ls.Holder != "node1" {
+		t.Errorf("expected lease held by node1, received: %+v", ls)
+	}
+	// renewal keeps the checkpoint when not provided
+	if ls, acquired := acquireLease(stored, &Lease{ID: "SchedulerS", Holder: "node1",
+		ExpiryTime: now.Add(10 * time.Second)}, now); !acquired || !ls.Checkpoint.Equal(chk) ||
+		!ls.IsHeldBy("node1", now.Add(5*time.Second)) {
+		t.Errorf("unexpected lease: %+v", ls)
+	}
+	// expired lease is taken over
+	if ls, acquired := acquireLease(stored, &Lease{ID: "SchedulerS", Holder: "node2",
+		ExpiryTime: now.Add(12 * time.Second)}, now.Add(2*time.Second)); !acquired ||
+		!ls.IsHeldBy("node2", now.Add(5*time.Second)) || !ls.Checkpoint.Equal(chk) {
+		t.Errorf("unexpected lease: %+v", ls)
+	}
+	// first holder
+	if ls, acquired := acquireLease(nil, &Lease{ID: "SchedulerS", Holder: "node1",
+		ExpiryTime: now.Add(10 * time.Second)}, now); !acquired || !ls.Checkpoint.IsZero() {
+		t.Errorf("unexpected lease: %+v", ls)
+	}
+}
@@ -19,7 +19,11 @@ along with this program.  If not, see <https://www.gnu.org/licenses/>
 package engine
 
 import (
+	"encoding/json"
+	"fmt"
+	"math"
 	"sort"
+	"time"
 
 	"github.com/cgrates/cgrates/utils"
 )
@@ -33,6 +37,8 @@ type ChargerProfile struct {
 	RunID              string
 	AttributeIDs       []string // perform data aliasing based on these Attributes
 	Weight             float64
+	ShareType          string  // <*usage|*cost> split between the runs, *usage if empty
+	Share              float64 // percentage of the usage or cost carried by the run, 0 for no split
 }
 
 // Clone method for ChargerProfile
@@ -41,10 +47,12 @@ func (cp *ChargerProfile) Clone() *ChargerProfile {
 		return nil
 	}
 	clone := &ChargerProfile{
-		Tenant: cp.Tenant,
-		ID:     cp.ID,
-		RunID:  cp.RunID,
-		Weight: cp.Weight,
+		Tenant:    cp.Tenant,
+		ID:        cp.ID,
+		RunID:     cp.RunID,
+		Weight:    cp.Weight,
+		ShareType: cp.ShareType,
+		Share:     cp.Share,
 	}
 	if cp.FilterIDs != nil {
 		clone.FilterIDs = make([]string, len(cp.FilterIDs))
@@ -82,3 +90,147 @@ type ChargerProfiles []*ChargerProfile
 func (cps ChargerProfiles) Sort() {
 	sort.Slice(cps, func(i, j int) bool { return cps[i].Weight > cps[j].Weight })
 }
+
+// CheckShare validates the share of the profile
+func (cp *ChargerProfile) CheckShare() error {
+	if cp.Share < 0 || cp.Share > 100 {
+		return fmt.Errorf("invalid share <%v> of ChargerProfile <%s>, expecting a percentage", cp.Share, cp.TenantID())
+	}
+	switch cp.ShareType {
+	case utils.EmptyString, utils.MetaUsage, utils.MetaCost:
+		return nil
+	default:
+		return fmt.Errorf("unsupported share type <%s> of ChargerProfile <%s>", cp.ShareType, cp.TenantID())
+	}
+}
+
+// splitEpsilon absorbs the float errors when rounding the parts down
+const splitEpsilon = 1e-9
+
+// ChargerSplit records the share carried by one of the runs an event was split into,
+// being attached to the event and its CDR within the *split field
+type ChargerSplit struct {
+	Type    string        // *usage or *cost
+	Share   float64       // percentage carried by the run
+	Residue bool          // the run receives the rounding residue of the split
+	Others  []float64     // shares of the other runs, populated when receiving the residue
+	Usage   time.Duration // usage of the event before splitting
+	Cost    float64       // cost of the run before splitting, populated for *cost by CDRs
+}
+
+// part returns the part of the total units carried by the run, rounded down for all the runs
+// except the one receiving the residue which gets the rest out of the total shares
+func (cs *ChargerSplit) part(units float64) float64 {
+	if !cs.Residue {
+		return math.Floor(units*cs.Share/100 + splitEpsilon)
+	}
+	shares := cs.Share
+	var others float64
+	for _, share := range cs.Others {
+		shares += share
+		others += math.Floor(units*share/100 + splitEpsilon)
+	}
+	return math.Round(units*shares/100) - others
+}
+
+// AsJSON returns the split as it is attached to the events
+func (cs *ChargerSplit) AsJSON() string {
+	b, _ := json.Marshal(cs)
+	return string(b)
+}
+
+// chargerSplits returns the splits of the event for the profiles carrying a share, indexed by profile ID
+// the *usage ones being ignored when the event has no usage
+// the residue is received by the run with the highest weight, the lowest ID breaking the ties
+func chargerSplits(cPs ChargerProfiles, usage *time.Duration) (splits map[string]*ChargerSplit) {
+	splits = make(map[string]*ChargerSplit)
+	residues := make(map[string]*ChargerProfile) // indexed by share type
+	for _, cP := range cPs {
+		if cP.Share <= 0 {
+			continue
+		}
+		split := &ChargerSplit{
+			Type:  utils.FirstNonEmpty(cP.ShareType, utils.MetaUsage),
+			Share: cP.Share,
+		}
+		if split.Type == utils.MetaUsage {
+			if usage == nil {
+				continue
+			}
+			split.Usage = *usage
+		}
+		splits[cP.ID] = split
+		if rsd, has := residues[split.Type]; !has ||
+			cP.Weight > rsd.Weight ||
+			(cP.Weight == rsd.Weight && cP.ID < rsd.ID) {
+			residues[split.Type] = cP
+		}
+	}
+	for splitType, rsd := range residues {
+		split := splits[rsd.ID]
+		split.Residue = true
+		split.Others = make([]float64, 0)
+		for id, oth := range splits {
+			if id != rsd.ID && oth.Type == splitType {
+				split.Others = append(split.Others, oth.Share)
+			}
+		}
+		sort.Float64s(split.Others)
+	}
+	return
+}
+
+// splitEvent applies the *usage split on the event, attaching the split to it
+func (cs *ChargerSplit) splitEvent(ev map[string]any) {
+	if cs.Type == utils.MetaUsage {
+		ev[utils.Usage] = time.Duration(cs.part(float64(cs.Usage)))
+	}
+	ev[utils.MetaSplit] = cs.AsJSON()
+}
+
+// cdrSplit returns the split attached by ChargerS to the CDR, nil if not split
+func cdrSplit(cdr *CDR) (split *ChargerSplit, err error) {
+	splitJSON, has := cdr.ExtraFields[utils.MetaSplit]
+	if !has {
+		return
+	}
+	split = new(ChargerSplit)
+	if err = json.Unmarshal([]byte(splitJSON), split); err != nil {
+		return nil, fmt.Errorf("invalid %s field: %w", utils.MetaSplit, err)
+	}
+	return
+}
+
+// CheckCDRCostSplit rejects the *cost split on accountable request types
+// since the accounts are debited for the whole cost before the split is applied
+func CheckCDRCostSplit(cdr *CDR) (err error) {
+	var split *ChargerSplit
+	if split, err = cdrSplit(cdr); err != nil ||
+		split == nil || split.Type != utils.MetaCost {
+		return
+	}
+	if utils.AccountableRequestTypes.Has(cdr.RequestType) {
+		return fmt.Errorf("%s split not supported for the accountable request type <%s>",
+			utils.MetaCost, cdr.RequestType)
+	}
+	return
+}
+
+// SplitCDRCost applies the *cost split attached by ChargerS on the cost of the rated CDR,
+// recording the cost before splitting within the split
+func SplitCDRCost(cdr *CDR, roundingDecimals int) (err error) {
+	if cdr.Cost < 0 { // not rated
+		return
+	}
+	var split *ChargerSplit
+	if split, err = cdrSplit(cdr); err != nil ||
+		split == nil || split.Type != utils.MetaCost {
+		return
+	}
+	split.Cost = cdr.Cost
+	pow := math.Pow10(roundingDecimals)
+	cdr.Cost = utils.Round(split.part(math.Round(cdr.Cost*pow))/pow,
+		roundingDecimals, utils.MetaRoundingMiddle)
+	cdr.ExtraFields[utils.MetaSplit] = split.AsJSON()
+	return
+}
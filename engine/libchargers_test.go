@@ -19,7 +19,13 @@ along with this program.  If not, see <https://www.gnu.org/licenses/>
 package engine
 
 import (
+	"encoding/json"
+	"reflect"
 	"testing"
+	"time"
+
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/utils"
 )
 
 func TestLibChargersSort(t *testing.T) {
@@ -108,3 +114,113 @@ func TestLibChargersTenantID(t *testing.T) {
 		t.Errorf("TenantID() = %v, want %v", result, expected)
 	}
 }
+
+func TestLibChargersSplitEventCost(t *testing.T) {
+	Cache.Clear(nil)
+	cfg := config.NewDefaultCGRConfig()
+	cfg.ChargerSCfg().IndexedSelects = false
+	data, err := NewInternalDB(nil, nil, true, nil, cfg.DataDbCfg().Items)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dm := NewDataManager(data, cfg.CacheCfg(), nil)
+	for _, cP := range []*ChargerProfile{
+		{Tenant: "cgrates.org", ID: "DEFAULT", RunID: utils.MetaDefault, Weight: 30},
+		{Tenant: "cgrates.org", ID: "CUSTOMER", RunID: "customer", Weight: 20, Share: 70},
+		{Tenant: "cgrates.org", ID: "PARTNER", RunID: "partner", Weight: 10, ShareType: utils.MetaUsage, Share: 30},
+		{Tenant: "cgrates.org", ID: "REVENUE_2", RunID: "revenue_2", ShareType: utils.MetaCost, Share: 50},
+		{Tenant: "cgrates.org", ID: "REVENUE_1", RunID: "revenue_1", ShareType: utils.MetaCost, Share: 50},
+	} {
+		cP.AttributeIDs = []string{utils.MetaNone}
+		if err := dm.SetChargerProfile(cP, true); err != nil {
+			t.Fatal(err)
+		}
+	}
+	cS := NewChargerService(dm, NewFilterS(cfg, nil, dm), cfg, nil)
+	usage := 100*time.Second + time.Nanosecond
+	rply, err := cS.processEvent("cgrates.org", &utils.CGREvent{
+		Tenant:  "cgrates.org",
+		ID:      "splitEv",
+		Event:   map[string]any{utils.AccountField: "1001", utils.Usage: usage},
+		APIOpts: make(map[string]any),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expUsages := map[string]time.Duration{
+		"DEFAULT":   usage,
+		"CUSTOMER":  70*time.Second + time.Nanosecond, // receives the residue, having the highest weight
+		"PARTNER":   30 * time.Second,
+		"REVENUE_1": usage,
+		"REVENUE_2": usage,
+	}
+	expSplits := map[string]*ChargerSplit{
+		"CUSTOMER":  {Type: utils.MetaUsage, Share: 70, Residue: true, Others: []float64{30}, Usage: usage},
+		"PARTNER":   {Type: utils.MetaUsage, Share: 30, Usage: usage},
+		"REVENUE_1": {Type: utils.MetaCost, Share: 50, Residue: true, Others: []float64{50}},
+		"REVENUE_2": {Type: utils.MetaCost, Share: 50},
+	}
+	if len(rply) != len(expUsages) {
+		t.Fatalf("expected %d runs, received: %s", len(expUsages), utils.ToJSON(rply))
+	}
+	cdrs := make(map[string]*CDR)
+	for _, chRply := range rply {
+		ev := MapEvent(chRply.CGREvent.Event)
+		if rcv := ev.GetDurationIgnoreErrors(utils.Usage); rcv != expUsages[chRply.ChargerSProfile] {
+			t.Errorf("%s expected usage %v, received %v", chRply.ChargerSProfile, expUsages[chRply.ChargerSProfile], rcv)
+		}
+		splitJSON, has := ev[utils.MetaSplit]
+		if expSplit := expSplits[chRply.ChargerSProfile]; expSplit == nil {
+			if has {
+				t.Errorf("%s unexpected split: %v", chRply.ChargerSProfile, splitJSON)
+			}
+		} else if splitJSON != expSplit.AsJSON() {
+			t.Errorf("%s expected split %s, received %v", chRply.ChargerSProfile, expSplit.AsJSON(), splitJSON)
+		}
+		if cdrs[chRply.ChargerSProfile], err = ev.AsCDR(cfg, "cgrates.org", utils.EmptyString); err != nil {
+			t.Fatal(err)
+		}
+		cdrs[chRply.ChargerSProfile].Cost = 0.3333
+	}
+
+	for id, expCost := range map[string]float64{
+		"DEFAULT":   0.3333,
+		"CUSTOMER":  0.3333, // *usage split, rated on its part
+		"REVENUE_1": 0.1667,
+		"REVENUE_2": 0.1666,
+	} {
+		if err = SplitCDRCost(cdrs[id], 4); err != nil {
+			t.Fatal(err)
+		}
+		if cdrs[id].Cost != expCost {
+			t.Errorf("%s expected cost %v, received %v", id, expCost, cdrs[id].Cost)
+		}
+	}
+	var split ChargerSplit
+	if err = json.Unmarshal([]byte(cdrs["REVENUE_2"].ExtraFields[utils.MetaSplit]), &split); err != nil {
+		t.Fatal(err)
+	}
+	if exp := (ChargerSplit{Type: utils.MetaCost, Share: 50, Cost: 0.3333}); !reflect.DeepEqual(exp, split) {
+		t.Errorf("expected %+v, received %+v", exp, split)
+	}
+
+	cdrs["REVENUE_1"].RequestType = utils.MetaRated
+	if err = CheckCDRCostSplit(cdrs["REVENUE_1"]); err != nil {
+		t.Error(err)
+	}
+	cdrs["REVENUE_1"].RequestType = utils.MetaPostpaid
+	if err = CheckCDRCostSplit(cdrs["REVENUE_1"]); err == nil {
+		t.Error("expected error for *cost split on accountable request type")
+	}
+	cdrs["CUSTOMER"].RequestType = utils.MetaPostpaid
+	if err = CheckCDRCostSplit(cdrs["CUSTOMER"]); err != nil {
+		t.Error(err)
+	}
+
+	if err = (&ChargerProfile{Share: 101}).CheckShare(); err == nil {
+		t.Error("expected error for share over 100")
+	}
+	if err = (&ChargerProfile{ShareType: utils.MetaDuration, Share: 10}).CheckShare(); err == nil {
+		t.Error("expected error for unsupported share type")
+	}
+}
@@ -323,6 +323,8 @@ func LoadAllDataDBToCache(dm *DataManager) (err error) {
 		utils.ChargerProfilePrefix:     {utils.MetaAny},
 		utils.DispatcherProfilePrefix:  {utils.MetaAny},
 		utils.DispatcherHostPrefix:     {utils.MetaAny},
+		utils.FraudProfilesPrefix:      {utils.MetaAny},
+		utils.TaxProfilesPrefix:        {utils.MetaAny},
 		utils.TimingsPrefix:            {utils.MetaAny},
 		utils.AttributeFilterIndexes:   {utils.MetaAny},
 		utils.ResourceFilterIndexes:    {utils.MetaAny},
@@ -331,6 +333,8 @@ func LoadAllDataDBToCache(dm *DataManager) (err error) {
 		utils.RouteFilterIndexes:       {utils.MetaAny},
 		utils.ChargerFilterIndexes:     {utils.MetaAny},
 		utils.DispatcherFilterIndexes:  {utils.MetaAny},
+		utils.FraudFilterIndexes:       {utils.MetaAny},
+		utils.TaxFilterIndexes:         {utils.MetaAny},
 		utils.FilterIndexPrfx:          {utils.MetaAny},
 	} {
 		if err = dm.CacheDataFromDB(key, ids, false); err != nil {
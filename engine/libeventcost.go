@@ -193,6 +193,7 @@ type BalanceCharge struct {
 	Units         float64 // number of units charged
 	BalanceFactor float64 `json:",omitempty"` // calculation multiplier for units
 	ExtraChargeID string  // used in cases when paying *voice with *monetary
+	ExchangeRate  float64 `json:",omitempty"` // rate converting the rated cost into the balance currency
 }
 
 // FieldAsInterface func to help EventCost FieldAsInterface
@@ -219,6 +220,8 @@ func (bc *BalanceCharge) FieldAsInterface(fldPath []string) (val any, err error)
 		return bc.BalanceFactor, nil
 	case utils.ExtraChargeID:
 		return bc.ExtraChargeID, nil
+	case utils.ExchangeRate:
+		return bc.ExchangeRate, nil
 	}
 }
 
@@ -239,6 +242,7 @@ func (bc *BalanceCharge) Equals(oBC *BalanceCharge) bool {
 		bc.RatingID == oBC.RatingID &&
 		bc.Units == oBC.Units &&
 		bc.BalanceFactor == oBC.BalanceFactor &&
+		bc.ExchangeRate == oBC.ExchangeRate &&
 		bcExtraChargeID == oBCExtraChargeID
 }
 
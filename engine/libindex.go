@@ -85,7 +85,7 @@ func newFilterIndex(dm *DataManager, itemType, tnt, ctx, itemID string,
 			}
 		}
 
-		for _, rule := range fltr.Rules {
+		for _, rule := range fltr.IndexRules() {
 			if !FilterIndexTypes.Has(rule.Type) || IsDynamicDPPath(rule.Element) {
 				continue
 			}
@@ -557,7 +557,7 @@ func UpdateFilterIndex(dm *DataManager, oldFlt, newFlt *Filter) (err error) {
 	oldRules := utils.StringSet{}
 	newRules := utils.StringSet{}    // we only need to determine if we added new rules to rebuild
 	removeRules := utils.StringSet{} // but we need to know what indexes to remove
-	for _, flt := range newFlt.Rules {
+	for _, flt := range newFlt.IndexRules() {
 		if !FilterIndexTypes.Has(flt.Type) ||
 			IsDynamicDPPath(flt.Element) {
 			continue
@@ -582,7 +582,7 @@ func UpdateFilterIndex(dm *DataManager, oldFlt, newFlt *Filter) (err error) {
 			newRules.Add(idxKey)
 		}
 	}
-	for _, flt := range oldFlt.Rules {
+	for _, flt := range oldFlt.IndexRules() {
 		if !FilterIndexTypes.Has(flt.Type) ||
 			IsDynamicDPPath(flt.Element) {
 			continue
@@ -735,6 +735,42 @@ func UpdateFilterIndex(dm *DataManager, oldFlt, newFlt *Filter) (err error) {
 				}, newFlt); err != nil && err != utils.ErrNotFound {
 				return utils.APIErrorHandler(err)
 			}
+		case utils.CacheFraudFilterIndexes:
+			if err = removeFilterIndexesForFilter(dm, idxItmType, newFlt.Tenant, // remove the indexes for the filter
+				removeIndexKeys, indx); err != nil {
+				return
+			}
+			idxSlice := indx.AsSlice()
+			if _, err = ComputeIndexes(dm, newFlt.Tenant, utils.EmptyString, idxItmType, // compute all the indexes for afected items
+				&idxSlice, utils.NonTransactional, func(tnt, id, ctx string) (*[]string, error) {
+					fp, e := dm.GetFraudProfile(tnt, id, true, false, utils.NonTransactional)
+					if e != nil {
+						return nil, e
+					}
+					fltrIDs := make([]string, len(fp.FilterIDs))
+					copy(fltrIDs, fp.FilterIDs)
+					return &fltrIDs, nil
+				}, newFlt); err != nil && err != utils.ErrNotFound {
+				return utils.APIErrorHandler(err)
+			}
+		case utils.CacheTaxFilterIndexes:
+			if err = removeFilterIndexesForFilter(dm, idxItmType, newFlt.Tenant, // remove the indexes for the filter
+				removeIndexKeys, indx); err != nil {
+				return
+			}
+			idxSlice := indx.AsSlice()
+			if _, err = ComputeIndexes(dm, newFlt.Tenant, utils.EmptyString, idxItmType, // compute all the indexes for afected items
+				&idxSlice, utils.NonTransactional, func(tnt, id, ctx string) (*[]string, error) {
+					tp, e := dm.GetTaxProfile(tnt, id, true, false, utils.NonTransactional)
+					if e != nil {
+						return nil, e
+					}
+					fltrIDs := make([]string, len(tp.FilterIDs))
+					copy(fltrIDs, tp.FilterIDs)
+					return &fltrIDs, nil
+				}, newFlt); err != nil && err != utils.ErrNotFound {
+				return utils.APIErrorHandler(err)
+			}
 		case utils.CacheAttributeFilterIndexes:
 			for itemID := range indx {
 				var ap *AttributeProfile
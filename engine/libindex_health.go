@@ -347,6 +347,18 @@ func getFiltersAndContexts(dm *DataManager, indxType, tnt, id string) (filterIDs
 			return
 		}
 		filterIDs = ch.FilterIDs
+	case utils.CacheFraudFilterIndexes:
+		var fp *FraudProfile
+		if fp, err = dm.GetFraudProfile(tnt, id, true, false, utils.NonTransactional); err != nil {
+			return
+		}
+		filterIDs = fp.FilterIDs
+	case utils.CacheTaxFilterIndexes:
+		var tp *TaxProfile
+		if tp, err = dm.GetTaxProfile(tnt, id, true, false, utils.NonTransactional); err != nil {
+			return
+		}
+		filterIDs = tp.FilterIDs
 	case utils.CacheDispatcherFilterIndexes:
 		var ds *DispatcherProfile
 		if ds, err = dm.GetDispatcherProfile(tnt, id, true, false, utils.NonTransactional); err != nil {
@@ -438,7 +450,7 @@ func getIHFltrIdxFromCache(dm *DataManager, fltrIdxCache *ltcache.Cache, idxItmT
 // getFilterAsIndexSet will parse the rules of filter and add them to the index map
 func getFilterAsIndexSet(dm *DataManager, fltrIdxCache *ltcache.Cache, idxItmType, tntCtx string, fltr *Filter) (indexes map[string]utils.StringSet, err error) {
 	indexes = make(map[string]utils.StringSet)
-	for _, flt := range fltr.Rules {
+	for _, flt := range fltr.IndexRules() {
 		if !FilterIndexTypes.Has(flt.Type) ||
 			IsDynamicDPPath(flt.Element) {
 			continue
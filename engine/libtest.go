@@ -266,6 +266,10 @@ func GetDefaultEmptyCacheStats() map[string]*ltcache.CacheStats {
 		utils.CacheChargerFilterIndexes:    {},
 		utils.CacheChargerProfiles:         {},
 		utils.CacheDispatcherFilterIndexes: {},
+		utils.CacheFraudFilterIndexes:      {},
+		utils.CacheFraudProfiles:           {},
+		utils.CacheTaxFilterIndexes:        {},
+		utils.CacheTaxProfiles:             {},
 		utils.CacheDispatcherProfiles:      {},
 		utils.CacheDispatcherHosts:         {},
 		utils.CacheDispatcherRoutes:        {},
@@ -314,6 +318,7 @@ func GetDefaultEmptyCacheStats() map[string]*ltcache.CacheStats {
 		utils.CacheCapsEvents:              {},
 		utils.CacheReplicationHosts:        {},
 		utils.CacheRadiusPackets:           {},
+		utils.CacheRadiusEAPSessions:       {},
 	}
 }
 
@@ -90,6 +90,7 @@ func TestGetDefaultEmptyCacheStats(t *testing.T) {
 		utils.CacheCapsEvents,
 		utils.CacheReplicationHosts,
 		utils.CacheRadiusPackets,
+		utils.CacheRadiusEAPSessions,
 	}
 
 	if len(cacheStats) != len(expectedKeys) {
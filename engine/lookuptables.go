@@ -0,0 +1,240 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/cgrates/birpc/context"
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/utils"
+)
+
+func init() {
+	utils.RegisterDataConverter(utils.MetaLookup, NewLookupConverter)
+}
+
+// LookupTable maps the keys out of the events to values, avoiding one AttributeProfile per key
+type LookupTable struct {
+	Tenant    string
+	ID        string
+	MatchType string            // <*string|*prefix>, the *prefix one matching the longest prefix of the key
+	Entries   map[string]string // key to value
+	Default   string            // returned when no entry matches, empty for no default
+}
+
+// LookupTableWithAPIOpts is used in replicatorV1 for dispatcher
+type LookupTableWithAPIOpts struct {
+	*LookupTable
+	APIOpts map[string]any
+}
+
+// LookupValueArgs is the argument of APIerSv1.GetLookupValue
+type LookupValueArgs struct {
+	Tenant  string
+	ID      string
+	Key     string
+	APIOpts map[string]any
+}
+
+// TenantID returns the concatenated key between tenant and ID
+func (lt *LookupTable) TenantID() string {
+	return utils.ConcatenatedKey(lt.Tenant, lt.ID)
+}
+
+// Clone returns a deep copy of the LookupTable
+func (lt *LookupTable) Clone() *LookupTable {
+	if lt == nil {
+		return nil
+	}
+	cln := &LookupTable{
+		Tenant:    lt.Tenant,
+		ID:        lt.ID,
+		MatchType: lt.MatchType,
+		Default:   lt.Default,
+	}
+	if lt.Entries != nil {
+		cln.Entries = make(map[string]string, len(lt.Entries))
+		for k, v := range lt.Entries {
+			cln.Entries[k] = v
+		}
+	}
+	return cln
+}
+
+// CacheClone returns a clone of LookupTable used by ltcache CacheCloner
+func (lt *LookupTable) CacheClone() any {
+	return lt.Clone()
+}
+
+// Compile checks the MatchType of the LookupTable
+func (lt *LookupTable) Compile() error {
+	switch lt.MatchType {
+	case utils.EmptyString:
+		lt.MatchType = utils.MetaString
+	case utils.MetaString, utils.MetaPrefix:
+	default:
+		return fmt.Errorf("unsupported match type: <%s> for lookup table: <%s>",
+			lt.MatchType, lt.TenantID())
+	}
+	return nil
+}
+
+// Lookup returns the value mapped to the key or the Default one
+func (lt *LookupTable) Lookup(key string) (val string, has bool) {
+	if lt.MatchType == utils.MetaPrefix {
+		for i := len(key); i > 0; i-- {
+			if val, has = lt.Entries[key[:i]]; has {
+				return
+			}
+		}
+	} else if val, has = lt.Entries[key]; has {
+		return
+	}
+	return lt.Default, lt.Default != utils.EmptyString
+}
+
+// getLookupValue queries the value mapped to the key via the APIerS connections of FilterS
+func getLookupValue(tnt, tableID, key string) (val string, err error) {
+	if err = connMgr.Call(context.TODO(), config.CgrConfig().FilterSCfg().ApierSConns,
+		utils.APIerSv1GetLookupValue, &LookupValueArgs{
+			Tenant: tnt,
+			ID:     tableID,
+			Key:    key,
+		}, &val); err != nil {
+		return utils.EmptyString, utils.CastRPCErr(err)
+	}
+	return
+}
+
+// lookupTableTenantID splits the [tenant:]ID reference of a LookupTable, defaulting the tenant to dfltTnt
+func lookupTableTenantID(ref, dfltTnt string) (tnt, tableID string) {
+	if tnt, tableID, has := strings.Cut(ref, utils.InInFieldSep); has {
+		return tnt, tableID
+	}
+	return dfltTnt, ref
+}
+
+// parseLookupAttribute handles the *lookup attribute type having the value: [tenant:]TableID;key
+// the tenant of the table defaults to the one of the event
+func parseLookupAttribute(dp utils.DataProvider, value config.RSRParsers) (out any, err error) {
+	if len(value) != 2 {
+		return nil, fmt.Errorf("invalid arguments <%s> to %s",
+			utils.ToJSON(value), utils.MetaLookup)
+	}
+	var ref string
+	if ref, err = value[0].ParseDataProvider(dp); err != nil {
+		return
+	}
+	var key string
+	if key, err = value[1].ParseDataProvider(dp); err != nil {
+		return
+	}
+	dfltTnt, errTnt := dp.FieldAsString([]string{utils.MetaTenant})
+	if errTnt != nil || dfltTnt == utils.EmptyString {
+		dfltTnt = config.CgrConfig().GeneralCfg().DefaultTenant
+	}
+	tnt, tableID := lookupTableTenantID(ref, dfltTnt)
+	return getLookupValue(tnt, tableID, key)
+}
+
+// NewLookupConverter is the constructor of the *lookup converter having the params: [tenant:]TableID
+func NewLookupConverter(params string) (utils.DataConverter, error) {
+	if params == utils.EmptyString {
+		return nil, fmt.Errorf("missing lookup table ID for %s converter", utils.MetaLookup)
+	}
+	tnt, tableID := lookupTableTenantID(params, config.CgrConfig().GeneralCfg().DefaultTenant)
+	return &LookupConverter{tenant: tnt, tableID: tableID}, nil
+}
+
+// LookupConverter converts a key into its value out of a LookupTable,
+// returning NOT_FOUND if no entry matches and the table has no default
+type LookupConverter struct {
+	tenant  string
+	tableID string
+}
+
+// Convert implements utils.DataConverter
+func (lc *LookupConverter) Convert(in any) (any, error) {
+	return getLookupValue(lc.tenant, lc.tableID, utils.IfaceAsString(in))
+}
+
+// LoadLookupTablesCSV loads the LookupTables out of a CSV with the columns: Tenant,ID,MatchType,Key,Value
+// lines starting with # are ignored, the *default Key sets the Default of the table and the MatchType of the
+// first line is used for the whole table. Every table within the file replaces the stored one.
+func LoadLookupTablesCSV(dm *DataManager, rdr io.Reader, sep rune, dfltTnt string) (tntIDs []string, err error) {
+	csvRdr := csv.NewReader(rdr)
+	csvRdr.Comma = sep
+	csvRdr.Comment = '#'
+	csvRdr.FieldsPerRecord = -1
+	csvRdr.TrimLeadingSpace = true
+	lts := make(map[string]*LookupTable)
+	var record []string
+	for {
+		if record, err = csvRdr.Read(); err != nil {
+			if !errors.Is(err, io.EOF) {
+				return
+			}
+			err = nil
+			break
+		}
+		if len(record) != 5 {
+			line, _ := csvRdr.FieldPos(0)
+			return nil, fmt.Errorf("invalid number of fields: %d at line %d, expecting 5", len(record), line)
+		}
+		for i := range record {
+			record[i] = strings.TrimSpace(record[i])
+		}
+		tnt := utils.FirstNonEmpty(record[0], dfltTnt)
+		if record[1] == utils.EmptyString {
+			line, _ := csvRdr.FieldPos(0)
+			return nil, fmt.Errorf("missing lookup table ID at line %d", line)
+		}
+		tntID := utils.ConcatenatedKey(tnt, record[1])
+		lt, has := lts[tntID]
+		if !has {
+			lt = &LookupTable{
+				Tenant:    tnt,
+				ID:        record[1],
+				MatchType: record[2],
+				Entries:   make(map[string]string),
+			}
+			if err = lt.Compile(); err != nil {
+				return
+			}
+			lts[tntID] = lt
+			tntIDs = append(tntIDs, tntID)
+		}
+		if record[3] == utils.MetaDefault {
+			lt.Default = record[4]
+			continue
+		}
+		lt.Entries[record[3]] = record[4]
+	}
+	for _, tntID := range tntIDs {
+		if err = dm.SetLookupTable(lts[tntID]); err != nil {
+			return
+		}
+	}
+	return
+}
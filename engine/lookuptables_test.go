@@ -0,0 +1,151 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/cgrates/birpc"
+	"github.com/cgrates/birpc/context"
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/utils"
+)
+
+func TestLookupTablesLoadConvertAttribute(t *testing.T) {
+	tmp1, tmp2 := connMgr, config.CgrConfig()
+	defer func() {
+		connMgr = tmp1
+		config.SetCgrConfig(tmp2)
+	}()
+	cfg := config.NewDefaultCGRConfig()
+	cfg.FilterSCfg().ApierSConns = []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaApier)}
+	config.SetCgrConfig(cfg)
+	Cache.Clear(nil)
+	data, err := NewInternalDB(nil, nil, true, nil, cfg.DataDbCfg().Items)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dmLkt := NewDataManager(data, cfg.CacheCfg(), nil)
+
+	csv := `#Tenant,ID,MatchType,Key,Value
+cgrates.org,LKT_CLI,*string,+4986517174963,CUSTOMER_1
+cgrates.org,LKT_CLI,,+4986517174964,CUSTOMER_2
+,LKT_PREFIX,*prefix,49,DE
+,LKT_PREFIX,,4986517,DE_SPECIAL
+,LKT_PREFIX,,*default,OTHER
+itsyscom.com,LKT_CLI,*string,+4986517174963,CUSTOMER_3
+`
+	if tntIDs, err := LoadLookupTablesCSV(dmLkt, strings.NewReader(csv), utils.CSVSep, "cgrates.org"); err != nil {
+		t.Fatal(err)
+	} else if exp := []string{"cgrates.org:LKT_CLI", "cgrates.org:LKT_PREFIX", "itsyscom.com:LKT_CLI"}; !reflect.DeepEqual(exp, tntIDs) {
+		t.Errorf("expected %v, received %v", exp, tntIDs)
+	}
+	exp := &LookupTable{
+		Tenant:    "cgrates.org",
+		ID:        "LKT_CLI",
+		MatchType: utils.MetaString,
+		Entries:   map[string]string{"+4986517174963": "CUSTOMER_1", "+4986517174964": "CUSTOMER_2"},
+	}
+	if lt, err := dmLkt.GetLookupTable("cgrates.org", "LKT_CLI", true, true, utils.NonTransactional); err != nil {
+		t.Fatal(err)
+	} else if !reflect.DeepEqual(exp, lt) {
+		t.Errorf("expected %s, received %s", utils.ToJSON(exp), utils.ToJSON(lt))
+	}
+	for _, csv := range []string{
+		"cgrates.org,LKT_CLI,*suffix,1001,CUSTOMER_1\n",
+		"cgrates.org,,*string,1001,CUSTOMER_1\n",
+		"cgrates.org,LKT_CLI,*string,1001\n",
+	} {
+		if _, err := LoadLookupTablesCSV(dmLkt, strings.NewReader(csv), utils.CSVSep, "cgrates.org"); err == nil {
+			t.Errorf("expected error loading <%s>", csv)
+		}
+	}
+
+	client := make(chan birpc.ClientConnector, 1)
+	client <- &ccMock{
+		calls: map[string]func(ctx *context.Context, args any, reply any) error{
+			utils.APIerSv1GetLookupValue: func(ctx *context.Context, args, reply any) error {
+				lkArgs := args.(*LookupValueArgs)
+				lt, err := dmLkt.GetLookupTable(lkArgs.Tenant, lkArgs.ID, true, true, utils.NonTransactional)
+				if err != nil {
+					return err
+				}
+				val, has := lt.Lookup(lkArgs.Key)
+				if !has {
+					return utils.ErrNotFound
+				}
+				*reply.(*string) = val
+				return nil
+			},
+		},
+	}
+	NewConnManager(cfg, map[string]chan birpc.ClientConnector{
+		utils.ConcatenatedKey(utils.MetaInternal, utils.MetaApier): client,
+	})
+
+	for params, exp := range map[string]map[string]any{
+		"*lookup:LKT_CLI":              {"+4986517174963": "CUSTOMER_1", "+4986517174964": "CUSTOMER_2"},
+		"*lookup:itsyscom.com:LKT_CLI": {"+4986517174963": "CUSTOMER_3"},
+		"*lookup:LKT_PREFIX":           {"4986517174963": "DE_SPECIAL", "4921": "DE", "4021": "OTHER", "": "OTHER"},
+	} {
+		cnv, err := utils.NewDataConverter(params)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for in, out := range exp {
+			if rcv, err := cnv.Convert(in); err != nil {
+				t.Error(err)
+			} else if rcv != out {
+				t.Errorf("%s converting %s expected %q, received %q", params, in, out, rcv)
+			}
+		}
+	}
+	if cnv, err := utils.NewDataConverter("*lookup:LKT_CLI"); err != nil {
+		t.Fatal(err)
+	} else if _, err = cnv.Convert("+4986517174965"); err != utils.ErrNotFound {
+		t.Errorf("expected %v, received %v", utils.ErrNotFound, err)
+	}
+	if _, err = utils.NewDataConverter("*lookup"); err == nil {
+		t.Error("expected error for missing table ID")
+	}
+
+	dp := utils.MapStorage{
+		utils.MetaTenant: "itsyscom.com",
+		utils.MetaReq:    utils.MapStorage{"CLI": "+4986517174963"},
+	}
+	for value, exp := range map[string]string{
+		"LKT_CLI;~*req.CLI":             "CUSTOMER_3",
+		"cgrates.org:LKT_CLI;~*req.CLI": "CUSTOMER_1",
+	} {
+		if out, err := ParseAttribute(dp, utils.MetaLookup, utils.EmptyString,
+			config.NewRSRParsersMustCompile(value, utils.InfieldSep), 0,
+			utils.EmptyString, utils.EmptyString, utils.InfieldSep); err != nil {
+			t.Error(err)
+		} else if out != exp {
+			t.Errorf("%s expected %q, received %q", value, exp, out)
+		}
+	}
+	if _, err = ParseAttribute(dp, utils.MetaLookup, utils.EmptyString,
+		config.NewRSRParsersMustCompile("LKT_CLI", utils.InfieldSep), 0,
+		utils.EmptyString, utils.EmptyString, utils.InfieldSep); err == nil {
+		t.Error("expected error for missing key")
+	}
+}
@@ -41,15 +41,21 @@ func csvLoad(s any, values []string) (any, error) {
 		index := field.Tag.Get("index")
 		if index != utils.EmptyString {
 			idx, err := strconv.Atoi(index)
-			if err != nil || len(values) <= idx {
+			if err != nil {
+				return nil, fmt.Errorf("invalid %v.%v index %v", st.Name(), field.Name, index)
+			}
+			var value string
+			if len(values) > idx {
+				value = values[idx]
+			} else if field.Tag.Get("optional") == utils.EmptyString { // optional columns can be missing from older files
 				return nil, fmt.Errorf("invalid %v.%v index %v", st.Name(), field.Name, index)
 			}
 			if re != utils.EmptyString {
-				if matched, err := regexp.MatchString(re, values[idx]); !matched || err != nil {
-					return nil, fmt.Errorf("invalid %v.%v value %v", st.Name(), field.Name, values[idx])
+				if matched, err := regexp.MatchString(re, value); !matched || err != nil {
+					return nil, fmt.Errorf("invalid %v.%v value %v", st.Name(), field.Name, value)
 				}
 			}
-			fieldValueMap[field.Name] = values[idx]
+			fieldValueMap[field.Name] = value
 		}
 	}
 	elem := reflect.New(st).Elem()
@@ -146,6 +152,22 @@ func getColumnCount(s any) int {
 	return count
 }
 
+// getMandatoryColumnCount returns the number of columns without the optional tag
+// optional columns are the last ones so files written before their introduction still load
+func getMandatoryColumnCount(s any) int {
+	st := reflect.TypeOf(s)
+	numFields := st.NumField()
+	count := 0
+	for i := 0; i < numFields; i++ {
+		field := st.Field(i)
+		if field.Tag.Get("index") != utils.EmptyString &&
+			field.Tag.Get("optional") == utils.EmptyString {
+			count++
+		}
+	}
+	return count
+}
+
 type DestinationMdls []DestinationMdl
 
 func (tps DestinationMdls) AsMapDestinations() (map[string]*Destination, error) {
@@ -429,8 +451,9 @@ func (tps RatingPlanMdls) AsMapTPRatingPlans() (result map[string]*utils.TPRatin
 	result = make(map[string]*utils.TPRatingPlan)
 	for _, tp := range tps {
 		rp := &utils.TPRatingPlan{
-			TPid: tp.Tpid,
-			ID:   tp.Tag,
+			TPid:     tp.Tpid,
+			ID:       tp.Tag,
+			Currency: tp.Currency,
 		}
 		rpb := &utils.TPRatingPlanBinding{
 			DestinationRatesId: tp.DestratesTag,
@@ -442,6 +465,9 @@ func (tps RatingPlanMdls) AsMapTPRatingPlans() (result map[string]*utils.TPRatin
 			result[rp.ID] = rp
 		} else {
 			existing.RatingPlanBindings = append(existing.RatingPlanBindings, rpb)
+			if existing.Currency == utils.EmptyString { // declaring it once is enough
+				existing.Currency = tp.Currency
+			}
 		}
 	}
 	return
@@ -500,6 +526,17 @@ func MapTPRatingPlanBindings(s []*utils.TPRatingPlan) map[string][]*utils.TPRati
 	return result
 }
 
+// MapTPRatingPlanCurrencies returns the currencies declared by the rating plans
+func MapTPRatingPlanCurrencies(s []*utils.TPRatingPlan) map[string]string {
+	result := make(map[string]string)
+	for _, e := range s {
+		if e.Currency != utils.EmptyString {
+			result[e.ID] = e.Currency
+		}
+	}
+	return result
+}
+
 func APItoModelRatingPlan(rp *utils.TPRatingPlan) (result RatingPlanMdls) {
 	if rp != nil {
 		for _, rpb := range rp.RatingPlanBindings {
@@ -509,12 +546,14 @@ func APItoModelRatingPlan(rp *utils.TPRatingPlan) (result RatingPlanMdls) {
 				DestratesTag: rpb.DestinationRatesId,
 				TimingTag:    rpb.TimingId,
 				Weight:       rpb.Weight,
+				Currency:     rp.Currency,
 			})
 		}
 		if len(rp.RatingPlanBindings) == 0 {
 			result = append(result, RatingPlanMdl{
-				Tpid: rp.TPid,
-				Tag:  rp.ID,
+				Tpid:     rp.TPid,
+				Tag:      rp.ID,
+				Currency: rp.Currency,
 			})
 		}
 	}
@@ -2891,7 +2930,7 @@ type ChargerMdls []*ChargerMdl
 // CSVHeader return the header for csv fields as a slice of string
 func (tps ChargerMdls) CSVHeader() (result []string) {
 	return []string{"#" + utils.Tenant, utils.ID, utils.FilterIDs, utils.ActivationIntervalString,
-		utils.RunID, utils.AttributeIDs, utils.Weight}
+		utils.RunID, utils.AttributeIDs, utils.Weight, utils.ShareType, utils.Share}
 }
 
 func (tps ChargerMdls) AsTPChargers() (result []*utils.TPChargerProfile) {
@@ -2930,6 +2969,12 @@ func (tps ChargerMdls) AsTPChargers() (result []*utils.TPChargerProfile) {
 		if tp.RunID != utils.EmptyString {
 			tpCPP.RunID = tp.RunID
 		}
+		if tp.ShareType != utils.EmptyString {
+			tpCPP.ShareType = tp.ShareType
+		}
+		if tp.Share != 0 {
+			tpCPP.Share = tp.Share
+		}
 		if tp.AttributeIDs != utils.EmptyString {
 			attributeSplit := strings.Split(tp.AttributeIDs, utils.InfieldSep)
 			var inlineAttribute string
@@ -2979,11 +3024,13 @@ func APItoModelTPCharger(tpCPP *utils.TPChargerProfile) (mdls ChargerMdls) {
 		}
 		if min == 0 {
 			mdl := &ChargerMdl{
-				Tenant: tpCPP.Tenant,
-				Tpid:   tpCPP.TPid,
-				ID:     tpCPP.ID,
-				Weight: tpCPP.Weight,
-				RunID:  tpCPP.RunID,
+				Tenant:    tpCPP.Tenant,
+				Tpid:      tpCPP.TPid,
+				ID:        tpCPP.ID,
+				Weight:    tpCPP.Weight,
+				RunID:     tpCPP.RunID,
+				ShareType: tpCPP.ShareType,
+				Share:     tpCPP.Share,
 			}
 			if tpCPP.ActivationInterval != nil {
 				if tpCPP.ActivationInterval.ActivationTime != utils.EmptyString {
@@ -3010,6 +3057,8 @@ func APItoModelTPCharger(tpCPP *utils.TPChargerProfile) (mdls ChargerMdls) {
 				if i == 0 {
 					mdl.Weight = tpCPP.Weight
 					mdl.RunID = tpCPP.RunID
+					mdl.ShareType = tpCPP.ShareType
+					mdl.Share = tpCPP.Share
 					if tpCPP.ActivationInterval != nil {
 						if tpCPP.ActivationInterval.ActivationTime != utils.EmptyString {
 							mdl.ActivationInterval = tpCPP.ActivationInterval.ActivationTime
@@ -3059,10 +3108,15 @@ func APItoChargerProfile(tpCPP *utils.TPChargerProfile, timezone string) (cpp *C
 		RunID:        tpCPP.RunID,
 		FilterIDs:    make([]string, len(tpCPP.FilterIDs)),
 		AttributeIDs: make([]string, len(tpCPP.AttributeIDs)),
+		ShareType:    tpCPP.ShareType,
+		Share:        tpCPP.Share,
 	}
 
 	copy(cpp.FilterIDs, tpCPP.FilterIDs)
 	copy(cpp.AttributeIDs, tpCPP.AttributeIDs)
+	if err = cpp.CheckShare(); err != nil {
+		return nil, err
+	}
 
 	if tpCPP.ActivationInterval != nil {
 		if cpp.ActivationInterval, err = tpCPP.ActivationInterval.AsActivationInterval(timezone); err != nil {
@@ -3081,6 +3135,8 @@ func ChargerProfileToAPI(chargerPrf *ChargerProfile) (tpCharger *utils.TPCharger
 		RunID:              chargerPrf.RunID,
 		AttributeIDs:       make([]string, len(chargerPrf.AttributeIDs)),
 		Weight:             chargerPrf.Weight,
+		ShareType:          chargerPrf.ShareType,
+		Share:              chargerPrf.Share,
 	}
 
 	copy(tpCharger.FilterIDs, chargerPrf.FilterIDs)
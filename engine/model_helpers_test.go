@@ -37,6 +37,27 @@ func TestModelHelperCsvLoad(t *testing.T) {
 	}
 }
 
+func TestModelHelperCsvLoadOptionalColumns(t *testing.T) {
+	l, err := csvLoad(RatingPlanMdl{}, []string{"RP_1", "DR_1", utils.MetaAny, "10"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rp := l.(RatingPlanMdl); rp.Tag != "RP_1" || rp.Weight != 10 || rp.Currency != utils.EmptyString {
+		t.Errorf("model load failed: %+v", rp)
+	}
+	if l, err = csvLoad(RatingPlanMdl{}, []string{"RP_1", "DR_1", utils.MetaAny, "10", "EUR"}); err != nil {
+		t.Fatal(err)
+	} else if rp := l.(RatingPlanMdl); rp.Currency != "EUR" {
+		t.Errorf("model load failed: %+v", rp)
+	}
+	if _, err = csvLoad(RatingPlanMdl{}, []string{"RP_1", "DR_1", utils.MetaAny}); err == nil {
+		t.Error("expected error for missing mandatory column")
+	}
+	if cnt, mandatory := getColumnCount(RatingPlanMdl{}), getMandatoryColumnCount(RatingPlanMdl{}); cnt != 5 || mandatory != 4 {
+		t.Errorf("expected 5 columns with 4 mandatory, received %d with %d mandatory", cnt, mandatory)
+	}
+}
+
 func TestModelHelperCsvDump(t *testing.T) {
 	tpd := DestinationMdl{
 		Tag:    "TEST_DEST",
@@ -766,8 +787,8 @@ func TestTPRatingPlanAsExportSlice(t *testing.T) {
 				Weight:             20.0},
 		}}
 	expectedSlc := [][]string{
-		{"TEST_RPLAN", "TEST_DSTRATE1", "TEST_TIMING1", "10"},
-		{"TEST_RPLAN", "TEST_DSTRATE2", "TEST_TIMING2", "20"},
+		{"TEST_RPLAN", "TEST_DSTRATE1", "TEST_TIMING1", "10", ""},
+		{"TEST_RPLAN", "TEST_DSTRATE2", "TEST_TIMING2", "20", ""},
 	}
 
 	ms := APItoModelRatingPlan(tpRpln)
@@ -5054,7 +5075,7 @@ func TestChargerMdlsCSVHeader(t *testing.T) {
 		},
 	}
 	expStruct := []string{"#" + utils.Tenant, utils.ID, utils.FilterIDs, utils.ActivationIntervalString,
-		utils.RunID, utils.AttributeIDs, utils.Weight}
+		utils.RunID, utils.AttributeIDs, utils.Weight, utils.ShareType, utils.Share}
 
 	result := testStruct.CSVHeader()
 	if !reflect.DeepEqual(result, expStruct) {
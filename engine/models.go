@@ -19,6 +19,7 @@ along with this program.  If not, see <https://www.gnu.org/licenses/>
 package engine
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -97,6 +98,7 @@ type RatingPlanMdl struct {
 	DestratesTag string  `index:"1" re:".*"`
 	TimingTag    string  `index:"2" re:".*"`
 	Weight       float64 `index:"3" re:".*"`
+	Currency     string  `index:"4" re:"^([A-Z]{3})?$" optional:"true"`
 	CreatedAt    time.Time
 }
 
@@ -466,6 +468,71 @@ func (t SessionCostsSQL) TableName() string {
 	return utils.SessionCostsTBL
 }
 
+type BalanceJournalSQL struct {
+	ID          int64
+	Tenant      string
+	Account     string
+	BalanceType string
+	BalanceUUID string
+	BalanceID   string
+	Before      float64
+	After       float64
+	Delta       float64
+	Source      string
+	ActionsID   string
+	ActionID    string
+	Cgrid       string
+	CreatedAt   time.Time
+}
+
+func (t BalanceJournalSQL) TableName() string {
+	return utils.BalanceJournalTBL
+}
+
+// InvoiceSQL stores the invoice as JSON, keeping aside the fields it is queried by
+type InvoiceSQL struct {
+	ID          int64
+	Tenant      string
+	Account     string
+	InvoiceID   string
+	PeriodStart time.Time
+	PeriodEnd   time.Time
+	Final       bool
+	Content     string
+	CreatedAt   time.Time
+}
+
+func (t InvoiceSQL) TableName() string {
+	return utils.InvoicesTBL
+}
+
+// NewInvoiceSQL converts the invoice into its SQL model
+func NewInvoiceSQL(inv *Invoice) (*InvoiceSQL, error) {
+	content, err := json.Marshal(inv)
+	if err != nil {
+		return nil, err
+	}
+	return &InvoiceSQL{
+		Tenant:      inv.Tenant,
+		Account:     inv.Account,
+		InvoiceID:   inv.ID,
+		PeriodStart: inv.PeriodStart,
+		PeriodEnd:   inv.PeriodEnd,
+		Final:       inv.Final,
+		Content:     string(content),
+		CreatedAt:   inv.GenerationTime,
+	}, nil
+}
+
+// AsInvoice converts the SQL model back into the invoice
+func (t *InvoiceSQL) AsInvoice() (inv *Invoice, err error) {
+	inv = new(Invoice)
+	if err = json.Unmarshal([]byte(t.Content), inv); err != nil {
+		return nil, err
+	}
+	return
+}
+
 type TBLVersion struct {
 	ID      uint
 	Item    string
@@ -533,6 +600,8 @@ type ChargerMdl struct {
 	RunID              string  `index:"4" re:".*"`
 	AttributeIDs       string  `index:"5" re:".*"`
 	Weight             float64 `index:"6" re:".*"`
+	ShareType          string  `index:"7" re:"^([*]usage|[*]cost)?$" optional:"true"`
+	Share              float64 `index:"8" re:".*" optional:"true"`
 	CreatedAt          time.Time
 }
 
@@ -0,0 +1,168 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/cgrates/birpc/context"
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/utils"
+)
+
+func init() {
+	utils.RegisterDataConverter(utils.MetaPorted, NewPortedNumberConverter)
+}
+
+// PortedNumber holds the porting information of a number, out of the number portability database
+type PortedNumber struct {
+	Number            string // the number as received within the events
+	RoutingNumber     string // identifies the network currently serving the number
+	DonorOperator     string // the operator the number was originally allocated to
+	RecipientOperator string // the operator the number was ported to
+}
+
+// PortedNumberWithAPIOpts is used in replicatorV1 for dispatcher
+type PortedNumberWithAPIOpts struct {
+	*PortedNumber
+	APIOpts map[string]any
+}
+
+// Clone returns a copy of the PortedNumber
+func (pn *PortedNumber) Clone() *PortedNumber {
+	if pn == nil {
+		return nil
+	}
+	cln := *pn
+	return &cln
+}
+
+// CacheClone returns a clone of PortedNumber used by ltcache CacheCloner
+func (pn *PortedNumber) CacheClone() any {
+	return pn.Clone()
+}
+
+// FieldAsString returns the porting information selected by fldName
+// <*routing_number|*routed|*donor|*recipient>
+func (pn *PortedNumber) FieldAsString(fldName string) (string, error) {
+	switch fldName {
+	case utils.EmptyString, utils.MetaRoutingNumber:
+		return pn.RoutingNumber, nil
+	case utils.MetaRouted:
+		return pn.RoutingNumber + pn.Number, nil
+	case utils.MetaDonor:
+		return pn.DonorOperator, nil
+	case utils.MetaRecipient:
+		return pn.RecipientOperator, nil
+	}
+	return utils.EmptyString, fmt.Errorf("unsupported ported number field: <%s>", fldName)
+}
+
+// getPortedNumber queries the porting information of the number via the APIerS connections of FilterS
+func getPortedNumber(number string) (pn *PortedNumber, err error) {
+	var rply PortedNumber
+	if err = connMgr.Call(context.TODO(), config.CgrConfig().FilterSCfg().ApierSConns,
+		utils.APIerSv1GetPortedNumber, &number, &rply); err != nil {
+		return nil, utils.CastRPCErr(err)
+	}
+	return &rply, nil
+}
+
+// NewPortedNumberConverter is the constructor of the *ported converter
+func NewPortedNumberConverter(params string) (utils.DataConverter, error) {
+	if _, err := new(PortedNumber).FieldAsString(params); err != nil {
+		return nil, err
+	}
+	return &PortedNumberConverter{field: params}, nil
+}
+
+// PortedNumberConverter converts a number into its porting information,
+// numbers which are not ported convert to empty string, except for *routed which returns them unchanged
+type PortedNumberConverter struct {
+	field string
+}
+
+// Convert implements utils.DataConverter
+func (pc *PortedNumberConverter) Convert(in any) (out any, err error) {
+	number := utils.IfaceAsString(in)
+	var pn *PortedNumber
+	if pn, err = getPortedNumber(number); err != nil {
+		if err != utils.ErrNotFound {
+			return nil, err
+		}
+		if pc.field == utils.MetaRouted {
+			return number, nil
+		}
+		return utils.EmptyString, nil
+	}
+	return pn.FieldAsString(pc.field)
+}
+
+// LoadPortedNumbersCSV loads the ported numbers out of a CSV or a number portability database dump
+// with the columns: Number,RoutingNumber[,DonorOperator[,RecipientOperator]]
+// lines starting with # are ignored and the ones having only the Number remove it (the number was ported back)
+func LoadPortedNumbersCSV(dm *DataManager, rdr io.Reader, sep rune) (loaded, removed int, err error) {
+	csvRdr := csv.NewReader(rdr)
+	csvRdr.Comma = sep
+	csvRdr.Comment = '#'
+	csvRdr.FieldsPerRecord = -1
+	csvRdr.TrimLeadingSpace = true
+	var record []string
+	for {
+		if record, err = csvRdr.Read(); err != nil {
+			if errors.Is(err, io.EOF) {
+				err = nil
+			}
+			return
+		}
+		pn := &PortedNumber{Number: strings.TrimSpace(record[0])}
+		if pn.Number == utils.EmptyString {
+			continue
+		}
+		if len(record) > 1 {
+			pn.RoutingNumber = strings.TrimSpace(record[1])
+		}
+		if len(record) > 2 {
+			pn.DonorOperator = strings.TrimSpace(record[2])
+		}
+		if len(record) > 3 {
+			pn.RecipientOperator = strings.TrimSpace(record[3])
+		}
+		if pn.RoutingNumber == utils.EmptyString &&
+			pn.RecipientOperator == utils.EmptyString {
+			if err = dm.RemovePortedNumber(pn.Number); err != nil {
+				if err != utils.ErrNotFound {
+					return
+				}
+				err = nil
+				continue
+			}
+			removed++
+			continue
+		}
+		if err = dm.SetPortedNumber(pn); err != nil {
+			return
+		}
+		loaded++
+	}
+}
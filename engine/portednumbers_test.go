@@ -0,0 +1,147 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/cgrates/birpc"
+	"github.com/cgrates/birpc/context"
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/utils"
+)
+
+func TestPortedNumbersLoadConvertFilter(t *testing.T) {
+	tmp1, tmp2 := connMgr, config.CgrConfig()
+	defer func() {
+		connMgr = tmp1
+		config.SetCgrConfig(tmp2)
+	}()
+	cfg := config.NewDefaultCGRConfig()
+	cfg.FilterSCfg().ApierSConns = []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaApier)}
+	config.SetCgrConfig(cfg)
+	Cache.Clear(nil)
+	data, err := NewInternalDB(nil, nil, true, nil, cfg.DataDbCfg().Items)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dmPn := NewDataManager(data, cfg.CacheCfg(), nil)
+
+	csv := `#Number;RoutingNumber;DonorOperator;RecipientOperator
+4917612345;D123;OP_DONOR;OP_RECIPIENT
+4917654321;D456
+4917600000;D123;OP_DONOR;OP_RECIPIENT
+`
+	if loaded, removed, err := LoadPortedNumbersCSV(dmPn, strings.NewReader(csv), ';'); err != nil {
+		t.Fatal(err)
+	} else if loaded != 3 || removed != 0 {
+		t.Errorf("expected 3 loaded and 0 removed, received %d and %d", loaded, removed)
+	}
+	// the number ported back is removed
+	if loaded, removed, err := LoadPortedNumbersCSV(dmPn, strings.NewReader("4917600000\n"), ';'); err != nil {
+		t.Fatal(err)
+	} else if loaded != 0 || removed != 1 {
+		t.Errorf("expected 0 loaded and 1 removed, received %d and %d", loaded, removed)
+	}
+	if _, err = dmPn.GetPortedNumber("4917600000", true, true, utils.NonTransactional); err != utils.ErrNotFound {
+		t.Errorf("expected %v, received %v", utils.ErrNotFound, err)
+	}
+	exp := &PortedNumber{Number: "4917612345", RoutingNumber: "D123",
+		DonorOperator: "OP_DONOR", RecipientOperator: "OP_RECIPIENT"}
+	if pn, err := dmPn.GetPortedNumber("4917612345", true, true, utils.NonTransactional); err != nil {
+		t.Fatal(err)
+	} else if !reflect.DeepEqual(exp, pn) {
+		t.Errorf("expected %+v, received %+v", exp, pn)
+	}
+
+	client := make(chan birpc.ClientConnector, 1)
+	client <- &ccMock{
+		calls: map[string]func(ctx *context.Context, args any, reply any) error{
+			utils.APIerSv1GetPortedNumber: func(ctx *context.Context, args, reply any) error {
+				pn, err := dmPn.GetPortedNumber(*args.(*string), true, true, utils.NonTransactional)
+				if err != nil {
+					return err
+				}
+				*reply.(*PortedNumber) = *pn
+				return nil
+			},
+		},
+	}
+	NewConnManager(cfg, map[string]chan birpc.ClientConnector{
+		utils.ConcatenatedKey(utils.MetaInternal, utils.MetaApier): client,
+	})
+
+	for params, exp := range map[string]map[string]any{
+		"*ported":                 {"4917612345": "D123", "4917654321": "D456", "4917699999": ""},
+		"*ported:*routed":         {"4917612345": "D1234917612345", "4917699999": "4917699999"},
+		"*ported:*recipient":      {"4917612345": "OP_RECIPIENT", "4917654321": ""},
+		"*ported:*donor":          {"4917612345": "OP_DONOR"},
+		"*ported:*routing_number": {"4917654321": "D456"},
+	} {
+		cnv, err := utils.NewDataConverter(params)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for in, out := range exp {
+			if rcv, err := cnv.Convert(in); err != nil {
+				t.Error(err)
+			} else if rcv != out {
+				t.Errorf("%s converting %s expected %q, received %q", params, in, out, rcv)
+			}
+		}
+	}
+	if _, err = utils.NewDataConverter("*ported:*unknown"); err == nil {
+		t.Error("expected error for unsupported field")
+	}
+
+	dDP := utils.MapStorage{utils.MetaReq: utils.MapStorage{utils.Destination: "4917612345"}}
+	for _, tc := range []struct {
+		rType string
+		vals  []string
+		exp   bool
+	}{
+		{utils.MetaPorted, nil, true},
+		{utils.MetaPorted, []string{"OP_RECIPIENT"}, true},
+		{utils.MetaPorted, []string{"D123"}, true},
+		{utils.MetaPorted, []string{"OP_DONOR"}, false},
+		{utils.MetaNotPorted, nil, false},
+	} {
+		fltr, err := NewFilterRule(tc.rType, "~*req.Destination", tc.vals)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if pass, err := fltr.Pass(dDP); err != nil {
+			t.Error(err)
+		} else if pass != tc.exp {
+			t.Errorf("%s%v expected %v, received %v", tc.rType, tc.vals, tc.exp, pass)
+		}
+	}
+	dDP[utils.MetaReq] = utils.MapStorage{utils.Destination: "4917699999"}
+	fltr, err := NewFilterRule(utils.MetaNotPorted, "~*req.Destination", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pass, err := fltr.Pass(dDP); err != nil {
+		t.Error(err)
+	} else if !pass {
+		t.Error("expected the not ported number to pass *notported")
+	}
+}
@@ -0,0 +1,88 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cgrates/cgrates/utils"
+)
+
+// ResourceRateLimit turns a resource into a rate limiter, allowing Rate units per Interval
+// with bursts of up to Burst units, instead of counting the concurrent usages
+type ResourceRateLimit struct {
+	Rate     float64       // units allowed per Interval
+	Interval time.Duration // defaults to one second
+	Burst    float64       // units allowed at once, defaults to Rate
+	Shared   bool          // keep the limiter state within DataDB, shared by all the engines using it
+}
+
+// Clone returns a copy of the ResourceRateLimit
+func (rl *ResourceRateLimit) Clone() *ResourceRateLimit {
+	if rl == nil {
+		return nil
+	}
+	cln := *rl
+	return &cln
+}
+
+// Validate checks the rate limit parameters
+func (rl *ResourceRateLimit) Validate() error {
+	if rl.Rate <= 0 {
+		return fmt.Errorf("invalid rate limit Rate: %v", rl.Rate)
+	}
+	if rl.Interval < 0 || rl.Burst < 0 {
+		return fmt.Errorf("%w rate limit Interval or Burst", utils.ErrNegative)
+	}
+	return nil
+}
+
+// emission returns the time needed to replenish one unit
+func (rl *ResourceRateLimit) emission() time.Duration {
+	intvl := rl.Interval
+	if intvl == 0 {
+		intvl = time.Second
+	}
+	return time.Duration(float64(intvl) / rl.Rate)
+}
+
+// limits returns the GCRA increment for the units together with the tolerance given by the burst
+func (rl *ResourceRateLimit) limits(units float64) (increment, tolerance time.Duration) {
+	emission := rl.emission()
+	burst := rl.Burst
+	if burst == 0 {
+		burst = rl.Rate
+	}
+	return time.Duration(float64(emission) * units), time.Duration(float64(emission) * burst)
+}
+
+// gcra is the generic cell rate algorithm: the increment is allowed if it does not push the
+// theoretical arrival time (tat) further than tolerance from now, returning the new tat if
+// allowed or the duration to retry after otherwise
+func gcra(tat, now time.Time, increment, tolerance time.Duration) (nTAT time.Time, retryAfter time.Duration) {
+	if tat.Before(now) {
+		tat = now
+	}
+	nTAT = tat.Add(increment)
+	if retryAfter = nTAT.Sub(now) - tolerance; retryAfter > 0 {
+		return tat, retryAfter
+	}
+	return nTAT, 0
+}
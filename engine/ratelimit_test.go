@@ -0,0 +1,130 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/cgrates/birpc/context"
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/utils"
+)
+
+func TestGCRA(t *testing.T) {
+	rl := &ResourceRateLimit{Rate: 2, Burst: 3} // one unit each 500ms, 3 at once
+	increment, tolerance := rl.limits(1)
+	if increment != 500*time.Millisecond || tolerance != 1500*time.Millisecond {
+		t.Fatalf("unexpected limits: %v, %v", increment, tolerance)
+	}
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	var tat time.Time
+	var retryAfter time.Duration
+	for i := 0; i < 3; i++ {
+		if tat, retryAfter = gcra(tat, now, increment, tolerance); retryAfter != 0 {
+			t.Fatalf("burst unit %d not allowed, retry after %v", i, retryAfter)
+		}
+	}
+	if tat, retryAfter = gcra(tat, now, increment, tolerance); retryAfter != 500*time.Millisecond {
+		t.Errorf("expected retry after 500ms, received %v", retryAfter)
+	}
+	// one unit replenished
+	if _, retryAfter = gcra(tat, now.Add(500*time.Millisecond), increment, tolerance); retryAfter != 0 {
+		t.Errorf("expected allowed, retry after %v", retryAfter)
+	}
+}
+
+func TestInternalDBRateLimitDrv(t *testing.T) {
+	cfg := config.NewDefaultCGRConfig()
+	data, err := NewInternalDB(nil, nil, true, nil, cfg.DataDbCfg().Items)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	if retryAfter, err := data.RateLimitDrv("cgrates.org:RL1", time.Second, time.Second, now, true); err != nil || retryAfter != 0 {
+		t.Fatalf("unexpected dry run: %v, %v", retryAfter, err)
+	}
+	if retryAfter, err := data.RateLimitDrv("cgrates.org:RL1", time.Second, time.Second, now, false); err != nil || retryAfter != 0 {
+		t.Fatalf("unexpected allocation: %v, %v", retryAfter, err)
+	}
+	if retryAfter, err := data.RateLimitDrv("cgrates.org:RL1", time.Second, time.Second, now, false); err != nil || retryAfter != time.Second {
+		t.Errorf("expected retry after 1s, received: %v, %v", retryAfter, err)
+	}
+}
+
+func TestResourcesRateLimit(t *testing.T) {
+	for _, shared := range []bool{false, true} {
+		Cache.Clear(nil)
+		cfg := config.NewDefaultCGRConfig()
+		data, err := NewInternalDB(nil, nil, true, nil, cfg.DataDbCfg().Items)
+		if err != nil {
+			t.Fatal(err)
+		}
+		dm := NewDataManager(data, cfg.CacheCfg(), nil)
+		if err = dm.SetResourceProfile(&ResourceProfile{
+			Tenant:            "cgrates.org",
+			ID:                "CPS_1001",
+			FilterIDs:         []string{"*string:~*req.Account:1001"},
+			ThresholdIDs:      []string{utils.MetaNone},
+			AllocationMessage: "CPS",
+			RateLimit:         &ResourceRateLimit{Rate: 2, Interval: time.Minute, Shared: shared},
+		}, true); err != nil {
+			t.Fatal(err)
+		}
+		rS := NewResourceService(dm, cfg, NewFilterS(cfg, nil, dm), nil)
+		newEv := func(i int) *utils.CGREvent {
+			return &utils.CGREvent{
+				ID:    fmt.Sprintf("Ev%d", i),
+				Event: map[string]any{utils.AccountField: "1001"},
+				APIOpts: map[string]any{
+					utils.OptsResourcesUsageID: fmt.Sprintf("RU%d", i),
+					utils.OptsResourcesUnits:   1,
+				},
+			}
+		}
+		var reply string
+		for i := 0; i < 2; i++ {
+			if err = rS.V1AuthorizeResources(context.Background(), newEv(i), &reply); err != nil {
+				t.Fatal(err)
+			}
+			if err = rS.V1AllocateResources(context.Background(), newEv(i), &reply); err != nil {
+				t.Fatal(err)
+			} else if reply != "CPS" {
+				t.Errorf("unexpected reply: %q", reply)
+			}
+		}
+		if err = rS.V1AuthorizeResources(context.Background(), newEv(2), &reply); !errors.Is(err, utils.ErrResourceUnauthorized) {
+			t.Errorf("expected %v, received %v", utils.ErrResourceUnauthorized, err)
+		}
+		err = rS.V1AllocateResources(context.Background(), newEv(3), &reply)
+		if !errors.Is(err, utils.ErrResourceUnavailable) {
+			t.Fatalf("expected %v, received %v", utils.ErrResourceUnavailable, err)
+		}
+		if retryAfter, has := utils.RetryAfterFromError(utils.NewErrResourceS(err)); !has ||
+			retryAfter <= 0 || retryAfter > 30*time.Second {
+			t.Errorf("unexpected retry after: %v, %v", retryAfter, has)
+		}
+		// no usages are recorded by the rate limiters
+		if err = rS.V1ReleaseResources(context.Background(), newEv(0), &reply); err != nil {
+			t.Error(err)
+		}
+	}
+}
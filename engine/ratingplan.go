@@ -30,6 +30,7 @@ type RatingPlan struct {
 	Timings          map[string]*RITiming
 	Ratings          map[string]*RIRate
 	DestinationRates map[string]RPRateList
+	Currency         string `json:",omitempty"` // ISO 4217 currency of the rates, empty for the implicit one
 }
 
 // Clone returns a clone of RatingPlan
@@ -38,7 +39,8 @@ func (rp *RatingPlan) Clone() *RatingPlan {
 		return nil
 	}
 	result := &RatingPlan{
-		Id: rp.Id,
+		Id:       rp.Id,
+		Currency: rp.Currency,
 	}
 	if rp.Timings != nil {
 		result.Timings = make(map[string]*RITiming, len(rp.Timings))
@@ -145,6 +145,7 @@ type RatingInfo struct {
 	ActivationTime time.Time
 	RateIntervals  RateIntervalList
 	FallbackKeys   []string
+	Currency       string `json:",omitempty"` // currency of the rating plan
 }
 
 // SelectRatingIntevalsForTimespan orders rate intervals in time preserving only those which aply to the specified timestamp
@@ -285,7 +286,8 @@ func (rpf *RatingProfile) GetRatingPlansForPrefix(cd *CallDescriptor) (err error
 				MatchedDestId:  destinationID,
 				ActivationTime: rpa.ActivationTime,
 				RateIntervals:  rps,
-				FallbackKeys:   rpa.FallbackKeys})
+				FallbackKeys:   rpa.FallbackKeys,
+				Currency:       rpl.Currency})
 		} else {
 			// add for fallback information
 			if len(rpa.FallbackKeys) > 0 {
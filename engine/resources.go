@@ -44,8 +44,10 @@ type ResourceProfile struct {
 	AllocationMessage  string                    // message returned by the winning resource on allocation
 	Blocker            bool                      // blocker flag to stop processing on filters matched
 	Stored             bool
-	Weight             float64  // Weight to sort the resources
-	ThresholdIDs       []string // Thresholds to check after changing Limit
+	Weight             float64            // Weight to sort the resources
+	ThresholdIDs       []string           // Thresholds to check after changing Limit
+	RateLimit          *ResourceRateLimit // limits the rate of the allocations, Limit and UsageTTL being ignored
+	ParentID           string             // the resource pool consumed together with this resource
 
 	lkID string // holds the reference towards guardian lock key
 }
@@ -64,6 +66,7 @@ func (rp *ResourceProfile) Clone() *ResourceProfile {
 		Blocker:           rp.Blocker,
 		Stored:            rp.Stored,
 		Weight:            rp.Weight,
+		ParentID:          rp.ParentID,
 	}
 	if rp.FilterIDs != nil {
 		clone.FilterIDs = make([]string, len(rp.FilterIDs))
@@ -76,6 +79,7 @@ func (rp *ResourceProfile) Clone() *ResourceProfile {
 	if rp.ActivationInterval != nil {
 		clone.ActivationInterval = rp.ActivationInterval.Clone()
 	}
+	clone.RateLimit = rp.RateLimit.Clone()
 	return clone
 }
 
@@ -162,11 +166,14 @@ type Resource struct {
 	ID     string
 	Usages map[string]*ResourceUsage
 	TTLIdx []string         // holds ordered list of ResourceIDs based on their TTL, empty if feature is disableda
+	TAT    time.Time        // theoretical arrival time of the next allocation on rate limiters not shared
 	lkID   string           // ID of the lock used when matching the resource
 	ttl    *time.Duration   // time to leave for this resource, picked up on each Resource initialization out of config
 	tUsage *float64         // sum of all usages
 	dirty  *bool            // the usages were modified, needs save, *bool so we only save if enabled in config
 	rPrf   *ResourceProfile // for ordering purposes
+	parent *Resource        // the parent pool, consumed together with this resource
+	isPrnt bool             // loaded as parent of the resources matching the event
 }
 
 // Clone clones *Resource (lkID excluded)
@@ -177,6 +184,7 @@ func (r *Resource) Clone() *Resource {
 	clone := &Resource{
 		Tenant: r.Tenant,
 		ID:     r.ID,
+		TAT:    r.TAT,
 	}
 	if r.Usages != nil {
 		clone.Usages = make(map[string]*ResourceUsage, len(r.Usages))
@@ -349,6 +357,37 @@ func (r *Resource) clearUsage(ruID string) (err error) {
 	return
 }
 
+// available checks if the units fit within the limits of the resource and of all its parents
+// the rate limiters are skipped, being checked by ResourceService.rateLimit
+func (r *Resource) available(units float64) bool {
+	for ; r != nil; r = r.parent {
+		if !r.isRateLimiter() &&
+			r.rPrf.Limit != -1 && r.rPrf.Limit < r.TotalUsage()+units {
+			return false
+		}
+	}
+	return true
+}
+
+// isRateLimiter checks if the resource limits the rate of the allocations instead of counting the usages
+func (r *Resource) isRateLimiter() bool {
+	return r.rPrf != nil && r.rPrf.RateLimit != nil
+}
+
+// rateLimit applies the units on the rate limiter of the resource, unless dryRun,
+// returning the duration to retry after if they are not allowed
+func (r *Resource) rateLimit(dm *DataManager, units float64, dryRun bool) (retryAfter time.Duration, err error) {
+	increment, tolerance := r.rPrf.RateLimit.limits(units)
+	if r.rPrf.RateLimit.Shared {
+		return dm.RateLimit(r.TenantID(), increment, tolerance, dryRun)
+	}
+	var nTAT time.Time
+	if nTAT, retryAfter = gcra(r.TAT, time.Now(), increment, tolerance); retryAfter == 0 && !dryRun {
+		r.TAT = nTAT
+	}
+	return
+}
+
 // Resources is an orderable list of Resources based on Weight
 type Resources []*Resource
 
@@ -358,11 +397,29 @@ func (rs Resources) Sort() {
 }
 
 // unlock will unlock resources part of this slice
+// the lock references are cleared before unlocking since the resources can share the same one
 func (rs Resources) unlock() {
+	lkIDs := utils.NewStringSet(nil)
 	for _, r := range rs {
-		r.unlock()
+		lkIDs.Add(r.lkID)
+		r.lkID = utils.EmptyString
+		if r.rPrf != nil {
+			lkIDs.Add(r.rPrf.lkID)
+			r.rPrf.lkID = utils.EmptyString
+		}
+	}
+	for lkID := range lkIDs {
+		guardian.Guardian.UnguardIDs(lkID)
+	}
+}
+
+// release clears the lock references of the resources part of this slice without unlocking them,
+// the shared lock being released together with the rest of the resources
+func (rs Resources) release() {
+	for _, r := range rs {
+		r.lkID = utils.EmptyString
 		if r.rPrf != nil {
-			r.rPrf.unlock()
+			r.rPrf.lkID = utils.EmptyString
 		}
 	}
 }
@@ -390,6 +447,9 @@ func (rs Resources) recordUsage(ru *ResourceUsage) (err error) {
 // clearUsage gives back the units to the pool
 func (rs Resources) clearUsage(ruTntID string) (err error) {
 	for _, r := range rs {
+		if r.isRateLimiter() { // no usages recorded
+			continue
+		}
 		if errClear := r.clearUsage(ruTntID); errClear != nil &&
 			r.ttl != nil && *r.ttl != 0 { // we only consider not found error in case of ttl different than 0
 			utils.Logger.Warning(fmt.Sprintf("<%s>, clear ruID: %s, err: %s", utils.ResourceS, ruTntID, errClear.Error()))
@@ -401,24 +461,43 @@ func (rs Resources) clearUsage(ruTntID string) (err error) {
 
 // allocateResource attempts allocating resources for a *ResourceUsage
 // simulates on dryRun
+// the rate limiters are skipped, being checked before by ResourceService.rateLimit
+// the units are allocated also on the parents, each resource being available only if its parents are too
 // returns utils.ErrResourceUnavailable if allocation is not possible
 func (rs Resources) allocateResource(ru *ResourceUsage, dryRun bool) (alcMessage string, err error) {
 	if len(rs) == 0 {
 		return "", utils.ErrResourceUnavailable
 	}
+	cncRs := make(Resources, 0, len(rs)) // the resources counting the usages
 	// Simulate resource usage
 	for _, r := range rs {
+		if r.rPrf == nil {
+			err = fmt.Errorf("empty configuration for resourceID: %s", r.TenantID())
+			return
+		}
+		if r.isRateLimiter() {
+			continue
+		}
+		cncRs = append(cncRs, r)
 		r.removeExpiredUnits()
 		if _, hasID := r.Usages[ru.ID]; hasID && !dryRun { // update
 			r.clearUsage(ru.ID) // clearUsage returns error only when ru.ID does not exist in the Usages map
 		}
-		if r.rPrf == nil {
-			err = fmt.Errorf("empty configuration for resourceID: %s", r.TenantID())
-			return
+	}
+	var hasCnc bool // the event matched resources counting the usages, the rate limiters allowing the allocation already
+	for _, r := range cncRs {
+		if !r.isPrnt {
+			hasCnc = true
+			break
 		}
-		if alcMessage == utils.EmptyString &&
-			(r.rPrf.Limit >= r.TotalUsage()+ru.Units || r.rPrf.Limit == -1) {
+	}
+	for _, r := range rs {
+		if r.isPrnt || r.isRateLimiter() == hasCnc { // only the counting resources if any, the rate limiters otherwise
+			continue
+		}
+		if r.available(ru.Units) {
 			alcMessage = utils.FirstNonEmpty(r.rPrf.AllocationMessage, r.rPrf.ID)
+			break
 		}
 	}
 	if alcMessage == "" {
@@ -428,7 +507,7 @@ func (rs Resources) allocateResource(ru *ResourceUsage, dryRun bool) (alcMessage
 	if dryRun {
 		return
 	}
-	rs.recordUsage(ru) // recordUsage returns error only when ru.ID already exists in the Usages map
+	cncRs.recordUsage(ru) // recordUsage returns error only when ru.ID already exists in the Usages map
 	return
 }
 
@@ -581,6 +660,33 @@ func (rS *ResourceService) storeMatchedResources(mtcRLs Resources) (err error) {
 	return
 }
 
+// rateLimit applies the units on the rate limiters out of rs, consuming them only if allowed by all of them
+// and not on dryRun, otherwise returns the longest duration to retry after
+func (rS *ResourceService) rateLimit(rs Resources, units float64, dryRun bool) (retryAfter time.Duration, err error) {
+	for _, r := range rs {
+		if !r.isRateLimiter() {
+			continue
+		}
+		var rtAftr time.Duration
+		if rtAftr, err = r.rateLimit(rS.dm, units, true); err != nil {
+			return
+		}
+		retryAfter = max(retryAfter, rtAftr)
+	}
+	if retryAfter != 0 || dryRun {
+		return
+	}
+	for _, r := range rs {
+		if !r.isRateLimiter() {
+			continue
+		}
+		if _, err = r.rateLimit(rS.dm, units, false); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // processThresholds will pass the event for resource to ThresholdS
 func (rS *ResourceService) processThresholds(rs Resources, opts map[string]any) (err error) {
 	if len(rS.cgrcfg.ResourceSCfg().ThresholdSConns) == 0 {
@@ -628,7 +734,106 @@ func (rS *ResourceService) processThresholds(rs Resources, opts map[string]any)
 	return
 }
 
+// resourceLockKeys returns the sorted lock keys of the resources together with their parents, up the chain
+// the chain is resolved without locking, being checked again once locked
+func (rS *ResourceService) resourceLockKeys(tnt string, ids []string) (lkIDs []string, err error) {
+	chain := utils.NewStringSet(nil)
+	for _, id := range ids {
+		for id != utils.EmptyString && !chain.Has(id) {
+			chain.Add(id)
+			var rPrf *ResourceProfile
+			if rPrf, err = rS.dm.GetResourceProfile(tnt, id,
+				true, true, utils.NonTransactional); err != nil {
+				if err != utils.ErrNotFound {
+					return nil, err
+				}
+				err = nil
+				break
+			}
+			id = rPrf.ParentID
+		}
+	}
+	lkIDs = make([]string, 0, 2*chain.Size())
+	for id := range chain {
+		lkIDs = append(lkIDs, resourceProfileLockKey(tnt, id), resourceLockKey(tnt, id))
+	}
+	sort.Strings(lkIDs) // lock in sorted order to prevent AB-BA deadlock
+	return
+}
+
+// lockedResource returns the resource of the profile, both being locked within lkID
+func (rS *ResourceService) lockedResource(rPrf *ResourceProfile, lkID string,
+	usageTTL *time.Duration) (r *Resource, err error) {
+	if r, err = rS.dm.GetResource(rPrf.Tenant, rPrf.ID, true, true, ""); err != nil {
+		return nil, err
+	}
+	rPrf.lock(lkID)
+	r.lock(lkID) // pass the lock into resource so we have it as reference
+	if rPrf.Stored && r.dirty == nil {
+		r.dirty = utils.BoolPointer(false)
+	}
+	if usageTTL != nil {
+		if *usageTTL != 0 {
+			r.ttl = usageTTL
+		}
+	} else if rPrf.UsageTTL >= 0 {
+		r.ttl = utils.DurationPointer(rPrf.UsageTTL)
+	}
+	r.rPrf = rPrf
+	r.parent = nil
+	r.isPrnt = false
+	return
+}
+
+// withParents appends to the matched resources their parents, up the chain, linking them
+// the parents are applied independent of their filters and activation interval
+// the parents need to be part of the locked keys, otherwise the chain changed after being resolved
+func (rS *ResourceService) withParents(rs Resources, lkID string, lkIDs utils.StringSet,
+	usageTTL *time.Duration) (_ Resources, err error) {
+	defer func() {
+		if err != nil {
+			rs.release()
+		}
+	}()
+	idx := make(map[string]*Resource, len(rs))
+	for _, r := range rs {
+		idx[r.TenantID()] = r
+	}
+	for i := 0; i < len(rs); i++ { // rs grows with the parents added
+		r := rs[i]
+		if r.rPrf.ParentID == utils.EmptyString {
+			continue
+		}
+		prntTntID := utils.ConcatenatedKey(r.Tenant, r.rPrf.ParentID)
+		prnt, has := idx[prntTntID]
+		if !has {
+			if !lkIDs.Has(resourceLockKey(r.Tenant, r.rPrf.ParentID)) {
+				return nil, fmt.Errorf("parent of resource <%s>: changed while locking", r.TenantID())
+			}
+			var rPrf *ResourceProfile
+			if rPrf, err = rS.dm.GetResourceProfile(r.Tenant, r.rPrf.ParentID,
+				true, true, utils.NonTransactional); err != nil {
+				return nil, fmt.Errorf("parent of resource <%s>: %w", r.TenantID(), err)
+			}
+			if prnt, err = rS.lockedResource(rPrf, lkID, usageTTL); err != nil {
+				return nil, fmt.Errorf("parent of resource <%s>: %w", r.TenantID(), err)
+			}
+			prnt.isPrnt = true
+			idx[prntTntID] = prnt
+			rs = append(rs, prnt)
+		}
+		r.parent = prnt
+		for p := prnt; p != nil; p = p.parent {
+			if p == r {
+				return nil, fmt.Errorf("cyclic parents for resource <%s>", r.TenantID())
+			}
+		}
+	}
+	return rs, nil
+}
+
 // matchingResourcesForEvent returns ordered list of matching resources which are active by the time of the call
+// the candidates are locked together with their parents within one lock, released by unlocking the resources
 func (rS *ResourceService) matchingResourcesForEvent(tnt string, ev *utils.CGREvent,
 	evUUID string, usageTTL *time.Duration) (rs Resources, err error) {
 	evNm := utils.MapStorage{
@@ -668,62 +873,51 @@ func (rS *ResourceService) matchingResourcesForEvent(tnt string, ev *utils.CGREv
 			}
 			return nil, err
 		}
-		// Lock items in sorted order to prevent AB-BA deadlock.
 		itemIDs = slices.Sorted(maps.Keys(rIDs))
 	}
+	var lkIDs []string
+	if lkIDs, err = rS.resourceLockKeys(tnt, itemIDs); err != nil {
+		return
+	}
+	lkID := guardian.Guardian.GuardIDs(utils.EmptyString,
+		config.CgrConfig().GeneralCfg().LockingTimeout, lkIDs...)
+	defer func() {
+		if err != nil || len(rs) == 0 { // the resources are unlocked by the caller
+			guardian.Guardian.UnguardIDs(lkID)
+		}
+	}()
+	expl := ExplanationFromOpts(ev.APIOpts)
+	expl.SetCandidates(itemIDs)
 	rs = make(Resources, 0, len(itemIDs))
 	for _, id := range itemIDs {
-		lkPrflID := guardian.Guardian.GuardIDs("",
-			config.CgrConfig().GeneralCfg().LockingTimeout,
-			resourceProfileLockKey(tnt, id))
 		var rPrf *ResourceProfile
 		if rPrf, err = rS.dm.GetResourceProfile(tnt, id,
 			true, true, utils.NonTransactional); err != nil {
-			guardian.Guardian.UnguardIDs(lkPrflID)
 			if err == utils.ErrNotFound {
+				expl.Skip(id, ExplainReasonNotFound)
 				continue
 			}
-			rs.unlock()
-			return
+			rs.release()
+			return nil, err
 		}
-		rPrf.lock(lkPrflID)
 		if rPrf.ActivationInterval != nil && ev.Time != nil &&
 			!rPrf.ActivationInterval.IsActiveAtTime(*ev.Time) { // not active
-			rPrf.unlock()
+			expl.Skip(id, ExplainReasonInactive)
 			continue
 		}
 		var pass bool
-		if pass, err = rS.filterS.Pass(tnt, rPrf.FilterIDs,
+		if pass, err = rS.filterS.ExplainPass(expl, id, tnt, rPrf.FilterIDs,
 			evNm); err != nil {
-			rPrf.unlock()
-			rs.unlock()
+			rs.release()
 			return nil, err
 		} else if !pass {
-			rPrf.unlock()
 			continue
 		}
-		lkID := guardian.Guardian.GuardIDs(utils.EmptyString,
-			config.CgrConfig().GeneralCfg().LockingTimeout,
-			resourceLockKey(rPrf.Tenant, rPrf.ID))
 		var r *Resource
-		if r, err = rS.dm.GetResource(rPrf.Tenant, rPrf.ID, true, true, ""); err != nil {
-			guardian.Guardian.UnguardIDs(lkID)
-			rPrf.unlock()
-			rs.unlock()
+		if r, err = rS.lockedResource(rPrf, lkID, usageTTL); err != nil {
+			rs.release()
 			return nil, err
 		}
-		r.lock(lkID) // pass the lock into resource so we have it as reference
-		if rPrf.Stored && r.dirty == nil {
-			r.dirty = utils.BoolPointer(false)
-		}
-		if usageTTL != nil {
-			if *usageTTL != 0 {
-				r.ttl = usageTTL
-			}
-		} else if rPrf.UsageTTL >= 0 {
-			r.ttl = utils.DurationPointer(rPrf.UsageTTL)
-		}
-		r.rPrf = rPrf
 		rs = append(rs, r)
 	}
 
@@ -733,11 +927,14 @@ func (rS *ResourceService) matchingResourcesForEvent(tnt string, ev *utils.CGREv
 	rs.Sort()
 	for i, r := range rs {
 		if r.rPrf.Blocker && i != len(rs)-1 { // blocker will stop processing and we are not at last index
-			Resources(rs[i+1:]).unlock()
+			Resources(rs[i+1:]).release()
 			rs = rs[:i+1]
 			break
 		}
 	}
+	if rs, err = rS.withParents(rs, lkID, utils.NewStringSet(lkIDs), usageTTL); err != nil {
+		return nil, err
+	}
 	if err = Cache.Set(utils.CacheEventResources, evUUID, itemIDs, nil, true, ""); err != nil {
 		rs.unlock()
 	}
@@ -794,6 +991,44 @@ func (rS *ResourceService) V1GetResourcesForEvent(ctx *context.Context, args *ut
 	return
 }
 
+// V1ExplainEvent details why the ResourceProfiles were matched or not for the event
+// the event is matched under a new UUID so the cached matches of the usage are neither used nor altered
+func (rS *ResourceService) V1ExplainEvent(ctx *context.Context, args *utils.CGREvent,
+	reply *MatchExplanation) (err error) {
+	if args == nil {
+		return utils.NewErrMandatoryIeMissing(utils.Event)
+	}
+	tnt := args.Tenant
+	if tnt == utils.EmptyString {
+		tnt = rS.cgrcfg.GeneralCfg().DefaultTenant
+	}
+	var usageTTL *time.Duration
+	if usageTTL, err = utils.GetDurationPointerOpts(args, rS.cgrcfg.ResourceSCfg().Opts.UsageTTL,
+		utils.OptsResourcesUsageTTL); err != nil {
+		return
+	}
+	args, expl := explainArgs(args)
+	evUUID := utils.GenUUID()
+	var mtcRLs Resources
+	if mtcRLs, err = rS.matchingResourcesForEvent(tnt, args, evUUID, usageTTL); err != nil {
+		if err != utils.ErrNotFound {
+			return
+		}
+		err = nil
+	}
+	for _, r := range mtcRLs {
+		expl.Matched = append(expl.Matched, r.ID)
+	}
+	mtcRLs.unlock()
+	if err = Cache.Remove(utils.CacheEventResources, evUUID,
+		cacheCommit(utils.NonTransactional), utils.NonTransactional); err != nil {
+		return
+	}
+	expl.Sort()
+	*reply = *expl
+	return
+}
+
 // V1AuthorizeResources queries service to find if an Usage is allowed
 func (rS *ResourceService) V1AuthorizeResources(ctx *context.Context, args *utils.CGREvent, reply *string) (err error) {
 	if args == nil {
@@ -846,6 +1081,12 @@ func (rS *ResourceService) V1AuthorizeResources(ctx *context.Context, args *util
 		utils.OptsResourcesUnits); err != nil {
 		return
 	}
+	var retryAfter time.Duration
+	if retryAfter, err = rS.rateLimit(mtcRLs, units, true); err != nil {
+		return
+	} else if retryAfter != 0 {
+		return utils.NewErrRetryAfter(utils.ErrResourceUnauthorized, retryAfter)
+	}
 	var alcMessage string
 	if alcMessage, err = mtcRLs.allocateResource(
 		&ResourceUsage{
@@ -914,6 +1155,12 @@ func (rS *ResourceService) V1AllocateResources(ctx *context.Context, args *utils
 		utils.OptsResourcesUnits); err != nil {
 		return
 	}
+	var retryAfter time.Duration
+	if retryAfter, err = rS.rateLimit(mtcRLs, units, false); err != nil {
+		return
+	} else if retryAfter != 0 {
+		return utils.NewErrRetryAfter(utils.ErrResourceUnavailable, retryAfter)
+	}
 	var alcMsg string
 	if alcMsg, err = mtcRLs.allocateResource(
 		&ResourceUsage{Tenant: tnt, ID: usageID,
@@ -246,20 +246,24 @@ func (rpS *RouteService) matchingRouteProfilesForEvent(tnt string, ev *utils.CGR
 	if err != nil {
 		return nil, err
 	}
+	expl := ExplanationFromOpts(ev.APIOpts)
+	expl.SetCandidates(rPrfIDs.AsSlice())
 	matchingRPrf = make([]*RouteProfile, 0, len(rPrfIDs))
 	for lpID := range rPrfIDs {
 		rPrf, err := rpS.dm.GetRouteProfile(tnt, lpID, true, true, utils.NonTransactional)
 		if err != nil {
 			if err == utils.ErrNotFound {
+				expl.Skip(lpID, ExplainReasonNotFound)
 				continue
 			}
 			return nil, err
 		}
 		if rPrf.ActivationInterval != nil && ev.Time != nil &&
 			!rPrf.ActivationInterval.IsActiveAtTime(*ev.Time) { // not active
+			expl.Skip(lpID, ExplainReasonInactive)
 			continue
 		}
-		if pass, err := rpS.filterS.Pass(tnt, rPrf.FilterIDs,
+		if pass, err := rpS.filterS.ExplainPass(expl, lpID, tnt, rPrf.FilterIDs,
 			evNm); err != nil {
 			return nil, err
 		} else if !pass {
@@ -709,6 +713,28 @@ func (rpS *RouteService) V1GetRouteProfilesForEvent(ctx *context.Context, args *
 	return
 }
 
+// V1ExplainEvent details why the RouteProfiles were matched or not for the event
+func (rpS *RouteService) V1ExplainEvent(ctx *context.Context, args *utils.CGREvent,
+	reply *MatchExplanation) (err error) {
+	if args == nil {
+		return utils.NewErrMandatoryIeMissing(utils.CGREventString)
+	}
+	args, expl := explainArgs(args)
+	var rPrfs []*RouteProfile
+	if err = rpS.V1GetRouteProfilesForEvent(ctx, args, &rPrfs); err != nil {
+		if err != utils.ErrNotFound {
+			return
+		}
+		err = nil
+	}
+	for _, rPrf := range rPrfs {
+		expl.Matched = append(expl.Matched, rPrf.ID)
+	}
+	expl.Sort()
+	*reply = *expl
+	return
+}
+
 // sortedRoutesForEvent will return the list of valid route IDs
 // for event based on filters and sorting algorithms
 func (rpS *RouteService) sortedRoutesForProfile(tnt string, rPrfl *RouteProfile, ev *utils.CGREvent,
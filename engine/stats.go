@@ -179,6 +179,9 @@ func (sS *StatService) matchingStatQueuesForEvent(tnt string, statsIDs []string,
 
 	// Lock items in sorted order to prevent AB-BA deadlock.
 	itemIDs := slices.Sorted(maps.Keys(sqIDs))
+	opts, _ := evNm[utils.MetaOpts].(map[string]any)
+	expl := ExplanationFromOpts(opts)
+	expl.SetCandidates(itemIDs)
 
 	sqs = make(StatQueues, 0, len(itemIDs))
 	for _, id := range itemIDs {
@@ -190,6 +193,7 @@ func (sS *StatService) matchingStatQueuesForEvent(tnt string, statsIDs []string,
 			guardian.Guardian.UnguardIDs(lkPrflID)
 			if err == utils.ErrNotFound {
 				err = nil
+				expl.Skip(id, ExplainReasonNotFound)
 				continue
 			}
 			sqs.unlock()
@@ -199,11 +203,14 @@ func (sS *StatService) matchingStatQueuesForEvent(tnt string, statsIDs []string,
 		if sqPrfl.ActivationInterval != nil && actTime != nil &&
 			!sqPrfl.ActivationInterval.IsActiveAtTime(*actTime) { // not active
 			sqPrfl.unlock()
+			expl.Skip(id, ExplainReasonInactive)
 			continue
 		}
-		if !ignoreFilters {
+		if ignoreFilters {
+			expl.Skip(id, ExplainReasonIgnoreFilters)
+		} else {
 			var pass bool
-			if pass, err = sS.filterS.Pass(tnt, sqPrfl.FilterIDs,
+			if pass, err = sS.filterS.ExplainPass(expl, id, tnt, sqPrfl.FilterIDs,
 				evNm); err != nil {
 				sqPrfl.unlock()
 				sqs.unlock()
@@ -460,6 +467,24 @@ func (sS *StatService) V1GetStatQueuesForEvent(ctx *context.Context, args *utils
 	return
 }
 
+// V1ExplainEvent details why the StatQueueProfiles were matched or not for the event
+func (sS *StatService) V1ExplainEvent(ctx *context.Context, args *utils.CGREvent,
+	reply *MatchExplanation) (err error) {
+	if args == nil {
+		return utils.NewErrMandatoryIeMissing(utils.CGREventString)
+	}
+	args, expl := explainArgs(args)
+	if err = sS.V1GetStatQueuesForEvent(ctx, args, &expl.Matched); err != nil {
+		if err != utils.ErrNotFound {
+			return
+		}
+		err = nil
+	}
+	expl.Sort()
+	*reply = *expl
+	return
+}
+
 // V1GetStatQueue returns a StatQueue object
 func (sS *StatService) V1GetStatQueue(ctx *context.Context, args *utils.TenantIDWithAPIOpts, reply *StatQueue) (err error) {
 	if missing := utils.MissingStructFields(args, []string{utils.ID}); len(missing) != 0 { //Params missing
@@ -396,9 +396,14 @@ func appendName(paths []string, fileName string) (out []string) {
 
 func (csvs *CSVStorage) proccesData(listType any, fns []string, process func(any)) error {
 	collumnCount := getColumnCount(listType)
+	mandatoryCount := getMandatoryColumnCount(listType)
+	nrFields := collumnCount
+	if mandatoryCount != collumnCount {
+		nrFields = -1 // the number of fields is checked per record
+	}
 	for _, fileName := range fns {
 		csvReader := csvs.generator()
-		err := csvReader.Open(fileName, csvs.sep, collumnCount)
+		err := csvReader.Open(fileName, csvs.sep, nrFields)
 		if err != nil {
 			// maybe a log to view if failed to open file
 			continue // try read the rest
@@ -406,6 +411,10 @@ func (csvs *CSVStorage) proccesData(listType any, fns []string, process func(any
 		if err = func() error { // to execute defer corectly
 			defer csvReader.Close()
 			for record, err := csvReader.Read(); err != io.EOF; record, err = csvReader.Read() {
+				if err == nil &&
+					(len(record) < mandatoryCount || len(record) > collumnCount) {
+					err = csv.ErrFieldCount
+				}
 				if err != nil {
 					log.Printf("bad line in %s, %s\n", fileName, err.Error())
 					return err
@@ -927,8 +936,12 @@ func (c *csvGoogle) Read() (record []string, err error) {
 	if err != nil {
 		return
 	}
-	record = make([]string, c.nrFields)
-	for i := 0; i < c.nrFields; i++ {
+	nrFields := c.nrFields
+	if nrFields < 0 { // variable number of fields
+		nrFields = len(row)
+	}
+	record = make([]string, nrFields)
+	for i := 0; i < nrFields; i++ {
 		if i < len(row) {
 			record[i] = utils.IfaceAsString(row[i])
 			if i == 0 && strings.HasPrefix(record[i], "#") {
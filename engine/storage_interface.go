@@ -24,6 +24,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"time"
 
 	"github.com/cgrates/cgrates/utils"
 	"github.com/ugorji/go/codec"
@@ -148,6 +149,23 @@ type DataDB interface {
 	SetBackupSessionsDrv(nodeID string, tenant string, sessions []*StoredSession) error
 	GetSessionsBackupDrv(nodeID string, tenant string) ([]*StoredSession, error)
 	RemoveSessionsBackupDrv(nodeID, tenant, cgrid string) error
+	GetExchangeRateDrv(from, to string) (*ExchangeRate, error)
+	SetExchangeRateDrv(*ExchangeRate) error
+	RemoveExchangeRateDrv(from, to string) error
+	GetTaxProfileDrv(tnt, id string) (*TaxProfile, error)
+	SetTaxProfileDrv(*TaxProfile) error
+	RemoveTaxProfileDrv(tnt, id string) error
+	GetFraudProfileDrv(tnt, id string) (*FraudProfile, error)
+	SetFraudProfileDrv(*FraudProfile) error
+	RemoveFraudProfileDrv(tnt, id string) error
+	GetPortedNumberDrv(number string) (*PortedNumber, error)
+	SetPortedNumberDrv(*PortedNumber) error
+	RemovePortedNumberDrv(number string) error
+	GetLookupTableDrv(tnt, id string) (*LookupTable, error)
+	SetLookupTableDrv(*LookupTable) error
+	RemoveLookupTableDrv(tnt, id string) error
+	AcquireLeaseDrv(lease *Lease, now time.Time) (stored *Lease, acquired bool, err error)
+	RateLimitDrv(key string, increment, tolerance time.Duration, now time.Time, dryRun bool) (retryAfter time.Duration, err error)
 	DumpDataDB() error
 	RewriteDataDB() error
 	BackupDataDB(string, bool) error
@@ -169,6 +187,10 @@ type CdrStorage interface {
 	RemoveSMCost(*SMCost) error
 	RemoveSMCosts(qryFltr *utils.SMCostFilter) error
 	GetCDRs(*utils.CDRsFilter, bool) ([]*CDR, int64, error)
+	SetBalanceJournalEntries([]*BalanceJournalEntry) error
+	GetBalanceJournal(*utils.BalanceJournalFilter) ([]*BalanceJournalEntry, error)
+	SetInvoice(*Invoice) error
+	GetInvoices(*utils.InvoiceFilter) ([]*Invoice, error)
 }
 
 type LoadStorage interface {
@@ -34,6 +34,8 @@ import (
 // InternalDB is used as a DataDB and a StorDB
 type InternalDB struct {
 	tasks               []*Task
+	leases              map[string]*Lease
+	rateTATs            map[string]time.Time // theoretical arrival times of the shared rate limiters
 	mu                  sync.RWMutex
 	stringIndexedFields []string
 	prefixIndexedFields []string
@@ -225,7 +227,8 @@ func (iDB *InternalDB) HasDataDrv(category, subject, tenant string) (bool, error
 		utils.IPProfilesPrefix, utils.StatQueuePrefix, utils.StatQueueProfilePrefix,
 		utils.ThresholdPrefix, utils.ThresholdProfilePrefix, utils.FilterPrefix,
 		utils.RouteProfilePrefix, utils.AttributeProfilePrefix, utils.ChargerProfilePrefix,
-		utils.DispatcherProfilePrefix, utils.DispatcherHostPrefix:
+		utils.DispatcherProfilePrefix, utils.DispatcherHostPrefix, utils.FraudProfilesPrefix,
+		utils.TaxProfilesPrefix:
 		return iDB.db.HasItem(utils.CachePrefixToInstance[category], utils.ConcatenatedKey(tenant, subject)), nil
 	}
 	return false, errors.New("Unsupported HasData category")
@@ -486,6 +489,8 @@ func (iDB *InternalDB) SetAccountDrv(acc *Account) (err error) {
 			ac.UnitCounters = acc.UnitCounters
 			ac.AllowNegative = acc.AllowNegative
 			ac.Disabled = acc.Disabled
+			ac.Holds = acc.Holds
+			ac.Subscriptions = acc.Subscriptions
 			acc = ac
 		}
 	}
@@ -1027,6 +1032,152 @@ func (iDB *InternalDB) RemoveSessionsBackupDrv(nodeID, tnt, cgrid string) error
 	return nil
 }
 
+// GetExchangeRateDrv retrieves the exchange rate of the currency pair from dataDB
+func (iDB *InternalDB) GetExchangeRateDrv(from, to string) (er *ExchangeRate, err error) {
+	x, ok := iDB.db.Get(utils.CacheExchangeRates, utils.ConcatenatedKey(from, to))
+	if !ok || x == nil {
+		return nil, utils.ErrNotFound
+	}
+	return x.(*ExchangeRate), nil
+}
+
+// SetExchangeRateDrv stores the exchange rate in dataDB
+func (iDB *InternalDB) SetExchangeRateDrv(er *ExchangeRate) (err error) {
+	iDB.db.Set(utils.CacheExchangeRates, er.PairID(), er, nil,
+		true, utils.NonTransactional)
+	return
+}
+
+// RemoveExchangeRateDrv removes the exchange rate of the currency pair from dataDB
+func (iDB *InternalDB) RemoveExchangeRateDrv(from, to string) (err error) {
+	iDB.db.Remove(utils.CacheExchangeRates, utils.ConcatenatedKey(from, to),
+		true, utils.NonTransactional)
+	return
+}
+
+// GetTaxProfileDrv retrieves the TaxProfile from dataDB
+func (iDB *InternalDB) GetTaxProfileDrv(tnt, id string) (tp *TaxProfile, err error) {
+	x, ok := iDB.db.Get(utils.CacheTaxProfiles, utils.ConcatenatedKey(tnt, id))
+	if !ok || x == nil {
+		return nil, utils.ErrNotFound
+	}
+	return x.(*TaxProfile), nil
+}
+
+// SetTaxProfileDrv stores the TaxProfile in dataDB
+func (iDB *InternalDB) SetTaxProfileDrv(tp *TaxProfile) (err error) {
+	iDB.db.Set(utils.CacheTaxProfiles, tp.TenantID(), tp, nil,
+		true, utils.NonTransactional)
+	return
+}
+
+// RemoveTaxProfileDrv removes the TaxProfile from dataDB
+func (iDB *InternalDB) RemoveTaxProfileDrv(tnt, id string) (err error) {
+	iDB.db.Remove(utils.CacheTaxProfiles, utils.ConcatenatedKey(tnt, id),
+		true, utils.NonTransactional)
+	return
+}
+
+// GetFraudProfileDrv retrieves the FraudProfile from dataDB
+func (iDB *InternalDB) GetFraudProfileDrv(tnt, id string) (fp *FraudProfile, err error) {
+	x, ok := iDB.db.Get(utils.CacheFraudProfiles, utils.ConcatenatedKey(tnt, id))
+	if !ok || x == nil {
+		return nil, utils.ErrNotFound
+	}
+	return x.(*FraudProfile), nil
+}
+
+// SetFraudProfileDrv stores the FraudProfile in dataDB
+func (iDB *InternalDB) SetFraudProfileDrv(fp *FraudProfile) (err error) {
+	iDB.db.Set(utils.CacheFraudProfiles, fp.TenantID(), fp, nil,
+		true, utils.NonTransactional)
+	return
+}
+
+// RemoveFraudProfileDrv removes the FraudProfile from dataDB
+func (iDB *InternalDB) RemoveFraudProfileDrv(tnt, id string) (err error) {
+	iDB.db.Remove(utils.CacheFraudProfiles, utils.ConcatenatedKey(tnt, id),
+		true, utils.NonTransactional)
+	return
+}
+
+// GetPortedNumberDrv retrieves the PortedNumber from dataDB
+func (iDB *InternalDB) GetPortedNumberDrv(number string) (pn *PortedNumber, err error) {
+	x, ok := iDB.db.Get(utils.CachePortedNumbers, number)
+	if !ok || x == nil {
+		return nil, utils.ErrNotFound
+	}
+	return x.(*PortedNumber), nil
+}
+
+// SetPortedNumberDrv stores the PortedNumber in dataDB
+func (iDB *InternalDB) SetPortedNumberDrv(pn *PortedNumber) (err error) {
+	iDB.db.Set(utils.CachePortedNumbers, pn.Number, pn, nil,
+		true, utils.NonTransactional)
+	return
+}
+
+// RemovePortedNumberDrv removes the PortedNumber from dataDB
+func (iDB *InternalDB) RemovePortedNumberDrv(number string) (err error) {
+	if !iDB.db.HasItem(utils.CachePortedNumbers, number) {
+		return utils.ErrNotFound
+	}
+	iDB.db.Remove(utils.CachePortedNumbers, number,
+		true, utils.NonTransactional)
+	return
+}
+
+// GetLookupTableDrv retrieves the LookupTable from dataDB
+func (iDB *InternalDB) GetLookupTableDrv(tnt, id string) (lt *LookupTable, err error) {
+	x, ok := iDB.db.Get(utils.CacheLookupTables, utils.ConcatenatedKey(tnt, id))
+	if !ok || x == nil {
+		return nil, utils.ErrNotFound
+	}
+	return x.(*LookupTable), nil
+}
+
+// SetLookupTableDrv stores the LookupTable in dataDB
+func (iDB *InternalDB) SetLookupTableDrv(lt *LookupTable) (err error) {
+	iDB.db.Set(utils.CacheLookupTables, lt.TenantID(), lt, nil,
+		true, utils.NonTransactional)
+	return
+}
+
+// RemoveLookupTableDrv removes the LookupTable from dataDB
+func (iDB *InternalDB) RemoveLookupTableDrv(tnt, id string) (err error) {
+	iDB.db.Remove(utils.CacheLookupTables, utils.ConcatenatedKey(tnt, id),
+		true, utils.NonTransactional)
+	return
+}
+
+// AcquireLeaseDrv takes or renews the lease if it is free at now or already held by the same holder
+func (iDB *InternalDB) AcquireLeaseDrv(lease *Lease, now time.Time) (stored *Lease, acquired bool, err error) {
+	iDB.mu.Lock()
+	defer iDB.mu.Unlock()
+	if iDB.leases == nil {
+		iDB.leases = make(map[string]*Lease)
+	}
+	if stored, acquired = acquireLease(iDB.leases[lease.ID], lease, now); acquired {
+		iDB.leases[lease.ID] = stored.Clone()
+	}
+	return
+}
+
+// RateLimitDrv applies the increment on the rate limiter stored under key, unless dryRun
+func (iDB *InternalDB) RateLimitDrv(key string, increment, tolerance time.Duration,
+	now time.Time, dryRun bool) (retryAfter time.Duration, err error) {
+	iDB.mu.Lock()
+	defer iDB.mu.Unlock()
+	if iDB.rateTATs == nil {
+		iDB.rateTATs = make(map[string]time.Time)
+	}
+	var nTAT time.Time
+	if nTAT, retryAfter = gcra(iDB.rateTATs[key], now, increment, tolerance); retryAfter == 0 && !dryRun {
+		iDB.rateTATs[key] = nTAT
+	}
+	return
+}
+
 // Will dump everything inside datadb to files
 func (iDB *InternalDB) DumpDataDB() (err error) {
 	return iDB.db.DumpAll()
@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"slices"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -1587,6 +1588,83 @@ func (iDB *InternalDB) SetSMCost(smCost *SMCost) (err error) {
 	return err
 }
 
+// SetBalanceJournalEntries appends the entries to the balance journal
+func (iDB *InternalDB) SetBalanceJournalEntries(jes []*BalanceJournalEntry) (err error) {
+	for _, je := range jes {
+		acntID := utils.ConcatenatedKey(je.Tenant, je.Account)
+		iDB.db.Set(utils.CacheBalanceJournalTBL, utils.ConcatenatedKey(acntID, strconv.FormatInt(iDB.cnter.Next(), 10)),
+			je, []string{acntID}, cacheCommit(utils.NonTransactional), utils.NonTransactional)
+	}
+	return
+}
+
+// GetBalanceJournal returns the balance journal entries of an account in chronological order
+func (iDB *InternalDB) GetBalanceJournal(fltr *utils.BalanceJournalFilter) (jes []*BalanceJournalEntry, err error) {
+	for _, key := range iDB.db.GetGroupItemIDs(utils.CacheBalanceJournalTBL,
+		utils.ConcatenatedKey(fltr.Tenant, fltr.Account)) {
+		x, ok := iDB.db.Get(utils.CacheBalanceJournalTBL, key)
+		if !ok || x == nil {
+			continue
+		}
+		je := x.(*BalanceJournalEntry)
+		if (fltr.Time.Begin != nil && je.Time.Before(*fltr.Time.Begin)) ||
+			(fltr.Time.End != nil && !je.Time.Before(*fltr.Time.End)) {
+			continue
+		}
+		jes = append(jes, je)
+	}
+	if len(jes) == 0 {
+		return nil, utils.ErrNotFound
+	}
+	sort.SliceStable(jes, func(i, j int) bool {
+		if jes[i].Time.Equal(jes[j].Time) {
+			return jes[i].BalanceUUID < jes[j].BalanceUUID
+		}
+		return jes[i].Time.Before(jes[j].Time)
+	})
+	if fltr.Offset != nil {
+		if *fltr.Offset >= len(jes) {
+			return nil, utils.ErrNotFound
+		}
+		jes = jes[*fltr.Offset:]
+	}
+	if fltr.Limit != nil && len(jes) > *fltr.Limit {
+		jes = jes[:*fltr.Limit]
+	}
+	return
+}
+
+// SetInvoice stores the invoice, replacing the one with the same ID
+func (iDB *InternalDB) SetInvoice(inv *Invoice) (err error) {
+	acntID := utils.ConcatenatedKey(inv.Tenant, inv.Account)
+	iDB.db.Set(utils.CacheInvoicesTBL, utils.ConcatenatedKey(acntID, inv.ID),
+		inv, []string{acntID}, cacheCommit(utils.NonTransactional), utils.NonTransactional)
+	return
+}
+
+// GetInvoices returns the invoices of an account ordered by their period
+func (iDB *InternalDB) GetInvoices(fltr *utils.InvoiceFilter) (invs []*Invoice, err error) {
+	for _, key := range iDB.db.GetGroupItemIDs(utils.CacheInvoicesTBL,
+		utils.ConcatenatedKey(fltr.Tenant, fltr.Account)) {
+		x, ok := iDB.db.Get(utils.CacheInvoicesTBL, key)
+		if !ok || x == nil {
+			continue
+		}
+		inv := x.(*Invoice)
+		if len(fltr.IDs) != 0 && !slices.Contains(fltr.IDs, inv.ID) {
+			continue
+		}
+		invs = append(invs, inv)
+	}
+	if len(invs) == 0 {
+		return nil, utils.ErrNotFound
+	}
+	sort.Slice(invs, func(i, j int) bool {
+		return invs[i].PeriodStart.Before(invs[j].PeriodStart)
+	})
+	return
+}
+
 // Will dump everything inside stordb to files
 func (iDB *InternalDB) DumpStorDB() (err error) {
 	return iDB.db.DumpAll()
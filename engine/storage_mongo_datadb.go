@@ -84,6 +84,13 @@ const (
 	ColDph  = "dispatcher_hosts"
 	ColLID  = "load_ids"
 	ColBkup = "sessions_backup"
+	ColExr  = "exchange_rates"
+	ColTxp  = "tax_profiles"
+	ColFrp  = "fraud_profiles"
+	ColPnb  = "ported_numbers"
+	ColLkt  = "lookup_tables"
+	ColLes  = "leases"
+	ColRtl  = "rate_limits"
 )
 
 var (
@@ -308,10 +315,12 @@ func (ms *MongoStorage) ensureIndexesForCol(col string) error { // exported for
 	switch col {
 	case ColAct, ColApl, ColAAp, ColAtr, ColRpl, ColDst, ColRds, ColLht, ColIndx:
 		err = ms.enusureIndex(col, true, "key")
-	case ColRsP, ColRes, ColIPp, ColIPs, ColSqs, ColRgp, ColTrp, ColRnk, ColSqp, ColTps, ColThs, ColTrd, ColRts, ColAttr, ColFlt, ColCpp, ColDpp, ColDph:
+	case ColRsP, ColRes, ColIPp, ColIPs, ColSqs, ColRgp, ColTrp, ColRnk, ColSqp, ColTps, ColThs, ColTrd, ColRts, ColAttr, ColFlt, ColCpp, ColDpp, ColDph, ColLkt, ColFrp, ColTxp:
 		err = ms.enusureIndex(col, true, "tenant", "id")
-	case ColRpf, ColShg, ColAcc:
+	case ColRpf, ColShg, ColAcc, ColLes, ColRtl:
 		err = ms.enusureIndex(col, true, "id")
+	case ColPnb:
+		err = ms.enusureIndex(col, true, "number")
 		// StorDB
 	case utils.TBLTPTimings, utils.TBLTPDestinations,
 		utils.TBLTPDestinationRates, utils.TBLTPRatingPlans,
@@ -332,6 +341,10 @@ func (ms *MongoStorage) ensureIndexesForCol(col string) error { // exported for
 		if err == nil {
 			err = ms.enusureIndex(col, false, RunIDLow, OriginIDLow)
 		}
+	case utils.BalanceJournalTBL:
+		err = ms.enusureIndex(col, false, TenantLow, AccountLow, "time")
+	case utils.InvoicesTBL:
+		err = ms.enusureIndex(col, true, TenantLow, AccountLow, "id")
 	case utils.CDRsTBL:
 		err = ms.enusureIndex(col, true, CGRIDLow, RunIDLow,
 			OriginIDLow)
@@ -355,6 +368,7 @@ func (ms *MongoStorage) EnsureIndexes(cols ...string) error {
 				ColAct, ColApl, ColAAp, ColAtr, ColRpl, ColDst, ColRds, ColLht, ColIndx,
 				ColRsP, ColRes, ColIPs, ColSqs, ColSqp, ColTps, ColThs, ColRts, ColAttr,
 				ColFlt, ColCpp, ColDpp, ColRpf, ColShg, ColAcc, ColRgp, ColTrp, ColTrd, ColRnk,
+				ColLes, ColRtl, ColPnb, ColLkt, ColFrp, ColTxp,
 			}
 		} else {
 			cols = []string{
@@ -362,6 +376,7 @@ func (ms *MongoStorage) EnsureIndexes(cols ...string) error {
 				utils.TBLTPRatingPlans, utils.TBLTPSharedGroups, utils.TBLTPActions, utils.TBLTPActionPlans,
 				utils.TBLTPActionTriggers, utils.TBLTPRankings, utils.TBLTPStats, utils.TBLTPResources,
 				utils.TBLTPIPs, utils.TBLTPRatingProfiles, utils.CDRsTBL, utils.SessionCostsTBL,
+				utils.BalanceJournalTBL, utils.InvoicesTBL,
 			}
 		}
 	}
@@ -427,6 +442,10 @@ func (ms *MongoStorage) RemoveKeysForPrefix(prefix string) error {
 		colName = ColVer
 	case utils.TimingsPrefix:
 		colName = ColTmg
+	case utils.PortedNumberPrefix:
+		colName = ColPnb
+	case utils.LookupTablePrefix:
+		colName = ColLkt
 	case utils.ResourcesPrefix:
 		colName = ColRes
 	case utils.ResourceProfilesPrefix:
@@ -660,6 +679,10 @@ func (ms *MongoStorage) GetKeysForPrefix(prefix, search string) (keys []string,
 			keys, qryErr = ms.getAllKeysMatchingField(sctx, ColAAp, utils.AccountActionPlansPrefix, subject, "key", search)
 		case utils.TimingsPrefix:
 			keys, qryErr = ms.getAllKeysMatchingField(sctx, ColTmg, utils.TimingsPrefix, subject, "id", search)
+		case utils.PortedNumberPrefix:
+			keys, qryErr = ms.getAllKeysMatchingField(sctx, ColPnb, utils.PortedNumberPrefix, subject, "number", search)
+		case utils.LookupTablePrefix:
+			keys, qryErr = ms.getAllKeysMatchingTenantID(sctx, ColLkt, utils.LookupTablePrefix, subject, search, tntID)
 		case utils.TrendPrefix:
 			keys, qryErr = ms.getAllKeysMatchingTenantID(sctx, ColTrd, utils.TrendPrefix, subject, search, tntID)
 		case utils.RankingPrefix:
@@ -676,6 +699,10 @@ func (ms *MongoStorage) GetKeysForPrefix(prefix, search string) (keys []string,
 			keys, qryErr = ms.getAllKeysMatchingTenantID(sctx, ColAttr, utils.AttributeProfilePrefix, subject, search, tntID)
 		case utils.ChargerProfilePrefix:
 			keys, qryErr = ms.getAllKeysMatchingTenantID(sctx, ColCpp, utils.ChargerProfilePrefix, subject, search, tntID)
+		case utils.FraudProfilesPrefix:
+			keys, qryErr = ms.getAllKeysMatchingTenantID(sctx, ColFrp, utils.FraudProfilesPrefix, subject, search, tntID)
+		case utils.TaxProfilesPrefix:
+			keys, qryErr = ms.getAllKeysMatchingTenantID(sctx, ColTxp, utils.TaxProfilesPrefix, subject, search, tntID)
 		case utils.DispatcherProfilePrefix:
 			keys, qryErr = ms.getAllKeysMatchingTenantID(sctx, ColDpp, utils.DispatcherProfilePrefix, subject, search, tntID)
 		case utils.DispatcherHostPrefix:
@@ -696,6 +723,10 @@ func (ms *MongoStorage) GetKeysForPrefix(prefix, search string) (keys []string,
 			keys, qryErr = ms.getAllIndexKeys(sctx, utils.ChargerFilterIndexes)
 		case utils.DispatcherFilterIndexes:
 			keys, qryErr = ms.getAllIndexKeys(sctx, utils.DispatcherFilterIndexes)
+		case utils.FraudFilterIndexes:
+			keys, qryErr = ms.getAllIndexKeys(sctx, utils.FraudFilterIndexes)
+		case utils.TaxFilterIndexes:
+			keys, qryErr = ms.getAllIndexKeys(sctx, utils.TaxFilterIndexes)
 		case utils.ActionPlanIndexes:
 			keys, qryErr = ms.getAllIndexKeys(sctx, utils.ActionPlanIndexes)
 		case utils.FilterIndexPrfx:
@@ -756,6 +787,10 @@ func (ms *MongoStorage) HasDataDrv(category, subject, tenant string) (has bool,
 			count, err = ms.getCol(ColAttr).CountDocuments(sctx, bson.M{"tenant": tenant, "id": subject})
 		case utils.ChargerProfilePrefix:
 			count, err = ms.getCol(ColCpp).CountDocuments(sctx, bson.M{"tenant": tenant, "id": subject})
+		case utils.FraudProfilesPrefix:
+			count, err = ms.getCol(ColFrp).CountDocuments(sctx, bson.M{"tenant": tenant, "id": subject})
+		case utils.TaxProfilesPrefix:
+			count, err = ms.getCol(ColTxp).CountDocuments(sctx, bson.M{"tenant": tenant, "id": subject})
 		case utils.DispatcherProfilePrefix:
 			count, err = ms.getCol(ColDpp).CountDocuments(sctx, bson.M{"tenant": tenant, "id": subject})
 		case utils.DispatcherHostPrefix:
@@ -1088,6 +1123,8 @@ func (ms *MongoStorage) SetAccountDrv(acc *Account) error {
 			ac.UnitCounters = acc.UnitCounters
 			ac.AllowNegative = acc.AllowNegative
 			ac.Disabled = acc.Disabled
+			ac.Holds = acc.Holds
+			ac.Subscriptions = acc.Subscriptions
 			acc = ac
 		}
 	}
@@ -2354,6 +2391,265 @@ func (ms *MongoStorage) RemoveSessionsBackupDrv(nodeID, tnt, cgrid string) error
 	})
 }
 
+// GetExchangeRateDrv retrieves the exchange rate of the currency pair from dataDB
+func (ms *MongoStorage) GetExchangeRateDrv(from, to string) (*ExchangeRate, error) {
+	er := new(ExchangeRate)
+	err := ms.query(func(sctx mongo.SessionContext) error {
+		sr := ms.getCol(ColExr).FindOne(sctx, bson.M{"from": from, "to": to})
+		decodeErr := sr.Decode(er)
+		if errors.Is(decodeErr, mongo.ErrNoDocuments) {
+			return utils.ErrNotFound
+		}
+		return decodeErr
+	})
+	return er, err
+}
+
+// SetExchangeRateDrv stores the exchange rate in dataDB
+func (ms *MongoStorage) SetExchangeRateDrv(er *ExchangeRate) error {
+	return ms.query(func(sctx mongo.SessionContext) error {
+		_, err := ms.getCol(ColExr).UpdateOne(sctx, bson.M{"from": er.From, "to": er.To},
+			bson.M{"$set": er},
+			options.Update().SetUpsert(true),
+		)
+		return err
+	})
+}
+
+// RemoveExchangeRateDrv removes the exchange rate of the currency pair from dataDB
+func (ms *MongoStorage) RemoveExchangeRateDrv(from, to string) error {
+	return ms.query(func(sctx mongo.SessionContext) error {
+		dr, err := ms.getCol(ColExr).DeleteOne(sctx, bson.M{"from": from, "to": to})
+		if dr.DeletedCount == 0 {
+			return utils.ErrNotFound
+		}
+		return err
+	})
+}
+
+// GetTaxProfileDrv retrieves the TaxProfile from dataDB
+func (ms *MongoStorage) GetTaxProfileDrv(tnt, id string) (*TaxProfile, error) {
+	tp := new(TaxProfile)
+	err := ms.query(func(sctx mongo.SessionContext) error {
+		sr := ms.getCol(ColTxp).FindOne(sctx, bson.M{"tenant": tnt, "id": id})
+		decodeErr := sr.Decode(tp)
+		if errors.Is(decodeErr, mongo.ErrNoDocuments) {
+			return utils.ErrNotFound
+		}
+		return decodeErr
+	})
+	return tp, err
+}
+
+// SetTaxProfileDrv stores the TaxProfile in dataDB
+func (ms *MongoStorage) SetTaxProfileDrv(tp *TaxProfile) error {
+	return ms.query(func(sctx mongo.SessionContext) error {
+		_, err := ms.getCol(ColTxp).UpdateOne(sctx, bson.M{"tenant": tp.Tenant, "id": tp.ID},
+			bson.M{"$set": tp},
+			options.Update().SetUpsert(true),
+		)
+		return err
+	})
+}
+
+// RemoveTaxProfileDrv removes the TaxProfile from dataDB
+func (ms *MongoStorage) RemoveTaxProfileDrv(tnt, id string) error {
+	return ms.query(func(sctx mongo.SessionContext) error {
+		dr, err := ms.getCol(ColTxp).DeleteOne(sctx, bson.M{"tenant": tnt, "id": id})
+		if dr.DeletedCount == 0 {
+			return utils.ErrNotFound
+		}
+		return err
+	})
+}
+
+// GetFraudProfileDrv retrieves the FraudProfile from dataDB
+func (ms *MongoStorage) GetFraudProfileDrv(tnt, id string) (*FraudProfile, error) {
+	fp := new(FraudProfile)
+	err := ms.query(func(sctx mongo.SessionContext) error {
+		sr := ms.getCol(ColFrp).FindOne(sctx, bson.M{"tenant": tnt, "id": id})
+		decodeErr := sr.Decode(fp)
+		if errors.Is(decodeErr, mongo.ErrNoDocuments) {
+			return utils.ErrNotFound
+		}
+		return decodeErr
+	})
+	return fp, err
+}
+
+// SetFraudProfileDrv stores the FraudProfile in dataDB
+func (ms *MongoStorage) SetFraudProfileDrv(fp *FraudProfile) error {
+	return ms.query(func(sctx mongo.SessionContext) error {
+		_, err := ms.getCol(ColFrp).UpdateOne(sctx, bson.M{"tenant": fp.Tenant, "id": fp.ID},
+			bson.M{"$set": fp},
+			options.Update().SetUpsert(true),
+		)
+		return err
+	})
+}
+
+// RemoveFraudProfileDrv removes the FraudProfile from dataDB
+func (ms *MongoStorage) RemoveFraudProfileDrv(tnt, id string) error {
+	return ms.query(func(sctx mongo.SessionContext) error {
+		dr, err := ms.getCol(ColFrp).DeleteOne(sctx, bson.M{"tenant": tnt, "id": id})
+		if dr.DeletedCount == 0 {
+			return utils.ErrNotFound
+		}
+		return err
+	})
+}
+
+// GetPortedNumberDrv retrieves the PortedNumber from dataDB
+func (ms *MongoStorage) GetPortedNumberDrv(number string) (*PortedNumber, error) {
+	pn := new(PortedNumber)
+	err := ms.query(func(sctx mongo.SessionContext) error {
+		sr := ms.getCol(ColPnb).FindOne(sctx, bson.M{"number": number})
+		decodeErr := sr.Decode(pn)
+		if errors.Is(decodeErr, mongo.ErrNoDocuments) {
+			return utils.ErrNotFound
+		}
+		return decodeErr
+	})
+	return pn, err
+}
+
+// SetPortedNumberDrv stores the PortedNumber in dataDB
+func (ms *MongoStorage) SetPortedNumberDrv(pn *PortedNumber) error {
+	return ms.query(func(sctx mongo.SessionContext) error {
+		_, err := ms.getCol(ColPnb).UpdateOne(sctx, bson.M{"number": pn.Number},
+			bson.M{"$set": pn},
+			options.Update().SetUpsert(true),
+		)
+		return err
+	})
+}
+
+// RemovePortedNumberDrv removes the PortedNumber from dataDB
+func (ms *MongoStorage) RemovePortedNumberDrv(number string) error {
+	return ms.query(func(sctx mongo.SessionContext) error {
+		dr, err := ms.getCol(ColPnb).DeleteOne(sctx, bson.M{"number": number})
+		if dr.DeletedCount == 0 {
+			return utils.ErrNotFound
+		}
+		return err
+	})
+}
+
+// GetLookupTableDrv retrieves the LookupTable from dataDB
+func (ms *MongoStorage) GetLookupTableDrv(tnt, id string) (*LookupTable, error) {
+	lt := new(LookupTable)
+	err := ms.query(func(sctx mongo.SessionContext) error {
+		sr := ms.getCol(ColLkt).FindOne(sctx, bson.M{"tenant": tnt, "id": id})
+		decodeErr := sr.Decode(lt)
+		if errors.Is(decodeErr, mongo.ErrNoDocuments) {
+			return utils.ErrNotFound
+		}
+		return decodeErr
+	})
+	return lt, err
+}
+
+// SetLookupTableDrv stores the LookupTable in dataDB
+func (ms *MongoStorage) SetLookupTableDrv(lt *LookupTable) error {
+	return ms.query(func(sctx mongo.SessionContext) error {
+		_, err := ms.getCol(ColLkt).UpdateOne(sctx, bson.M{"tenant": lt.Tenant, "id": lt.ID},
+			bson.M{"$set": lt},
+			options.Update().SetUpsert(true),
+		)
+		return err
+	})
+}
+
+// RemoveLookupTableDrv removes the LookupTable from dataDB
+func (ms *MongoStorage) RemoveLookupTableDrv(tnt, id string) error {
+	return ms.query(func(sctx mongo.SessionContext) error {
+		dr, err := ms.getCol(ColLkt).DeleteOne(sctx, bson.M{"tenant": tnt, "id": id})
+		if dr.DeletedCount == 0 {
+			return utils.ErrNotFound
+		}
+		return err
+	})
+}
+
+// AcquireLeaseDrv takes or renews the lease if it is free at now or already held by the same holder
+func (ms *MongoStorage) AcquireLeaseDrv(lease *Lease, now time.Time) (stored *Lease, acquired bool, err error) {
+	set := bson.M{"holder": lease.Holder, "expirytime": lease.ExpiryTime}
+	if !lease.Checkpoint.IsZero() {
+		set["checkpoint"] = lease.Checkpoint
+	}
+	stored = new(Lease)
+	err = ms.query(func(sctx mongo.SessionContext) error {
+		sr := ms.getCol(ColLes).FindOneAndUpdate(sctx,
+			bson.M{"id": lease.ID, "$or": bson.A{
+				bson.M{"holder": lease.Holder},
+				bson.M{"expirytime": bson.M{"$lte": now}},
+			}},
+			bson.M{"$set": set},
+			options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After),
+		)
+		if err := sr.Err(); err != nil {
+			if !mongo.IsDuplicateKeyError(err) {
+				return err
+			}
+			// held by another holder
+			return ms.getCol(ColLes).FindOne(sctx, bson.M{"id": lease.ID}).Decode(stored)
+		}
+		acquired = true
+		return sr.Decode(stored)
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	return
+}
+
+// RateLimitDrv applies the increment on the rate limiter stored under key, unless dryRun
+// the theoretical arrival time is updated only if not changed by other engines meanwhile, retrying otherwise
+func (ms *MongoStorage) RateLimitDrv(key string, increment, tolerance time.Duration,
+	now time.Time, dryRun bool) (retryAfter time.Duration, err error) {
+	err = ms.query(func(sctx mongo.SessionContext) error {
+		for {
+			var stored struct {
+				TAT int64 `bson:"tat"` // microseconds, avoiding the milliseconds precision of the dates
+			}
+			found := true
+			if err := ms.getCol(ColRtl).FindOne(sctx, bson.M{"id": key}).Decode(&stored); err != nil {
+				if err != mongo.ErrNoDocuments {
+					return err
+				}
+				found = false
+			}
+			var tat time.Time
+			if found {
+				tat = time.UnixMicro(stored.TAT)
+			}
+			var nTAT time.Time
+			if nTAT, retryAfter = gcra(tat, now, increment, tolerance); retryAfter != 0 || dryRun {
+				return nil
+			}
+			if !found {
+				if _, err := ms.getCol(ColRtl).InsertOne(sctx,
+					bson.M{"id": key, "tat": nTAT.UnixMicro()}); err != nil {
+					if mongo.IsDuplicateKeyError(err) { // inserted meanwhile by other engine
+						continue
+					}
+					return err
+				}
+				return nil
+			}
+			ur, err := ms.getCol(ColRtl).UpdateOne(sctx, bson.M{"id": key, "tat": stored.TAT},
+				bson.M{"$set": bson.M{"tat": nTAT.UnixMicro()}})
+			if err != nil {
+				return err
+			}
+			if ur.MatchedCount != 0 {
+				return nil
+			}
+		}
+	})
+	return
+}
+
 // DumpDataDB will dump all of datadb from memory to a file, only for InternalDB
 func (ms *MongoStorage) DumpDataDB() error {
 	return utils.ErrNotImplemented
@@ -1051,6 +1051,97 @@ func (ms *MongoStorage) RemoveSMCosts(qryFltr *utils.SMCostFilter) error {
 	})
 }
 
+// SetBalanceJournalEntries appends the entries to the balance journal
+func (ms *MongoStorage) SetBalanceJournalEntries(jes []*BalanceJournalEntry) error {
+	docs := make([]any, len(jes))
+	for i, je := range jes {
+		docs[i] = je
+	}
+	return ms.query(func(sctx mongo.SessionContext) (err error) {
+		_, err = ms.getCol(utils.BalanceJournalTBL).InsertMany(sctx, docs)
+		return err
+	})
+}
+
+// GetBalanceJournal returns the balance journal entries of an account in chronological order
+func (ms *MongoStorage) GetBalanceJournal(fltr *utils.BalanceJournalFilter) (jes []*BalanceJournalEntry, err error) {
+	filter := bson.M{
+		TenantLow:  fltr.Tenant,
+		AccountLow: fltr.Account,
+		"time":     bson.M{"$gte": fltr.Time.Begin, "$lt": fltr.Time.End},
+	}
+	ms.cleanEmptyFilters(filter)
+	fop := options.Find().SetSort(bson.D{{Key: "time", Value: 1}, {Key: "_id", Value: 1}})
+	if fltr.Limit != nil {
+		fop = fop.SetLimit(int64(*fltr.Limit))
+	}
+	if fltr.Offset != nil {
+		fop = fop.SetSkip(int64(*fltr.Offset))
+	}
+	err = ms.query(func(sctx mongo.SessionContext) (err error) {
+		cur, err := ms.getCol(utils.BalanceJournalTBL).Find(sctx, filter, fop)
+		if err != nil {
+			return err
+		}
+		defer cur.Close(sctx)
+		for cur.Next(sctx) {
+			var je BalanceJournalEntry
+			if err := cur.Decode(&je); err != nil {
+				return err
+			}
+			jes = append(jes, &je)
+		}
+		if err = cur.Err(); err != nil {
+			return err
+		}
+		if len(jes) == 0 {
+			return utils.ErrNotFound
+		}
+		return nil
+	})
+	return
+}
+
+// SetInvoice stores the invoice, replacing the one with the same ID
+func (ms *MongoStorage) SetInvoice(inv *Invoice) error {
+	return ms.query(func(sctx mongo.SessionContext) (err error) {
+		_, err = ms.getCol(utils.InvoicesTBL).ReplaceOne(sctx,
+			bson.M{TenantLow: inv.Tenant, AccountLow: inv.Account, "id": inv.ID},
+			inv, options.Replace().SetUpsert(true))
+		return err
+	})
+}
+
+// GetInvoices returns the invoices of an account ordered by their period
+func (ms *MongoStorage) GetInvoices(fltr *utils.InvoiceFilter) (invs []*Invoice, err error) {
+	filter := bson.M{
+		TenantLow:  fltr.Tenant,
+		AccountLow: fltr.Account,
+	}
+	if len(fltr.IDs) != 0 {
+		filter["id"] = bson.M{"$in": fltr.IDs}
+	}
+	fop := options.Find().SetSort(bson.D{{Key: "periodstart", Value: 1}})
+	err = ms.query(func(sctx mongo.SessionContext) (err error) {
+		cur, err := ms.getCol(utils.InvoicesTBL).Find(sctx, filter, fop)
+		if err != nil {
+			return err
+		}
+		for cur.Next(sctx) {
+			var inv Invoice
+			if err := cur.Decode(&inv); err != nil {
+				return err
+			}
+			invs = append(invs, &inv)
+		}
+		if len(invs) == 0 {
+			return utils.ErrNotFound
+		}
+		return cur.Close(sctx)
+	})
+	return
+}
+
 func (ms *MongoStorage) SetCDR(cdr *CDR, allowUpdate bool) error {
 	if cdr.OrderID == 0 {
 		cdr.OrderID = ms.counter.Next()
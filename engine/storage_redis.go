@@ -66,6 +66,30 @@ const (
 	redis_HDEL     = "HDEL"
 	redis_RENAME   = "RENAME"
 	redis_HSET     = "HMSET"
+	redis_EVAL     = "EVAL"
+
+	// redisAcquireLease takes or renews the lease stored as hash if free at ARGV[2] or held by ARGV[1]
+	redisAcquireLease = `local cur = redis.call('HMGET', KEYS[1], 'holder', 'expiry', 'checkpoint')
+if cur[1] and cur[1] ~= ARGV[1] and tonumber(cur[2]) > tonumber(ARGV[2]) then
+	return {'0', cur[1], cur[2], cur[3] or ''}
+end
+local chk = ARGV[4]
+if chk == '' then chk = cur[3] or '' end
+redis.call('HSET', KEYS[1], 'holder', ARGV[1], 'expiry', ARGV[3], 'checkpoint', chk)
+return {'1', ARGV[1], ARGV[3], chk}`
+
+	// redisRateLimit applies the GCRA increment ARGV[2] with the tolerance ARGV[3] at ARGV[1], all in microseconds,
+	// on the theoretical arrival time stored at KEYS[1], returning the microseconds to retry after
+	redisRateLimit = `local now = tonumber(ARGV[1])
+local tat = tonumber(redis.call('GET', KEYS[1]) or now)
+if tat < now then tat = now end
+local ntat = tat + tonumber(ARGV[2])
+local retry = ntat - now - tonumber(ARGV[3])
+if retry > 0 then return math.ceil(retry) end
+if ARGV[4] ~= '1' then
+	redis.call('SET', KEYS[1], string.format('%.0f', ntat), 'PX', math.ceil((ntat - now) / 1000) + 1)
+end
+return 0`
 
 	redisLoadError = "Redis is loading the dataset in memory"
 	RedisLimit     = 524287 // https://github.com/StackExchange/StackExchange.Redis/issues/201#issuecomment-98639005
@@ -618,7 +642,8 @@ func (rs *RedisStorage) HasDataDrv(category, subject, tenant string) (exists boo
 		utils.IPProfilesPrefix, utils.StatQueuePrefix, utils.StatQueueProfilePrefix,
 		utils.ThresholdPrefix, utils.ThresholdProfilePrefix, utils.FilterPrefix,
 		utils.RouteProfilePrefix, utils.AttributeProfilePrefix, utils.ChargerProfilePrefix,
-		utils.DispatcherProfilePrefix, utils.DispatcherHostPrefix:
+		utils.DispatcherProfilePrefix, utils.DispatcherHostPrefix, utils.FraudProfilesPrefix,
+		utils.TaxProfilesPrefix:
 		err := rs.Cmd(&i, redis_EXISTS, category+utils.ConcatenatedKey(tenant, subject))
 		return i == 1, err
 	}
@@ -847,6 +872,8 @@ func (rs *RedisStorage) SetAccountDrv(acc *Account) (err error) {
 			ac.UnitCounters = acc.UnitCounters
 			ac.AllowNegative = acc.AllowNegative
 			ac.Disabled = acc.Disabled
+			ac.Holds = acc.Holds
+			ac.Subscriptions = acc.Subscriptions
 			acc = ac
 		}
 	}
@@ -1819,6 +1846,195 @@ func (rs *RedisStorage) RemoveSessionsBackupDrv(nodeID, tnt, cgrid string) error
 	return rs.Cmd(nil, redis_HDEL, utils.SessionsBackupPrefix+utils.ConcatenatedKey(tnt, nodeID), cgrid)
 }
 
+// GetExchangeRateDrv retrieves the exchange rate of the currency pair from dataDB
+func (rs *RedisStorage) GetExchangeRateDrv(from, to string) (er *ExchangeRate, err error) {
+	var values []byte
+	if err = rs.Cmd(&values, redis_GET, utils.ExchangeRatesPrefix+utils.ConcatenatedKey(from, to)); err != nil {
+		return
+	} else if len(values) == 0 {
+		err = utils.ErrNotFound
+		return
+	}
+	err = rs.ms.Unmarshal(values, &er)
+	return
+}
+
+// SetExchangeRateDrv stores the exchange rate in dataDB
+func (rs *RedisStorage) SetExchangeRateDrv(er *ExchangeRate) (err error) {
+	var result []byte
+	if result, err = rs.ms.Marshal(er); err != nil {
+		return
+	}
+	return rs.Cmd(nil, redis_SET, utils.ExchangeRatesPrefix+er.PairID(), string(result))
+}
+
+// RemoveExchangeRateDrv removes the exchange rate of the currency pair from dataDB
+func (rs *RedisStorage) RemoveExchangeRateDrv(from, to string) (err error) {
+	return rs.Cmd(nil, redis_DEL, utils.ExchangeRatesPrefix+utils.ConcatenatedKey(from, to))
+}
+
+// GetTaxProfileDrv retrieves the TaxProfile from dataDB
+func (rs *RedisStorage) GetTaxProfileDrv(tnt, id string) (tp *TaxProfile, err error) {
+	var values []byte
+	if err = rs.Cmd(&values, redis_GET, utils.TaxProfilesPrefix+utils.ConcatenatedKey(tnt, id)); err != nil {
+		return
+	} else if len(values) == 0 {
+		err = utils.ErrNotFound
+		return
+	}
+	err = rs.ms.Unmarshal(values, &tp)
+	return
+}
+
+// SetTaxProfileDrv stores the TaxProfile in dataDB
+func (rs *RedisStorage) SetTaxProfileDrv(tp *TaxProfile) (err error) {
+	var result []byte
+	if result, err = rs.ms.Marshal(tp); err != nil {
+		return
+	}
+	return rs.Cmd(nil, redis_SET, utils.TaxProfilesPrefix+tp.TenantID(), string(result))
+}
+
+// RemoveTaxProfileDrv removes the TaxProfile from dataDB
+func (rs *RedisStorage) RemoveTaxProfileDrv(tnt, id string) (err error) {
+	return rs.Cmd(nil, redis_DEL, utils.TaxProfilesPrefix+utils.ConcatenatedKey(tnt, id))
+}
+
+// GetFraudProfileDrv retrieves the FraudProfile from dataDB
+func (rs *RedisStorage) GetFraudProfileDrv(tnt, id string) (fp *FraudProfile, err error) {
+	var values []byte
+	if err = rs.Cmd(&values, redis_GET, utils.FraudProfilesPrefix+utils.ConcatenatedKey(tnt, id)); err != nil {
+		return
+	} else if len(values) == 0 {
+		err = utils.ErrNotFound
+		return
+	}
+	err = rs.ms.Unmarshal(values, &fp)
+	return
+}
+
+// SetFraudProfileDrv stores the FraudProfile in dataDB
+func (rs *RedisStorage) SetFraudProfileDrv(fp *FraudProfile) (err error) {
+	var result []byte
+	if result, err = rs.ms.Marshal(fp); err != nil {
+		return
+	}
+	return rs.Cmd(nil, redis_SET, utils.FraudProfilesPrefix+fp.TenantID(), string(result))
+}
+
+// RemoveFraudProfileDrv removes the FraudProfile from dataDB
+func (rs *RedisStorage) RemoveFraudProfileDrv(tnt, id string) (err error) {
+	return rs.Cmd(nil, redis_DEL, utils.FraudProfilesPrefix+utils.ConcatenatedKey(tnt, id))
+}
+
+// GetPortedNumberDrv retrieves the PortedNumber from dataDB
+func (rs *RedisStorage) GetPortedNumberDrv(number string) (pn *PortedNumber, err error) {
+	var values []byte
+	if err = rs.Cmd(&values, redis_GET, utils.PortedNumberPrefix+number); err != nil {
+		return
+	} else if len(values) == 0 {
+		err = utils.ErrNotFound
+		return
+	}
+	err = rs.ms.Unmarshal(values, &pn)
+	return
+}
+
+// SetPortedNumberDrv stores the PortedNumber in dataDB
+func (rs *RedisStorage) SetPortedNumberDrv(pn *PortedNumber) (err error) {
+	var result []byte
+	if result, err = rs.ms.Marshal(pn); err != nil {
+		return
+	}
+	return rs.Cmd(nil, redis_SET, utils.PortedNumberPrefix+pn.Number, string(result))
+}
+
+// RemovePortedNumberDrv removes the PortedNumber from dataDB
+func (rs *RedisStorage) RemovePortedNumberDrv(number string) (err error) {
+	var n int
+	if err = rs.Cmd(&n, redis_DEL, utils.PortedNumberPrefix+number); err != nil {
+		return
+	} else if n == 0 {
+		err = utils.ErrNotFound
+	}
+	return
+}
+
+// GetLookupTableDrv retrieves the LookupTable from dataDB
+func (rs *RedisStorage) GetLookupTableDrv(tnt, id string) (lt *LookupTable, err error) {
+	var values []byte
+	if err = rs.Cmd(&values, redis_GET, utils.LookupTablePrefix+utils.ConcatenatedKey(tnt, id)); err != nil {
+		return
+	} else if len(values) == 0 {
+		err = utils.ErrNotFound
+		return
+	}
+	err = rs.ms.Unmarshal(values, &lt)
+	return
+}
+
+// SetLookupTableDrv stores the LookupTable in dataDB
+func (rs *RedisStorage) SetLookupTableDrv(lt *LookupTable) (err error) {
+	var result []byte
+	if result, err = rs.ms.Marshal(lt); err != nil {
+		return
+	}
+	return rs.Cmd(nil, redis_SET, utils.LookupTablePrefix+lt.TenantID(), string(result))
+}
+
+// RemoveLookupTableDrv removes the LookupTable from dataDB
+func (rs *RedisStorage) RemoveLookupTableDrv(tnt, id string) (err error) {
+	return rs.Cmd(nil, redis_DEL, utils.LookupTablePrefix+utils.ConcatenatedKey(tnt, id))
+}
+
+// AcquireLeaseDrv takes or renews the lease if it is free at now or already held by the same holder
+func (rs *RedisStorage) AcquireLeaseDrv(lease *Lease, now time.Time) (stored *Lease, acquired bool, err error) {
+	var chk string
+	if !lease.Checkpoint.IsZero() {
+		chk = strconv.FormatInt(lease.Checkpoint.UnixNano(), 10)
+	}
+	var rply []string
+	if err = rs.Cmd(&rply, redis_EVAL, redisAcquireLease, "1", utils.LeasesPrefix+lease.ID,
+		lease.Holder, strconv.FormatInt(now.UnixNano(), 10),
+		strconv.FormatInt(lease.ExpiryTime.UnixNano(), 10), chk); err != nil {
+		return
+	}
+	if len(rply) != 4 {
+		return nil, false, fmt.Errorf("unexpected reply acquiring lease <%s>: %v", lease.ID, rply)
+	}
+	stored = &Lease{ID: lease.ID, Holder: rply[1]}
+	for _, tm := range []struct {
+		val string
+		out *time.Time
+	}{{rply[2], &stored.ExpiryTime}, {rply[3], &stored.Checkpoint}} {
+		if tm.val == utils.EmptyString {
+			continue
+		}
+		var nsec int64
+		if nsec, err = strconv.ParseInt(tm.val, 10, 64); err != nil {
+			return nil, false, err
+		}
+		*tm.out = time.Unix(0, nsec)
+	}
+	return stored, rply[0] == "1", nil
+}
+
+// RateLimitDrv applies the increment on the rate limiter stored under key, unless dryRun
+func (rs *RedisStorage) RateLimitDrv(key string, increment, tolerance time.Duration,
+	now time.Time, dryRun bool) (retryAfter time.Duration, err error) {
+	dry := "0"
+	if dryRun {
+		dry = "1"
+	}
+	var retryUsec int64
+	if err = rs.Cmd(&retryUsec, redis_EVAL, redisRateLimit, "1", utils.RateLimitsPrefix+key,
+		strconv.FormatInt(now.UnixMicro(), 10), strconv.FormatInt(increment.Microseconds(), 10),
+		strconv.FormatInt(tolerance.Microseconds(), 10), dry); err != nil {
+		return
+	}
+	return time.Duration(retryUsec) * time.Microsecond, nil
+}
+
 // DumpDataDB will dump all of datadb from memory to a file, only for InternalDB
 func (rs *RedisStorage) DumpDataDB() error {
 	return utils.ErrNotImplemented
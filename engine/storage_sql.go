@@ -102,7 +102,7 @@ func (sqls *SQLStorage) IsDBEmpty() (resp bool, err error) {
 		utils.TBLTPAccountActions, utils.TBLTPResources, utils.TBLTPStats, utils.TBLTPThresholds,
 		utils.TBLTPFilters, utils.SessionCostsTBL, utils.CDRsTBL, utils.TBLTPActionPlans,
 		utils.TBLVersions, utils.TBLTPRoutes, utils.TBLTPAttributes, utils.TBLTPChargers,
-		utils.TBLTPDispatchers, utils.TBLTPDispatcherHosts,
+		utils.TBLTPDispatchers, utils.TBLTPDispatcherHosts, utils.BalanceJournalTBL, utils.InvoicesTBL,
 	}
 	for _, tbl := range tbls {
 		if sqls.db.Migrator().HasTable(tbl) {
@@ -934,6 +934,122 @@ func (sqls *SQLStorage) GetSMCosts(cgrid, runid, originHost, originIDPrefix stri
 	return smCosts, nil
 }
 
+// SetBalanceJournalEntries appends the entries to the balance journal
+func (sqls *SQLStorage) SetBalanceJournalEntries(jes []*BalanceJournalEntry) error {
+	mdls := make([]*BalanceJournalSQL, len(jes))
+	for i, je := range jes {
+		mdls[i] = &BalanceJournalSQL{
+			Tenant:      je.Tenant,
+			Account:     je.Account,
+			BalanceType: je.BalanceType,
+			BalanceUUID: je.BalanceUUID,
+			BalanceID:   je.BalanceID,
+			Before:      je.Before,
+			After:       je.After,
+			Delta:       je.Delta,
+			Source:      je.Source,
+			ActionsID:   je.ActionsID,
+			ActionID:    je.ActionID,
+			Cgrid:       je.CGRID,
+			CreatedAt:   je.Time,
+		}
+	}
+	tx := sqls.db.Begin()
+	if err := tx.Create(mdls).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+	tx.Commit()
+	return nil
+}
+
+// GetBalanceJournal returns the balance journal entries of an account in chronological order
+func (sqls *SQLStorage) GetBalanceJournal(fltr *utils.BalanceJournalFilter) ([]*BalanceJournalEntry, error) {
+	q := sqls.db.Where(&BalanceJournalSQL{Tenant: fltr.Tenant, Account: fltr.Account})
+	if fltr.Time.Begin != nil {
+		q = q.Where("created_at >= ?", fltr.Time.Begin)
+	}
+	if fltr.Time.End != nil {
+		q = q.Where("created_at < ?", fltr.Time.End)
+	}
+	q = q.Order("created_at, id")
+	if fltr.Limit != nil {
+		q = q.Limit(*fltr.Limit)
+	}
+	if fltr.Offset != nil {
+		q = q.Offset(*fltr.Offset)
+	}
+	var results []*BalanceJournalSQL
+	if err := q.Find(&results).Error; err != nil {
+		return nil, err
+	}
+	if len(results) == 0 {
+		return nil, utils.ErrNotFound
+	}
+	jes := make([]*BalanceJournalEntry, len(results))
+	for i, result := range results {
+		jes[i] = &BalanceJournalEntry{
+			Tenant:      result.Tenant,
+			Account:     result.Account,
+			BalanceType: result.BalanceType,
+			BalanceUUID: result.BalanceUUID,
+			BalanceID:   result.BalanceID,
+			Before:      result.Before,
+			After:       result.After,
+			Delta:       result.Delta,
+			Source:      result.Source,
+			ActionsID:   result.ActionsID,
+			ActionID:    result.ActionID,
+			CGRID:       result.Cgrid,
+			Time:        result.CreatedAt,
+		}
+	}
+	return jes, nil
+}
+
+// SetInvoice stores the invoice, replacing the one with the same ID
+func (sqls *SQLStorage) SetInvoice(inv *Invoice) error {
+	mdl, err := NewInvoiceSQL(inv)
+	if err != nil {
+		return err
+	}
+	tx := sqls.db.Begin()
+	if err = tx.Where(&InvoiceSQL{Tenant: inv.Tenant, Account: inv.Account, InvoiceID: inv.ID}).
+		Delete(&InvoiceSQL{}).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err = tx.Create(mdl).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+	tx.Commit()
+	return nil
+}
+
+// GetInvoices returns the invoices of an account ordered by their period
+func (sqls *SQLStorage) GetInvoices(fltr *utils.InvoiceFilter) ([]*Invoice, error) {
+	q := sqls.db.Where(&InvoiceSQL{Tenant: fltr.Tenant, Account: fltr.Account})
+	if len(fltr.IDs) != 0 {
+		q = q.Where("invoice_id in (?)", fltr.IDs)
+	}
+	var results []*InvoiceSQL
+	if err := q.Order("period_start").Find(&results).Error; err != nil {
+		return nil, err
+	}
+	if len(results) == 0 {
+		return nil, utils.ErrNotFound
+	}
+	invs := make([]*Invoice, len(results))
+	for i, result := range results {
+		var err error
+		if invs[i], err = result.AsInvoice(); err != nil {
+			return nil, err
+		}
+	}
+	return invs, nil
+}
+
 func (sqls *SQLStorage) SetCDR(cdr *CDR, allowUpdate bool) error {
 	tx := sqls.db.Begin()
 	cdrSQL, err := cdr.AsCDRsql(sqls.ms)
@@ -0,0 +1,238 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/cgrates/cgrates/utils"
+)
+
+// TaxProfile groups the taxes applied on the costs of the events matching its filters
+type TaxProfile struct {
+	Tenant             string
+	ID                 string
+	FilterIDs          []string
+	ActivationInterval *utils.ActivationInterval // active only within this interval
+	Weight             float64                   // the heaviest profile matching the event is applied
+	Taxes              []*Tax                    // applied in order, the compound ones taxing the previous taxes as well
+}
+
+// Tax is a percentage of the cost or a fixed amount per unit of usage
+type Tax struct {
+	Code            string // ie: VAT
+	Description     string
+	Type            string        // *percent or *per_unit
+	Rate            float64       // the percentage for *percent, the amount per unit for *per_unit
+	Unit            time.Duration // the *per_unit usage unit, each started one being taxed; 0 taxes the event as one unit
+	Compound        bool          // *percent calculated on the cost including the taxes before it
+	ExemptFilterIDs []string      // events matching these filters are exempted from the tax
+}
+
+// TaxProfileWithAPIOpts is used in replicatorV1 for dispatcher
+type TaxProfileWithAPIOpts struct {
+	*TaxProfile
+	APIOpts map[string]any
+}
+
+// CDRTaxes are the taxes applied on the cost of a CDR, attached to it within the *taxes extra field
+type CDRTaxes struct {
+	TaxProfileID string
+	Cost         float64 // the cost the taxes were applied on
+	Total        float64
+	Taxes        []*AppliedTax
+}
+
+// AppliedTax is the amount of a tax applied on a cost
+type AppliedTax struct {
+	Code        string
+	Description string
+	Amount      float64
+}
+
+// TenantID returns the concatenated key between tenant and ID
+func (tp *TaxProfile) TenantID() string {
+	return utils.ConcatenatedKey(tp.Tenant, tp.ID)
+}
+
+// Clone returns a clone of TaxProfile
+func (tp *TaxProfile) Clone() *TaxProfile {
+	if tp == nil {
+		return nil
+	}
+	cln := &TaxProfile{
+		Tenant:             tp.Tenant,
+		ID:                 tp.ID,
+		FilterIDs:          slices.Clone(tp.FilterIDs),
+		ActivationInterval: tp.ActivationInterval.Clone(),
+		Weight:             tp.Weight,
+	}
+	if tp.Taxes != nil {
+		cln.Taxes = make([]*Tax, len(tp.Taxes))
+		for i, tx := range tp.Taxes {
+			txCln := *tx
+			txCln.ExemptFilterIDs = slices.Clone(tx.ExemptFilterIDs)
+			cln.Taxes[i] = &txCln
+		}
+	}
+	return cln
+}
+
+// CacheClone returns a clone of TaxProfile used by ltcache CacheCloner
+func (tp *TaxProfile) CacheClone() any {
+	return tp.Clone()
+}
+
+// Validate checks the taxes of the profile
+func (tp *TaxProfile) Validate() error {
+	for _, tx := range tp.Taxes {
+		if tx == nil || tx.Code == utils.EmptyString {
+			return utils.NewErrMandatoryIeMissing(utils.Code)
+		}
+		switch tx.Type {
+		case utils.MetaPercent, utils.MetaPerUnit:
+		default:
+			return fmt.Errorf("unsupported type <%s> for tax <%s>", tx.Type, tx.Code)
+		}
+		if tx.Rate < 0 || tx.Unit < 0 {
+			return fmt.Errorf("%w for tax <%s>", utils.ErrNegative, tx.Code)
+		}
+	}
+	return nil
+}
+
+// amount returns the tax due for the cost and usage, base including the taxes applied before it
+func (tx *Tax) amount(cost, base float64, usage time.Duration) float64 {
+	if tx.Type == utils.MetaPerUnit {
+		units := 1.0
+		if tx.Unit != 0 {
+			units = math.Ceil(float64(usage) / float64(tx.Unit))
+		}
+		return utils.Round(units*tx.Rate, globalRoundingDecimals, utils.MetaRoundingMiddle)
+	}
+	if !tx.Compound {
+		base = cost
+	}
+	return utils.Round((base*tx.Rate)/100, globalRoundingDecimals, utils.MetaRoundingMiddle)
+}
+
+// taxesFor applies the taxes of the profile, skipping the ones the event is exempted from
+func (tp *TaxProfile) taxesFor(cost float64, usage time.Duration, ev utils.DataProvider, fltrS *FilterS) (cdrTxs *CDRTaxes, err error) {
+	cdrTxs = &CDRTaxes{
+		TaxProfileID: tp.ID,
+		Cost:         cost,
+		Taxes:        make([]*AppliedTax, 0, len(tp.Taxes)),
+	}
+	base := cost
+	for _, tx := range tp.Taxes {
+		if len(tx.ExemptFilterIDs) != 0 {
+			var exempt bool
+			if exempt, err = fltrS.Pass(tp.Tenant, tx.ExemptFilterIDs, ev); err != nil {
+				return nil, err
+			} else if exempt {
+				continue
+			}
+		}
+		amount := tx.amount(cost, base, usage)
+		base += amount
+		cdrTxs.Total += amount
+		cdrTxs.Taxes = append(cdrTxs.Taxes, &AppliedTax{
+			Code:        tx.Code,
+			Description: tx.Description,
+			Amount:      amount,
+		})
+	}
+	cdrTxs.Total = utils.Round(cdrTxs.Total, globalRoundingDecimals, utils.MetaRoundingMiddle)
+	return
+}
+
+// matchingTaxProfile returns the heaviest TaxProfile of the tenant active at the time and matching the event.
+// The candidates are selected on the filter indexes built for all the fields of the event
+func matchingTaxProfile(dm *DataManager, fltrS *FilterS, tnt string, t time.Time, ev utils.MapStorage) (mtp *TaxProfile, err error) {
+	tpIDs, err := MatchingItemIDsForEvent(ev, nil, nil, nil, nil,
+		dm, utils.CacheTaxFilterIndexes, tnt, true, false)
+	if err != nil {
+		return nil, err
+	}
+	for tpID := range tpIDs {
+		var tp *TaxProfile
+		if tp, err = dm.GetTaxProfile(tnt, tpID, true, true, utils.NonTransactional); err != nil {
+			if err == utils.ErrNotFound {
+				continue
+			}
+			return nil, err
+		}
+		if tp.ActivationInterval != nil && !tp.ActivationInterval.IsActiveAtTime(t) {
+			continue
+		}
+		if mtp != nil && (mtp.Weight > tp.Weight ||
+			mtp.Weight == tp.Weight && mtp.ID <= tp.ID) { // ties go to the lowest ID
+			continue
+		}
+		var pass bool
+		if pass, err = fltrS.Pass(tnt, tp.FilterIDs, ev); err != nil {
+			return nil, err
+		} else if pass {
+			mtp = tp
+		}
+	}
+	if mtp == nil {
+		return nil, utils.ErrNotFound
+	}
+	return mtp, nil
+}
+
+// CDRTaxesFor returns the taxes of the TaxProfile matching the CDR, applied on its cost
+func CDRTaxesFor(dm *DataManager, fltrS *FilterS, cdr *CDR) (cdrTxs *CDRTaxes, err error) {
+	ev := cdr.AsMapStorage()
+	var tp *TaxProfile
+	if tp, err = matchingTaxProfile(dm, fltrS, cdr.Tenant, cdr.AnswerTime, ev); err != nil {
+		return
+	}
+	return tp.taxesFor(cdr.Cost, cdr.Usage, ev, fltrS)
+}
+
+// TaxCDR adds the taxes of the matching TaxProfile to the cost of the rated CDR,
+// attaching them within the *taxes extra field as done for *sure_tax
+func TaxCDR(dm *DataManager, fltrS *FilterS, cdr *CDR) (err error) {
+	if cdr.Cost < 0 { // not rated
+		return
+	}
+	var cdrTxs *CDRTaxes
+	if cdrTxs, err = CDRTaxesFor(dm, fltrS, cdr); err != nil {
+		if err == utils.ErrNotFound { // nothing to tax
+			err = nil
+		}
+		return
+	}
+	var txsJSON []byte
+	if txsJSON, err = json.Marshal(cdrTxs); err != nil {
+		return
+	}
+	if cdr.ExtraFields == nil {
+		cdr.ExtraFields = make(map[string]string)
+	}
+	cdr.ExtraFields[utils.MetaTaxes] = string(txsJSON)
+	cdr.Cost = utils.Round(cdr.Cost+cdrTxs.Total, globalRoundingDecimals, utils.MetaRoundingMiddle)
+	return
+}
@@ -0,0 +1,133 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/utils"
+)
+
+func TestTaxProfileValidate(t *testing.T) {
+	tp := &TaxProfile{ID: "TXP1", Taxes: []*Tax{{Code: "VAT", Type: utils.MetaPercent, Rate: 19}}}
+	if err := tp.Validate(); err != nil {
+		t.Error(err)
+	}
+	tp.Taxes[0].Type = "*unknown"
+	if err := tp.Validate(); err == nil {
+		t.Error("expected error for unsupported type")
+	}
+	tp.Taxes[0].Type = utils.MetaPerUnit
+	tp.Taxes[0].Rate = -1
+	if err := tp.Validate(); err == nil {
+		t.Error("expected error for negative rate")
+	}
+}
+
+func TestTaxCDR(t *testing.T) {
+	cfg := config.NewDefaultCGRConfig()
+	data, err := NewInternalDB(nil, nil, true, nil, cfg.DataDbCfg().Items)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dmTx := NewDataManager(data, cfg.CacheCfg(), nil)
+	fltrS := NewFilterS(cfg, nil, dmTx)
+	if err = dmTx.SetTaxProfile(&TaxProfile{Tenant: "cgrates.org", ID: "DEFAULT",
+		Taxes: []*Tax{{Code: "VAT", Type: utils.MetaPercent, Rate: 10}}}, true); err != nil {
+		t.Fatal(err)
+	}
+	if err = dmTx.SetTaxProfile(&TaxProfile{Tenant: "cgrates.org", ID: "CALLS",
+		FilterIDs: []string{"*string:~*req.Category:call"}, Weight: 10,
+		Taxes: []*Tax{
+			{Code: "FEE", Type: utils.MetaPerUnit, Rate: 0.01, Unit: time.Minute},
+			{Code: "VAT", Type: utils.MetaPercent, Rate: 10, Compound: true},
+			{Code: "LOCAL", Type: utils.MetaPercent, Rate: 5,
+				ExemptFilterIDs: []string{"*prefix:~*req.Destination:+49"}},
+		}}, true); err != nil {
+		t.Fatal(err)
+	}
+	// heavier but indexed on another category, never selected for calls
+	if err = dmTx.SetTaxProfile(&TaxProfile{Tenant: "cgrates.org", ID: "DATA",
+		FilterIDs: []string{"*string:~*req.Category:data"}, Weight: 20,
+		Taxes: []*Tax{{Code: "VAT", Type: utils.MetaPercent, Rate: 50}}}, true); err != nil {
+		t.Fatal(err)
+	}
+	// same weight as CALLS, loses the tie on ID
+	if err = dmTx.SetTaxProfile(&TaxProfile{Tenant: "cgrates.org", ID: "CALLS_OLD",
+		FilterIDs: []string{"*string:~*req.Category:call"}, Weight: 10,
+		Taxes: []*Tax{{Code: "VAT", Type: utils.MetaPercent, Rate: 20}}}, true); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 10; i++ {
+		if tp, err := matchingTaxProfile(dmTx, fltrS, "cgrates.org", time.Time{},
+			utils.MapStorage{utils.MetaReq: map[string]interface{}{utils.Category: "call"}}); err != nil {
+			t.Fatal(err)
+		} else if tp.ID != "CALLS" {
+			t.Fatalf("expected CALLS, received %s", tp.ID)
+		}
+	}
+	cdr := &CDR{Tenant: "cgrates.org", Account: "1001", Category: "call", Destination: "1002",
+		Usage: 90 * time.Second, Cost: 1, AnswerTime: time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC)}
+	if err = TaxCDR(dmTx, fltrS, cdr); err != nil {
+		t.Fatal(err)
+	}
+	// FEE 2*0.01, VAT 10% of 1.02, LOCAL 5% of 1
+	if cdr.Cost != 1.172 {
+		t.Errorf("expected cost 1.172, received %v", cdr.Cost)
+	}
+	var cdrTxs CDRTaxes
+	if err = json.Unmarshal([]byte(cdr.ExtraFields[utils.MetaTaxes]), &cdrTxs); err != nil {
+		t.Fatal(err)
+	}
+	if cdrTxs.TaxProfileID != "CALLS" || cdrTxs.Total != 0.172 || len(cdrTxs.Taxes) != 3 ||
+		cdrTxs.Taxes[1].Amount != 0.102 {
+		t.Errorf("unexpected taxes: %s", utils.ToJSON(cdrTxs))
+	}
+	if lines, err := cdrTaxLines(cdr); err != nil {
+		t.Error(err)
+	} else if len(lines) != 3 {
+		t.Errorf("expected 3 invoice tax lines, received %s", utils.ToJSON(lines))
+	}
+	// exempted from LOCAL
+	cdr = &CDR{Tenant: "cgrates.org", Category: "call", Destination: "+4930", Usage: time.Minute, Cost: 1}
+	if err = TaxCDR(dmTx, fltrS, cdr); err != nil {
+		t.Fatal(err)
+	} else if cdr.Cost != 1.111 {
+		t.Errorf("expected cost 1.111, received %v", cdr.Cost)
+	}
+	// falls back on the default profile
+	cdr = &CDR{Tenant: "cgrates.org", Category: "sms", Cost: 1}
+	if err = TaxCDR(dmTx, fltrS, cdr); err != nil {
+		t.Fatal(err)
+	} else if cdr.Cost != 1.1 {
+		t.Errorf("expected cost 1.1, received %v", cdr.Cost)
+	}
+	// not rated or without profile stays untouched
+	cdr = &CDR{Tenant: "cgrates.org", Category: "call", Cost: -1}
+	if err = TaxCDR(dmTx, fltrS, cdr); err != nil || cdr.Cost != -1 || cdr.ExtraFields != nil {
+		t.Errorf("unexpected taxed CDR: %s, err: %v", utils.ToJSON(cdr), err)
+	}
+	cdr = &CDR{Tenant: "itsyscom.com", Category: "call", Cost: 1}
+	if err = TaxCDR(dmTx, fltrS, cdr); err != nil || cdr.Cost != 1 || cdr.ExtraFields != nil {
+		t.Errorf("unexpected taxed CDR: %s, err: %v", utils.ToJSON(cdr), err)
+	}
+}
@@ -54,24 +54,47 @@ type ThresholdProfile struct {
 	ActionIDs          []string
 	Async              bool
 	EeIDs              []string
+	Escalations        []*ThresholdEscalation // executed in order while the alert stays unacknowledged
+	AutoResolve        time.Duration          // resolve the alert if no hits are received for this duration
 
 	lkID string // holds the reference towards guardian lock key
 }
 
+// ThresholdEscalation is one step of the escalation chain, executed once
+// the alert is firing for Delay without being acknowledged
+type ThresholdEscalation struct {
+	Delay     time.Duration
+	ActionIDs []string
+	EeIDs     []string
+}
+
+// Clone clones *ThresholdEscalation
+func (te *ThresholdEscalation) Clone() *ThresholdEscalation {
+	if te == nil {
+		return nil
+	}
+	return &ThresholdEscalation{
+		Delay:     te.Delay,
+		ActionIDs: slices.Clone(te.ActionIDs),
+		EeIDs:     slices.Clone(te.EeIDs),
+	}
+}
+
 // Clone clones *ThresholdProfile (lkID excluded)
 func (tp *ThresholdProfile) Clone() *ThresholdProfile {
 	if tp == nil {
 		return nil
 	}
 	clone := &ThresholdProfile{
-		Tenant:   tp.Tenant,
-		ID:       tp.ID,
-		MaxHits:  tp.MaxHits,
-		MinHits:  tp.MinHits,
-		MinSleep: tp.MinSleep,
-		Blocker:  tp.Blocker,
-		Weight:   tp.Weight,
-		Async:    tp.Async,
+		Tenant:      tp.Tenant,
+		ID:          tp.ID,
+		MaxHits:     tp.MaxHits,
+		MinHits:     tp.MinHits,
+		MinSleep:    tp.MinSleep,
+		Blocker:     tp.Blocker,
+		Weight:      tp.Weight,
+		Async:       tp.Async,
+		AutoResolve: tp.AutoResolve,
 	}
 	if tp.FilterIDs != nil {
 		clone.FilterIDs = make([]string, len(tp.FilterIDs))
@@ -88,6 +111,12 @@ func (tp *ThresholdProfile) Clone() *ThresholdProfile {
 		clone.EeIDs = make([]string, len(tp.EeIDs))
 		copy(clone.EeIDs, tp.EeIDs)
 	}
+	if tp.Escalations != nil {
+		clone.Escalations = make([]*ThresholdEscalation, len(tp.Escalations))
+		for i, esc := range tp.Escalations {
+			clone.Escalations[i] = esc.Clone()
+		}
+	}
 	return clone
 }
 
@@ -145,6 +174,11 @@ type Threshold struct {
 	Hits   int       // number of hits for this threshold
 	Snooze time.Time // prevent threshold to run too early
 
+	AlertState      string    // <""|*firing|*acknowledged|*snoozed|*resolved>
+	AlertTime       time.Time // last change of the AlertState
+	LastHit         time.Time // time of the last hit, used to auto-resolve the alert
+	EscalationLevel int       // number of escalations executed for the current alert
+
 	lkID  string // ID of the lock used when matching the threshold
 	tPrfl *ThresholdProfile
 	dirty *bool // needs save
@@ -160,6 +194,11 @@ func (t *Threshold) Clone() *Threshold {
 		ID:     t.ID,
 		Hits:   t.Hits,
 		Snooze: t.Snooze,
+
+		AlertState:      t.AlertState,
+		AlertTime:       t.AlertTime,
+		LastHit:         t.LastHit,
+		EscalationLevel: t.EscalationLevel,
 	}
 	if t.tPrfl != nil {
 		clone.tPrfl = t.tPrfl.Clone()
@@ -194,6 +233,13 @@ type ThresholdConfig struct {
 	EeIDs              []string
 }
 
+// alertActive returns true if the threshold has an alert which was not resolved yet
+func (t *Threshold) alertActive() bool {
+	return t.AlertState == utils.MetaFiring ||
+		t.AlertState == utils.MetaAcknowledged ||
+		t.AlertState == utils.MetaSnoozed
+}
+
 // thresholdLockKey returns the ID used to lock a threshold with guardian
 func thresholdLockKey(tnt, id string) string {
 	return utils.ConcatenatedKey(utils.CacheThresholds, tnt, id)
@@ -225,12 +271,13 @@ func (t *Threshold) isLocked() bool {
 	return t.lkID != utils.EmptyString
 }
 
-// processEEs processes to the EEs for this threshold
-func (t *ThresholdService) processEEs(opts map[string]any, th *Threshold) (err error) {
+// processEEs processes to the EEs for this threshold, eeIDs overwriting the
+// exporters configured within the ThresholdS section
+func (t *ThresholdService) processEEs(opts map[string]any, th *Threshold, eeIDs []string, evType string) (err error) {
 	var targetEeIDs []string
-	if len(th.tPrfl.EeIDs) > 0 {
-		targetEeIDs = th.tPrfl.EeIDs
-		if isNone := slices.Contains(th.tPrfl.EeIDs, utils.MetaNone); isNone {
+	if len(eeIDs) > 0 {
+		targetEeIDs = eeIDs
+		if isNone := slices.Contains(eeIDs, utils.MetaNone); isNone {
 			targetEeIDs = []string{}
 		}
 	} else {
@@ -249,16 +296,18 @@ func (t *ThresholdService) processEEs(opts map[string]any, th *Threshold) (err e
 	sortedFilterIDs := make([]string, len(th.tPrfl.FilterIDs))
 	copy(sortedFilterIDs, th.tPrfl.FilterIDs)
 	slices.Sort(sortedFilterIDs)
-	opts[utils.MetaEventType] = utils.ThresholdHit
+	opts[utils.MetaEventType] = evType
 	cgrEv := &utils.CGREvent{
 		Tenant: th.Tenant,
 		ID:     utils.GenUUID(),
 		Time:   utils.TimePointer(time.Now()),
 		Event: map[string]any{
-			utils.EventType: utils.ThresholdHit,
-			utils.ID:        th.ID,
-			utils.Hits:      th.Hits,
-			utils.Snooze:    th.Snooze,
+			utils.EventType:       evType,
+			utils.ID:              th.ID,
+			utils.Hits:            th.Hits,
+			utils.Snooze:          th.Snooze,
+			utils.AlertState:      th.AlertState,
+			utils.EscalationLevel: th.EscalationLevel,
 			utils.ThresholdConfig: ThresholdConfig{
 				FilterIDs:          sortedFilterIDs,
 				ActivationInterval: th.tPrfl.ActivationInterval,
@@ -328,6 +377,9 @@ func NewThresholdService(dm *DataManager, cgrcfg *config.CGRConfig, filterS *Fil
 		stopBackup:    make(chan struct{}),
 		loopStopped:   make(chan struct{}),
 		storedTdIDs:   make(utils.StringSet),
+		stopAlerts:    make(chan struct{}),
+		alertsStopped: make(chan struct{}),
+		alertTdIDs:    make(utils.StringSet),
 		sBiRPCClients: utils.NewServiceBiRPCClients(),
 		clientConnID:  make(map[string]*utils.SyConnIDs),
 	}
@@ -342,6 +394,10 @@ type ThresholdService struct {
 	loopStopped   chan struct{}
 	storedTdIDs   utils.StringSet // keep a record of stats which need saving, map[statsTenantID]bool
 	stMux         sync.RWMutex    // protects storedTdIDs
+	stopAlerts    chan struct{}
+	alertsStopped chan struct{}
+	alertTdIDs    utils.StringSet // thresholds with an alert not yet resolved
+	alMux         sync.Mutex      // protects alertTdIDs
 	connMgr       *ConnManager
 	sBiRPCClients *utils.ServiceBiRPCClients  // will hold ThresholdS BiRPC clients and conns
 	ccidMux       sync.RWMutex                // protects clientConnID
@@ -354,17 +410,23 @@ func (tS *ThresholdService) Reload() {
 	<-tS.loopStopped // wait until the loop is done
 	tS.stopBackup = make(chan struct{})
 	go tS.runBackup()
+	close(tS.stopAlerts)
+	<-tS.alertsStopped
+	tS.stopAlerts = make(chan struct{})
+	go tS.runAlerts()
 }
 
-// StartLoop starts the gorutine with the backup loop
+// StartLoop starts the gorutines with the backup and alerts loops
 func (tS *ThresholdService) StartLoop() {
 	go tS.runBackup()
+	go tS.runAlerts()
 }
 
 // Shutdown is called to shutdown the service
 func (tS *ThresholdService) Shutdown() {
 	utils.Logger.Info("<ThresholdS> shutdown initialized")
 	close(tS.stopBackup)
+	close(tS.stopAlerts)
 	tS.storeThresholds()
 	utils.Logger.Info("<ThresholdS> shutdown complete")
 }
@@ -397,6 +459,119 @@ func (tS *ThresholdService) runBackup() {
 	}
 }
 
+// runAlerts will regularly escalate and auto-resolve the threshold alerts
+func (tS *ThresholdService) runAlerts() {
+	alertInterval := tS.cgrcfg.ThresholdSCfg().AlertInterval
+	if alertInterval <= 0 {
+		tS.alertsStopped <- struct{}{}
+		return
+	}
+	tS.loadAlerts()
+	for {
+		tS.processAlerts(time.Now())
+		select {
+		case <-tS.stopAlerts:
+			tS.alertsStopped <- struct{}{}
+			return
+		case <-time.After(alertInterval):
+		}
+	}
+}
+
+// loadAlerts populates the alertTdIDs out of the thresholds stored in dataDB
+func (tS *ThresholdService) loadAlerts() {
+	keys, err := tS.dm.DataDB().GetKeysForPrefix(utils.ThresholdPrefix, utils.EmptyString)
+	if err != nil {
+		utils.Logger.Warning(fmt.Sprintf("<ThresholdS> failed loading the threshold alerts, error: %s", err.Error()))
+		return
+	}
+	for _, key := range keys {
+		tntID := utils.NewTenantID(key[len(utils.ThresholdPrefix):])
+		t, err := tS.dm.GetThreshold(tntID.Tenant, tntID.ID, true, false, utils.NonTransactional)
+		if err != nil {
+			continue
+		}
+		if t.alertActive() {
+			tS.alMux.Lock()
+			tS.alertTdIDs.Add(t.TenantID())
+			tS.alMux.Unlock()
+		}
+	}
+}
+
+// processAlerts escalates the alerts left unacknowledged and resolves the ones not hit anymore
+func (tS *ThresholdService) processAlerts(now time.Time) {
+	tS.alMux.Lock()
+	tIDs := tS.alertTdIDs.AsOrderedSlice()
+	tS.alMux.Unlock()
+	for _, tID := range tIDs {
+		if done := tS.processAlert(utils.NewTenantID(tID), now); done {
+			tS.alMux.Lock()
+			tS.alertTdIDs.Remove(tID)
+			tS.alMux.Unlock()
+		}
+	}
+}
+
+// processAlert handles the alert of one threshold, returning true if it needs no further checks
+func (tS *ThresholdService) processAlert(tntID *utils.TenantID, now time.Time) (done bool) {
+	lkID := guardian.Guardian.GuardIDs(utils.EmptyString,
+		config.CgrConfig().GeneralCfg().LockingTimeout,
+		thresholdLockKey(tntID.Tenant, tntID.ID))
+	defer guardian.Guardian.UnguardIDs(lkID)
+	t, err := tS.dm.GetThreshold(tntID.Tenant, tntID.ID, true, true, utils.NonTransactional)
+	if err != nil {
+		return err == utils.ErrNotFound
+	}
+	if t.tPrfl, err = tS.dm.GetThresholdProfile(tntID.Tenant, tntID.ID, true, true, utils.NonTransactional); err != nil {
+		return err == utils.ErrNotFound
+	}
+	initState, initLevel := t.AlertState, t.EscalationLevel
+	if t.AlertState == utils.MetaSnoozed && now.After(t.Snooze) {
+		// snooze expired, the escalation starts over
+		t.AlertState = utils.MetaFiring
+		t.AlertTime = now
+		t.EscalationLevel = 0
+	}
+	if t.alertActive() &&
+		t.tPrfl.AutoResolve > 0 && now.Sub(t.LastHit) >= t.tPrfl.AutoResolve {
+		resolveAlert(t, now)
+	}
+	for t.AlertState == utils.MetaFiring &&
+		t.EscalationLevel < len(t.tPrfl.Escalations) &&
+		now.Sub(t.AlertTime) >= t.tPrfl.Escalations[t.EscalationLevel].Delay {
+		esc := t.tPrfl.Escalations[t.EscalationLevel]
+		t.EscalationLevel++
+		tS.executeActions(t, esc.ActionIDs, nil)
+		if err = tS.processEEs(nil, t, esc.EeIDs, utils.ThresholdEscalation); err != nil {
+			utils.Logger.Warning(
+				fmt.Sprintf("<ThresholdService> received error: %s when processing escalation with EEs.", err.Error()))
+		}
+	}
+	if t.AlertState != initState || t.EscalationLevel != initLevel {
+		tS.markForStore(t)
+	}
+	switch t.AlertState {
+	case utils.MetaSnoozed:
+		return false
+	case utils.MetaFiring:
+		return t.tPrfl.AutoResolve <= 0 &&
+			t.EscalationLevel >= len(t.tPrfl.Escalations)
+	case utils.MetaAcknowledged:
+		return t.tPrfl.AutoResolve <= 0
+	}
+	return true
+}
+
+// resolveAlert moves the threshold alert into *resolved state
+func resolveAlert(t *Threshold, now time.Time) {
+	if t.AlertState == utils.MetaSnoozed {
+		t.Snooze = time.Time{}
+	}
+	t.AlertState = utils.MetaResolved
+	t.AlertTime = now
+}
+
 // storeThresholds represents one task of complete backup
 func (tS *ThresholdService) storeThresholds() {
 	var failedTdIDs []string
@@ -491,6 +666,8 @@ func (tS *ThresholdService) matchingThresholdsForEvent(tnt string, args *utils.C
 
 	// Lock items in sorted order to prevent AB-BA deadlock.
 	itemIDs := slices.Sorted(maps.Keys(tIDs))
+	expl := ExplanationFromOpts(args.APIOpts)
+	expl.SetCandidates(itemIDs)
 
 	ts = make(Thresholds, 0, len(itemIDs))
 	for _, id := range itemIDs {
@@ -502,6 +679,7 @@ func (tS *ThresholdService) matchingThresholdsForEvent(tnt string, args *utils.C
 			guardian.Guardian.UnguardIDs(lkPrflID)
 			if err == utils.ErrNotFound {
 				err = nil
+				expl.Skip(id, ExplainReasonNotFound)
 				continue
 			}
 			ts.unlock()
@@ -511,11 +689,14 @@ func (tS *ThresholdService) matchingThresholdsForEvent(tnt string, args *utils.C
 		if tPrfl.ActivationInterval != nil && args.Time != nil &&
 			!tPrfl.ActivationInterval.IsActiveAtTime(*args.Time) { // not active
 			tPrfl.unlock()
+			expl.Skip(id, ExplainReasonInactive)
 			continue
 		}
-		if !ignFilters {
+		if ignFilters {
+			expl.Skip(id, ExplainReasonIgnoreFilters)
+		} else {
 			var pass bool
-			if pass, err = tS.filterS.Pass(tnt, tPrfl.FilterIDs,
+			if pass, err = tS.filterS.ExplainPass(expl, id, tnt, tPrfl.FilterIDs,
 				evNm); err != nil {
 				tPrfl.unlock()
 				ts.unlock()
@@ -582,6 +763,83 @@ func (tS *ThresholdService) getThresholdSyConn(thresholdProfileID string) (*thre
 	return nil, nil
 }
 
+// executeActions executes the actionIDs on behalf of the threshold, returning true if any of them failed
+// the account is taken out of the event, if present
+func (tS *ThresholdService) executeActions(t *Threshold, actionIDs []string, args *utils.CGREvent) (withErrors bool) {
+	var tntAcnt string
+	if args != nil {
+		var acnt string
+		if utils.IfaceAsString(args.APIOpts[utils.MetaEventType]) == utils.AccountUpdate {
+			acnt, _ = args.FieldAsString(utils.AccountID)
+		} else {
+			acnt, _ = args.FieldAsString(utils.AccountField)
+		}
+		if _, has := args.APIOpts[utils.MetaAccountID]; has {
+			acnt, _ = args.OptAsString(utils.MetaAccountID)
+		}
+		if acnt != utils.EmptyString {
+			tntAcnt = utils.ConcatenatedKey(args.Tenant, acnt)
+		}
+	}
+	for _, actionSetID := range actionIDs {
+		at := &ActionTiming{
+			Uuid:      utils.GenUUID(),
+			ActionsID: actionSetID,
+		}
+		if args != nil {
+			at.ExtraData = args
+		}
+		if tntAcnt != utils.EmptyString {
+			at.accountIDs = utils.NewStringMap(tntAcnt)
+		}
+		thSC, err := tS.getThresholdSyConn(t.tPrfl.ID)
+		if err != nil {
+			withErrors = true
+			continue
+		}
+		if t.tPrfl.Async {
+			go func(setID string) {
+				if errExec := at.Execute(tS.filterS, utils.ThresholdS, thSC); errExec != nil {
+					utils.Logger.Warning(fmt.Sprintf("<ThresholdS> failed executing actions: %s, error: %s", setID, errExec.Error()))
+				}
+			}(actionSetID)
+		} else if errExec := at.Execute(tS.filterS, utils.ThresholdS, thSC); errExec != nil {
+			utils.Logger.Warning(fmt.Sprintf("<ThresholdS> failed executing actions: %s, error: %s", actionSetID, errExec.Error()))
+			withErrors = true
+		}
+	}
+	return
+}
+
+// markForStore marks the threshold as dirty and schedules it for saving
+func (tS *ThresholdService) markForStore(t *Threshold) {
+	if t.dirty == nil {
+		t.dirty = new(bool)
+	}
+	*t.dirty = true // mark it to be saved
+	if tS.cgrcfg.ThresholdSCfg().StoreInterval == -1 {
+		tS.StoreThreshold(t)
+	} else {
+		tS.stMux.Lock()
+		tS.storedTdIDs.Add(t.TenantID())
+		tS.stMux.Unlock()
+	}
+}
+
+// fireAlert moves the threshold alert into *firing state, an acknowledged alert stays acknowledged
+func (tS *ThresholdService) fireAlert(t *Threshold) {
+	if t.AlertState == utils.MetaFiring ||
+		t.AlertState == utils.MetaAcknowledged {
+		return
+	}
+	t.AlertState = utils.MetaFiring
+	t.AlertTime = time.Now()
+	t.EscalationLevel = 0
+	tS.alMux.Lock()
+	tS.alertTdIDs.Add(t.TenantID())
+	tS.alMux.Unlock()
+}
+
 // processEvent processes a new event, dispatching to matching thresholds
 func (tS *ThresholdService) processEvent(tnt string, args *utils.CGREvent) (thresholdsIDs []string, err error) {
 	var matchTs Thresholds
@@ -596,63 +854,23 @@ func (tS *ThresholdService) processEvent(tnt string, args *utils.CGREvent) (thre
 		}
 		thresholdsIDs = append(thresholdsIDs, t.ID)
 		t.Hits++
+		t.LastHit = time.Now()
 		if time.Now().After(t.Snooze) && // snoozed, not executing actions
 			t.Hits >= t.tPrfl.MinHits && // number of hits was not met, will not execute actions
 			(t.tPrfl.MaxHits == -1 ||
 				t.Hits <= t.tPrfl.MaxHits) {
-			var tntAcnt string
-			var acnt string
-			if utils.IfaceAsString(args.APIOpts[utils.MetaEventType]) == utils.AccountUpdate {
-				acnt, _ = args.FieldAsString(utils.AccountID)
-			} else {
-				acnt, _ = args.FieldAsString(utils.AccountField)
-			}
-			if _, has := args.APIOpts[utils.MetaAccountID]; has {
-				acnt, _ = args.OptAsString(utils.MetaAccountID)
-			}
-			if acnt != utils.EmptyString {
-				tntAcnt = utils.ConcatenatedKey(args.Tenant, acnt)
-			}
-			for _, actionSetID := range t.tPrfl.ActionIDs {
-				at := &ActionTiming{
-					Uuid:      utils.GenUUID(),
-					ActionsID: actionSetID,
-					ExtraData: args,
-				}
-				if tntAcnt != utils.EmptyString {
-					at.accountIDs = utils.NewStringMap(tntAcnt)
-				}
-				thSC, err := tS.getThresholdSyConn(t.tPrfl.ID)
-				if err != nil {
-					withErrors = true
-					continue
-				}
-				if t.tPrfl.Async {
-					go func(setID string) {
-						if errExec := at.Execute(tS.filterS, utils.ThresholdS, thSC); errExec != nil {
-							utils.Logger.Warning(fmt.Sprintf("<ThresholdS> failed executing actions: %s, error: %s", setID, errExec.Error()))
-						}
-					}(actionSetID)
-				} else if errExec := at.Execute(tS.filterS, utils.ThresholdS, thSC); errExec != nil {
-					utils.Logger.Warning(fmt.Sprintf("<ThresholdS> failed executing actions: %s, error: %s", actionSetID, errExec.Error()))
-					withErrors = true
-				}
+			if tS.executeActions(t, t.tPrfl.ActionIDs, args) {
+				withErrors = true
 			}
 			t.Snooze = time.Now().Add(t.tPrfl.MinSleep)
-			if err = tS.processEEs(args.APIOpts, t); err != nil {
+			tS.fireAlert(t)
+			if err = tS.processEEs(args.APIOpts, t, t.tPrfl.EeIDs, utils.ThresholdHit); err != nil {
 				utils.Logger.Warning(
 					fmt.Sprintf("<ThresholdService> received error: %s when processing with EEs.", err.Error()))
 				withErrors = true
 			}
 		}
-		*t.dirty = true // mark it to be saved
-		if tS.cgrcfg.ThresholdSCfg().StoreInterval == -1 {
-			tS.StoreThreshold(t)
-		} else {
-			tS.stMux.Lock()
-			tS.storedTdIDs.Add(t.TenantID())
-			tS.stMux.Unlock()
-		}
+		tS.markForStore(t)
 	}
 	matchTs.unlock()
 	if withErrors {
@@ -705,6 +923,28 @@ func (tS *ThresholdService) V1GetThresholdsForEvent(ctx *context.Context, args *
 	return
 }
 
+// V1ExplainEvent details why the ThresholdProfiles were matched or not for the event
+func (tS *ThresholdService) V1ExplainEvent(ctx *context.Context, args *utils.CGREvent,
+	reply *MatchExplanation) (err error) {
+	if args == nil {
+		return utils.NewErrMandatoryIeMissing(utils.CGREventString)
+	}
+	args, expl := explainArgs(args)
+	var ts Thresholds
+	if err = tS.V1GetThresholdsForEvent(ctx, args, &ts); err != nil {
+		if err != utils.ErrNotFound {
+			return
+		}
+		err = nil
+	}
+	for _, t := range ts {
+		expl.Matched = append(expl.Matched, t.ID)
+	}
+	expl.Sort()
+	*reply = *expl
+	return
+}
+
 // V1GetThresholdIDs returns list of thresholdIDs configured for a tenant
 func (tS *ThresholdService) V1GetThresholdIDs(ctx *context.Context, tenant string, tIDs *[]string) (err error) {
 	if tenant == utils.EmptyString {
@@ -778,6 +1018,79 @@ func (tS *ThresholdService) V1ResetThreshold(ctx *context.Context, tntID *utils.
 	return nil
 }
 
+// ThresholdSnoozeArgs is used to snooze the alert of a threshold
+type ThresholdSnoozeArgs struct {
+	*utils.TenantIDWithAPIOpts
+	Until time.Time // no actions are executed for the threshold until this time
+}
+
+// updateAlert changes the alert state of an active threshold alert using the updState function
+func (tS *ThresholdService) updateAlert(tntID *utils.TenantID, updState func(t *Threshold, now time.Time)) (err error) {
+	tnt := tntID.Tenant
+	if tnt == utils.EmptyString {
+		tnt = tS.cgrcfg.GeneralCfg().DefaultTenant
+	}
+	// make sure threshold is locked at process level
+	lkID := guardian.Guardian.GuardIDs(utils.EmptyString,
+		config.CgrConfig().GeneralCfg().LockingTimeout,
+		thresholdLockKey(tnt, tntID.ID))
+	defer guardian.Guardian.UnguardIDs(lkID)
+	var thd *Threshold
+	if thd, err = tS.dm.GetThreshold(tnt, tntID.ID, true, true, utils.NonTransactional); err != nil {
+		return
+	}
+	if !thd.alertActive() {
+		return utils.ErrNoActiveAlert
+	}
+	updState(thd, time.Now())
+	tS.markForStore(thd)
+	tS.alMux.Lock()
+	tS.alertTdIDs.Add(thd.TenantID())
+	tS.alMux.Unlock()
+	return
+}
+
+// V1AcknowledgeThreshold acknowledges the alert of a threshold, stopping its escalation
+func (tS *ThresholdService) V1AcknowledgeThreshold(ctx *context.Context, tntID *utils.TenantID, rply *string) (err error) {
+	if err = tS.updateAlert(tntID, func(t *Threshold, now time.Time) {
+		t.AlertState = utils.MetaAcknowledged
+		t.AlertTime = now
+	}); err != nil {
+		return
+	}
+	*rply = utils.OK
+	return
+}
+
+// V1SnoozeThreshold snoozes the alert of a threshold, the alert fires again
+// once the snooze expires if it was not resolved in the meantime
+func (tS *ThresholdService) V1SnoozeThreshold(ctx *context.Context, args *ThresholdSnoozeArgs, rply *string) (err error) {
+	if args.TenantIDWithAPIOpts == nil || args.TenantID == nil {
+		return utils.NewErrMandatoryIeMissing(utils.ID)
+	}
+	if args.Until.IsZero() {
+		return utils.NewErrMandatoryIeMissing("Until")
+	}
+	if err = tS.updateAlert(args.TenantID, func(t *Threshold, now time.Time) {
+		t.AlertState = utils.MetaSnoozed
+		t.AlertTime = now
+		t.Snooze = args.Until
+	}); err != nil {
+		return
+	}
+	*rply = utils.OK
+	return
+}
+
+// V1ResolveThreshold resolves the alert of a threshold
+func (tS *ThresholdService) V1ResolveThreshold(ctx *context.Context, tntID *utils.TenantID, rply *string) (err error) {
+	if err = tS.updateAlert(tntID, resolveAlert); err != nil {
+		return
+	}
+	*rply = utils.OK
+	return
+}
+
 // BiRPCv1RegisterInternalBiJSONConn will register the internal BiRPC connection towards ThresholdS
 func (tS *ThresholdService) BiRPCv1RegisterInternalBiJSONConn(ctx *context.Context,
 	connID string, reply *string) error {
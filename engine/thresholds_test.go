@@ -856,6 +856,7 @@ func TestThresholdsProcessEventStoreThOK(t *testing.T) {
 		rcv.tPrfl = nil
 		rcv.dirty = nil
 		rcv.Snooze = time.Time{}
+		rcv.LastHit = time.Time{}
 		if !reflect.DeepEqual(rcv, exp) {
 			t.Errorf("expected: <%+v>, \nreceived: <%+v>", exp, rcv)
 		}
@@ -1570,11 +1571,14 @@ func TestThresholdsReload(t *testing.T) {
 	cfg := config.NewDefaultCGRConfig()
 	cfg.ThresholdSCfg().StoreInterval = 5 * time.Millisecond
 	tS := &ThresholdService{
-		stopBackup:  make(chan struct{}),
-		loopStopped: make(chan struct{}, 1),
-		cgrcfg:      cfg,
+		stopBackup:    make(chan struct{}),
+		loopStopped:   make(chan struct{}, 1),
+		stopAlerts:    make(chan struct{}),
+		alertsStopped: make(chan struct{}, 1),
+		cgrcfg:        cfg,
 	}
 	tS.loopStopped <- struct{}{}
+	tS.alertsStopped <- struct{}{}
 	tS.Reload()
 	close(tS.stopBackup)
 	select {
@@ -1582,6 +1586,11 @@ func TestThresholdsReload(t *testing.T) {
 	case <-time.After(time.Second):
 		t.Error("timed out waiting for loop to stop")
 	}
+	select {
+	case <-tS.alertsStopped:
+	case <-time.After(time.Second):
+		t.Error("timed out waiting for alerts loop to stop")
+	}
 }
 
 func TestThresholdsStartLoop(t *testing.T) {
@@ -2358,3 +2367,101 @@ func TestThresholdClone(t *testing.T) {
 		})
 	}
 }
+
+func TestThresholdsAlertLifecycle(t *testing.T) {
+	cfg := config.NewDefaultCGRConfig()
+	cfg.ThresholdSCfg().StoreInterval = -1
+	data, dErr := NewInternalDB(nil, nil, true, nil, cfg.DataDbCfg().Items)
+	if dErr != nil {
+		t.Fatal(dErr)
+	}
+	dm := NewDataManager(data, cfg.CacheCfg(), nil)
+	tS := NewThresholdService(dm, cfg, NewFilterS(cfg, nil, dm), nil)
+	if err := dm.SetThresholdProfile(&ThresholdProfile{
+		Tenant:    "cgrates.org",
+		ID:        "TH_ALERT",
+		FilterIDs: []string{"*string:~*req.Account:1001"},
+		MaxHits:   -1,
+		EeIDs:     []string{utils.MetaNone},
+		Escalations: []*ThresholdEscalation{
+			{Delay: time.Minute, EeIDs: []string{utils.MetaNone}},
+			{Delay: 10 * time.Minute, EeIDs: []string{utils.MetaNone}},
+		},
+		AutoResolve: 3 * time.Hour,
+	}, true); err != nil {
+		t.Fatal(err)
+	}
+	args := &utils.CGREvent{
+		Tenant: "cgrates.org",
+		ID:     "ThdAlert",
+		Event: map[string]any{
+			utils.AccountField: "1001",
+		},
+	}
+	tntID := &utils.TenantID{Tenant: "cgrates.org", ID: "TH_ALERT"}
+	checkAlert := func(state string, level int) {
+		t.Helper()
+		var th Threshold
+		if err := tS.V1GetThreshold(context.Background(), tntID, &th); err != nil {
+			t.Fatal(err)
+		}
+		if th.AlertState != state || th.EscalationLevel != level {
+			t.Errorf("expected alert %s at level %d, received %s at level %d",
+				state, level, th.AlertState, th.EscalationLevel)
+		}
+	}
+	if _, err := tS.processEvent(args.Tenant, args); err != nil {
+		t.Fatal(err)
+	}
+	checkAlert(utils.MetaFiring, 0)
+	now := time.Now()
+	tS.processAlerts(now.Add(2 * time.Minute))
+	checkAlert(utils.MetaFiring, 1)
+
+	// acknowledged alerts are not escalated anymore
+	var rply string
+	if err := tS.V1AcknowledgeThreshold(context.Background(), tntID, &rply); err != nil {
+		t.Fatal(err)
+	}
+	tS.processAlerts(now.Add(20 * time.Minute))
+	checkAlert(utils.MetaAcknowledged, 1)
+	tS.processAlerts(now.Add(4 * time.Hour))
+	checkAlert(utils.MetaResolved, 1)
+	if tS.alertTdIDs.Has(tntID.TenantID()) {
+		t.Error("expected the resolved alert to not be tracked anymore")
+	}
+
+	// a new hit fires the alert again, snoozing it postpones the escalation
+	if _, err := tS.processEvent(args.Tenant, args); err != nil {
+		t.Fatal(err)
+	}
+	checkAlert(utils.MetaFiring, 0)
+	if err := tS.V1SnoozeThreshold(context.Background(), &ThresholdSnoozeArgs{
+		TenantIDWithAPIOpts: &utils.TenantIDWithAPIOpts{TenantID: tntID},
+	}, &rply); err == nil || err.Error() != utils.NewErrMandatoryIeMissing("Until").Error() {
+		t.Errorf("expected mandatory Until error, received %v", err)
+	}
+	if err := tS.V1SnoozeThreshold(context.Background(), &ThresholdSnoozeArgs{
+		TenantIDWithAPIOpts: &utils.TenantIDWithAPIOpts{TenantID: tntID},
+		Until:               now.Add(time.Hour),
+	}, &rply); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tS.processEvent(args.Tenant, args); err != nil {
+		t.Fatal(err)
+	}
+	tS.processAlerts(now.Add(30 * time.Minute))
+	checkAlert(utils.MetaSnoozed, 0)
+	tS.processAlerts(now.Add(90 * time.Minute))
+	checkAlert(utils.MetaFiring, 0)
+	tS.processAlerts(now.Add(95 * time.Minute))
+	checkAlert(utils.MetaFiring, 1)
+
+	if err := tS.V1ResolveThreshold(context.Background(), tntID, &rply); err != nil {
+		t.Fatal(err)
+	}
+	checkAlert(utils.MetaResolved, 1)
+	if err := tS.V1ResolveThreshold(context.Background(), tntID, &rply); err != utils.ErrNoActiveAlert {
+		t.Errorf("expected %v, received %v", utils.ErrNoActiveAlert, err)
+	}
+}
@@ -42,6 +42,7 @@ type TimeSpan struct {
 	MatchedPrefix  string
 	MatchedDestId  string
 	RatingPlanId   string
+	Currency       string `json:",omitempty"` // currency of the rating plan
 	CompressFactor int
 	ratingInfo     *RatingInfo
 }
@@ -84,6 +85,7 @@ type MonetaryInfo struct {
 	ID           string
 	Value        float64
 	RateInterval *RateInterval
+	ExchangeRate float64 `json:",omitempty"` // converting the cost into the currency of the balance
 }
 
 func (mi *MonetaryInfo) Clone() *MonetaryInfo {
@@ -99,6 +101,7 @@ func (mi *MonetaryInfo) Equal(other *MonetaryInfo) bool {
 		return false
 	}
 	return mi.UUID == other.UUID &&
+		mi.ExchangeRate == other.ExchangeRate &&
 		reflect.DeepEqual(mi.RateInterval, other.RateInterval)
 }
 
@@ -463,6 +466,7 @@ func (ts *TimeSpan) setRatingInfo(rp *RatingInfo) {
 	ts.MatchedPrefix = rp.MatchedPrefix
 	ts.MatchedDestId = rp.MatchedDestId
 	ts.RatingPlanId = rp.RatingPlanId
+	ts.Currency = rp.Currency
 }
 
 func (ts *TimeSpan) createIncrementsSlice() {
@@ -776,7 +780,8 @@ func (ts *TimeSpan) Equal(other *TimeSpan) bool {
 		ts.MatchedSubject == other.MatchedSubject &&
 		ts.MatchedPrefix == other.MatchedPrefix &&
 		ts.MatchedDestId == other.MatchedDestId &&
-		ts.RatingPlanId == other.RatingPlanId
+		ts.RatingPlanId == other.RatingPlanId &&
+		ts.Currency == other.Currency
 }
 
 // Estimate if they share charging signature
@@ -787,7 +792,8 @@ func (ts *TimeSpan) SharingSignature(other *TimeSpan) bool {
 		ts.MatchedSubject != other.MatchedSubject ||
 		ts.MatchedPrefix != other.MatchedPrefix ||
 		ts.MatchedDestId != other.MatchedDestId ||
-		ts.RatingPlanId != other.RatingPlanId {
+		ts.RatingPlanId != other.RatingPlanId ||
+		ts.Currency != other.Currency {
 		return false
 	}
 	return true
@@ -212,9 +212,10 @@ func (tpr *TpReader) LoadRatingPlansFiltered(tag string) (bool, error) {
 	}
 
 	bindings := MapTPRatingPlanBindings(mpRpls)
+	currencies := MapTPRatingPlanCurrencies(mpRpls)
 
 	for tag, rplBnds := range bindings {
-		ratingPlan := &RatingPlan{Id: tag}
+		ratingPlan := &RatingPlan{Id: tag, Currency: currencies[tag]}
 		for _, rp := range rplBnds {
 			tm := tpr.timings
 			_, exists := tpr.timings[rp.TimingId]
@@ -291,6 +292,7 @@ func (tpr *TpReader) LoadRatingPlans() (err error) {
 		return err
 	}
 	bindings := MapTPRatingPlanBindings(tps)
+	currencies := MapTPRatingPlanCurrencies(tps)
 	for tag, rplBnds := range bindings {
 		for _, rplBnd := range rplBnds {
 			t, exists := tpr.timings[rplBnd.TimingId]
@@ -304,7 +306,7 @@ func (tpr *TpReader) LoadRatingPlans() (err error) {
 			}
 			plan, exists := tpr.ratingPlans[tag]
 			if !exists {
-				plan = &RatingPlan{Id: tag}
+				plan = &RatingPlan{Id: tag, Currency: currencies[tag]}
 				tpr.ratingPlans[plan.Id] = plan
 			}
 			for _, dr := range drs.DestinationRates {
@@ -562,6 +564,12 @@ func (tpr *TpReader) LoadActionPlans() (err error) {
 				return fmt.Errorf("[ActionPlans] Could not load the action for tag: %q", at.ActionsId)
 			}
 			t, exists := tpr.timings[at.TimingId]
+			if !exists && isCronTiming(at.TimingId) {
+				if _, _, err = parseCronTiming(at.TimingId); err != nil {
+					return fmt.Errorf("[ActionPlans] Invalid cron timing %q: %s", at.TimingId, err.Error())
+				}
+				t, exists = &utils.TPTiming{ID: at.TimingId}, true
+			}
 			if !exists {
 				return fmt.Errorf("[ActionPlans] Could not load the timing for tag: %q", at.TimingId)
 			}
@@ -728,7 +736,12 @@ func (tpr *TpReader) LoadAccountActionsFiltered(qriedAA *utils.TPAccountActions)
 					return fmt.Errorf("no action with id %q", at.ActionsId)
 				}
 				var t *utils.TPTiming
-				if at.TimingId != utils.MetaASAP {
+				if isCronTiming(at.TimingId) {
+					if _, _, err = parseCronTiming(at.TimingId); err != nil {
+						return fmt.Errorf("invalid cron timing %q: %s", at.TimingId, err.Error())
+					}
+					t = &utils.TPTiming{ID: at.TimingId}
+				} else if at.TimingId != utils.MetaASAP {
 					tptm, err := tpr.lr.GetTPTimings(tpr.tpid, at.TimingId)
 					if err != nil {
 						return errors.New(err.Error() + " (Timing): " + at.TimingId)
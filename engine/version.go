@@ -44,6 +44,8 @@ var (
 		utils.CostDetails:   "cgr-migrator -exec=*cost_details",
 		utils.SessionSCosts: "cgr-migrator -exec=*sessions_costs",
 		utils.CDRs:          "cgr-migrator -exec=*cdrs",
+		utils.TpRatingPlans: "cgr-migrator -exec=*tp_rating_plans",
+		utils.TpChargers:    "cgr-migrator -exec=*tp_chargers",
 	}
 	allVers map[string]string // init will fill this with a merge of data+stor
 )
@@ -178,7 +180,7 @@ func CurrentStorDBVersions() Versions {
 		utils.CostDetails:        2,
 		utils.SessionSCosts:      3,
 		utils.CDRs:               2,
-		utils.TpRatingPlans:      1,
+		utils.TpRatingPlans:      2,
 		utils.TpFilters:          1,
 		utils.TpDestinationRates: 1,
 		utils.TpActionTriggers:   1,
@@ -199,7 +201,7 @@ func CurrentStorDBVersions() Versions {
 		utils.TpDestinations:     1,
 		utils.TpRatingPlan:       1,
 		utils.TpRatingProfile:    1,
-		utils.TpChargers:         1,
+		utils.TpChargers:         2,
 		utils.TpDispatchers:      1,
 	}
 }
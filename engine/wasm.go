@@ -0,0 +1,276 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/utils"
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/api"
+	"github.com/tetratelabs/wazero/imports/wasi_snapshot_preview1"
+)
+
+func init() {
+	utils.RegisterDataConverter(utils.MetaWasm, NewWasmConverter)
+}
+
+const (
+	wasmAllocFunc   = "alloc"   // exported by the modules to allocate the input within their memory
+	wasmDefaultFunc = "convert" // called when the function is not specified
+	wasmPagesPerMiB = 16        // the wasm memory pages have 64KiB
+)
+
+// wasmModules caches the compiled modules, indexed by their path
+var (
+	wasmModules   = make(map[string]*wasmModule)
+	wasmModulesMu sync.Mutex
+)
+
+// wasmModuleKey identifies the build of a cached module, which is recompiled once the file
+// or the memory limit changes
+type wasmModuleKey struct {
+	modTime  time.Time
+	size     int64
+	memLimit int
+}
+
+// wasmModule is a compiled WebAssembly module together with its runtime
+type wasmModule struct {
+	key   wasmModuleKey
+	rt    wazero.Runtime
+	cm    wazero.CompiledModule
+	inUse sync.WaitGroup // calls running on the module, waited before closing the replaced ones
+}
+
+// release marks the end of the call started on the module returned by getWasmModule
+func (wm *wasmModule) release() {
+	wm.inUse.Done()
+}
+
+// getWasmModule returns the module out of the file at path, compiling it on first use or after
+// the file changes, with the memory limit out of the general config (0 for the 4GiB wasm maximum),
+// the module needing to be released after use
+func getWasmModule(path string) (wm *wasmModule, err error) {
+	var fi os.FileInfo
+	if fi, err = os.Stat(path); err != nil {
+		return
+	}
+	key := wasmModuleKey{
+		modTime:  fi.ModTime(),
+		size:     fi.Size(),
+		memLimit: config.CgrConfig().GeneralCfg().WasmMemoryLimit,
+	}
+	wasmModulesMu.Lock()
+	if wm, has := wasmModules[path]; has && wm.key == key {
+		wm.inUse.Add(1)
+		wasmModulesMu.Unlock()
+		return wm, nil
+	}
+	wasmModulesMu.Unlock()
+	if wm, err = compileWasmModule(path, key); err != nil { // outside the lock since it can take a while
+		return
+	}
+	wasmModulesMu.Lock()
+	defer wasmModulesMu.Unlock()
+	old, has := wasmModules[path]
+	if has && old.key == key { // compiled meanwhile by another call
+		wm.rt.Close(context.Background())
+		old.inUse.Add(1)
+		return old, nil
+	}
+	wasmModules[path] = wm
+	wm.inUse.Add(1)
+	if has {
+		go func() {
+			old.inUse.Wait()
+			old.rt.Close(context.Background())
+		}()
+	}
+	return
+}
+
+// compileWasmModule reads and compiles the module within a new runtime
+func compileWasmModule(path string, key wasmModuleKey) (wm *wasmModule, err error) {
+	var bin []byte
+	if bin, err = os.ReadFile(path); err != nil {
+		return
+	}
+	ctx := context.Background()
+	rtCfg := wazero.NewRuntimeConfig().WithCloseOnContextDone(true)
+	if key.memLimit > 0 {
+		rtCfg = rtCfg.WithMemoryLimitPages(uint32(key.memLimit * wasmPagesPerMiB))
+	}
+	rt := wazero.NewRuntimeWithConfig(ctx, rtCfg)
+	// WASI is needed by the modules built with the usual toolchains, without access to the host filesystem or env
+	if _, err = wasi_snapshot_preview1.Instantiate(ctx, rt); err != nil {
+		rt.Close(ctx)
+		return
+	}
+	var cm wazero.CompiledModule
+	if cm, err = rt.CompileModule(ctx, bin); err != nil {
+		rt.Close(ctx)
+		return nil, fmt.Errorf("cannot compile wasm module <%s>: %w", path, err)
+	}
+	return &wasmModule{key: key, rt: rt, cm: cm}, nil
+}
+
+// call runs the function within a new instance of the module, passing it the value and the event
+// and returning the string found in the module memory at the (ptr<<32 | len) it returns
+func (wm *wasmModule) call(fnName, val string, ev []byte) (out string, err error) {
+	ctx := context.Background()
+	if tout := config.CgrConfig().GeneralCfg().WasmTimeout; tout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, tout)
+		defer cancel()
+	}
+	mod, err := wm.rt.InstantiateModule(ctx, wm.cm,
+		wazero.NewModuleConfig().WithName(utils.EmptyString).WithStartFunctions("_initialize"))
+	if err != nil {
+		return
+	}
+	defer mod.Close(context.Background())
+	fn := mod.ExportedFunction(fnName)
+	if fn == nil {
+		return utils.EmptyString, fmt.Errorf("wasm function <%s> not exported", fnName)
+	}
+	var valPtr, evPtr uint32
+	if valPtr, err = wasmWrite(ctx, mod, []byte(val)); err != nil {
+		return
+	}
+	if evPtr, err = wasmWrite(ctx, mod, ev); err != nil {
+		return
+	}
+	var res []uint64
+	if res, err = fn.Call(ctx, uint64(valPtr), uint64(len(val)),
+		uint64(evPtr), uint64(len(ev))); err != nil {
+		return
+	}
+	if len(res) != 1 {
+		return utils.EmptyString, fmt.Errorf("wasm function <%s> returned %d results instead of 1", fnName, len(res))
+	}
+	outBytes, ok := mod.Memory().Read(uint32(res[0]>>32), uint32(res[0]))
+	if !ok {
+		return utils.EmptyString, fmt.Errorf("wasm function <%s> returned out of memory range result", fnName)
+	}
+	return string(outBytes), nil
+}
+
+// wasmWrite copies the data within the module memory, allocated via its alloc function
+func wasmWrite(ctx context.Context, mod api.Module, data []byte) (ptr uint32, err error) {
+	if len(data) == 0 {
+		return
+	}
+	alloc := mod.ExportedFunction(wasmAllocFunc)
+	if alloc == nil {
+		return 0, fmt.Errorf("wasm function <%s> not exported", wasmAllocFunc)
+	}
+	var res []uint64
+	if res, err = alloc.Call(ctx, uint64(len(data))); err != nil {
+		return
+	}
+	if len(res) != 1 {
+		return 0, fmt.Errorf("wasm function <%s> returned %d results instead of 1", wasmAllocFunc, len(res))
+	}
+	ptr = uint32(res[0])
+	if !mod.Memory().Write(ptr, data) {
+		return 0, fmt.Errorf("wasm function <%s> returned out of memory range pointer", wasmAllocFunc)
+	}
+	return
+}
+
+// wasmModuleFunc splits the path[:function] reference of a module function
+func wasmModuleFunc(ref string) (path, fnName string) {
+	path, fnName, _ = strings.Cut(ref, utils.InInFieldSep)
+	return path, utils.FirstNonEmpty(fnName, wasmDefaultFunc)
+}
+
+// callWasm calls the function referenced as path[:function]
+func callWasm(ref, val string, ev []byte) (string, error) {
+	path, fnName := wasmModuleFunc(ref)
+	wm, err := getWasmModule(path)
+	if err != nil {
+		return utils.EmptyString, err
+	}
+	defer wm.release()
+	return wm.call(fnName, val, ev)
+}
+
+// wasmEvent marshals the *req and *opts maps of the data provider, the ones which are not maps being skipped
+func wasmEvent(dp utils.DataProvider) ([]byte, error) {
+	ev := make(map[string]any)
+	for _, fld := range []string{utils.MetaReq, utils.MetaOpts} {
+		x, err := dp.FieldAsInterface([]string{fld})
+		if err != nil {
+			continue
+		}
+		switch x.(type) {
+		case map[string]any, utils.MapStorage:
+			ev[fld] = x
+		}
+	}
+	return json.Marshal(ev)
+}
+
+// parseWasmAttribute handles the *wasm attribute type having the value: path[:function];fieldValue
+func parseWasmAttribute(dp utils.DataProvider, value config.RSRParsers) (out any, err error) {
+	if len(value) != 2 {
+		return nil, fmt.Errorf("invalid arguments <%s> to %s",
+			utils.ToJSON(value), utils.MetaWasm)
+	}
+	var ref string
+	if ref, err = value[0].ParseDataProvider(dp); err != nil {
+		return
+	}
+	var val string
+	if val, err = value[1].ParseDataProvider(dp); err != nil {
+		return
+	}
+	var ev []byte
+	if ev, err = wasmEvent(dp); err != nil {
+		return
+	}
+	return callWasm(ref, val, ev)
+}
+
+// NewWasmConverter is the constructor of the *wasm converter having the params: path[:function]
+// the module is compiled on first conversion, after the config is loaded
+func NewWasmConverter(params string) (utils.DataConverter, error) {
+	if path, _ := wasmModuleFunc(params); path == utils.EmptyString {
+		return nil, fmt.Errorf("missing module path for %s converter", utils.MetaWasm)
+	}
+	return &WasmConverter{ref: params}, nil
+}
+
+// WasmConverter converts the value via a function of a WebAssembly module, without access to the event
+type WasmConverter struct {
+	ref string
+}
+
+// Convert implements utils.DataConverter
+func (wc *WasmConverter) Convert(in any) (any, error) {
+	return callWasm(wc.ref, utils.IfaceAsString(in), nil)
+}
@@ -0,0 +1,193 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/utils"
+)
+
+// testWasmModule is the binary of the following module:
+//
+//	(module
+//	  (memory (export "memory") 1)
+//	  (global $heap (mut i32) (i32.const 1024))
+//	  (func (export "alloc") (param i32) (result i32)
+//	    global.get $heap  global.get $heap  local.get 0  i32.add  global.set $heap)
+//	  (func (export "convert") (param i32 i32 i32 i32) (result i64)   ;; returns the value
+//	    local.get 0  i64.extend_i32_u  i64.const 32  i64.shl  local.get 1  i64.extend_i32_u  i64.or)
+//	  (func (export "event") (param i32 i32 i32 i32) (result i64)     ;; returns the event
+//	    local.get 2  i64.extend_i32_u  i64.const 32  i64.shl  local.get 3  i64.extend_i32_u  i64.or)
+//	  (func (export "loop") (param i32 i32 i32 i32) (result i64)
+//	    loop  br 0  end  i64.const 0)
+//	  (func (export "grow") (param i32 i32 i32 i32) (result i64)     ;; traps if 1000 pages cannot be grown
+//	    i32.const 1000  memory.grow  i32.const -1  i32.eq  if  unreachable  end  i64.const 0))
+var testWasmModule = []byte{
+	0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00, // magic and version
+	0x01, 0x0e, 0x02, // types
+	0x60, 0x01, 0x7f, 0x01, 0x7f,
+	0x60, 0x04, 0x7f, 0x7f, 0x7f, 0x7f, 0x01, 0x7e,
+	0x03, 0x06, 0x05, 0x00, 0x01, 0x01, 0x01, 0x01, // functions
+	0x05, 0x03, 0x01, 0x00, 0x01, // memory
+	0x06, 0x07, 0x01, 0x7f, 0x01, 0x41, 0x80, 0x08, 0x0b, // globals
+	0x07, 0x32, 0x06, // exports
+	0x06, 'm', 'e', 'm', 'o', 'r', 'y', 0x02, 0x00,
+	0x05, 'a', 'l', 'l', 'o', 'c', 0x00, 0x00,
+	0x07, 'c', 'o', 'n', 'v', 'e', 'r', 't', 0x00, 0x01,
+	0x05, 'e', 'v', 'e', 'n', 't', 0x00, 0x02,
+	0x04, 'l', 'o', 'o', 'p', 0x00, 0x03,
+	0x04, 'g', 'r', 'o', 'w', 0x00, 0x04,
+	0x0a, 0x42, 0x05, // code
+	0x0b, 0x00, 0x23, 0x00, 0x23, 0x00, 0x20, 0x00, 0x6a, 0x24, 0x00, 0x0b,
+	0x0c, 0x00, 0x20, 0x00, 0xad, 0x42, 0x20, 0x86, 0x20, 0x01, 0xad, 0x84, 0x0b,
+	0x0c, 0x00, 0x20, 0x02, 0xad, 0x42, 0x20, 0x86, 0x20, 0x03, 0xad, 0x84, 0x0b,
+	0x09, 0x00, 0x03, 0x40, 0x0c, 0x00, 0x0b, 0x42, 0x00, 0x0b,
+	0x10, 0x00, 0x41, 0xe8, 0x07, 0x40, 0x00, 0x41, 0x7f, 0x46, 0x04, 0x40, 0x00, 0x0b, 0x42, 0x00, 0x0b,
+}
+
+func TestWasmConverterAttribute(t *testing.T) {
+	tmp := config.CgrConfig()
+	defer config.SetCgrConfig(tmp)
+	cfg := config.NewDefaultCGRConfig()
+	cfg.GeneralCfg().WasmTimeout = 50 * time.Millisecond
+	config.SetCgrConfig(cfg)
+	modPath := filepath.Join(t.TempDir(), "test.wasm")
+	if err := os.WriteFile(modPath, testWasmModule, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, params := range []string{modPath, modPath + ":convert"} {
+		cnv, err := utils.NewDataConverter(utils.MetaWasm + utils.InInFieldSep + params)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if rcv, err := cnv.Convert("+4986517174963"); err != nil {
+			t.Error(err)
+		} else if rcv != "+4986517174963" {
+			t.Errorf("expected %q, received %q", "+4986517174963", rcv)
+		}
+		if rcv, err := cnv.Convert(""); err != nil {
+			t.Error(err)
+		} else if rcv != "" {
+			t.Errorf("expected empty string, received %q", rcv)
+		}
+	}
+	for _, params := range []string{
+		modPath + ":unknown",
+		modPath + ":grow",
+		filepath.Join(t.TempDir(), "missing.wasm"),
+	} {
+		cnv, err := utils.NewDataConverter(utils.MetaWasm + utils.InInFieldSep + params)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := cnv.Convert("1001"); err == nil {
+			t.Errorf("expected error converting via <%s>", params)
+		}
+	}
+	cnv, err := utils.NewDataConverter(utils.MetaWasm + utils.InInFieldSep + modPath + ":loop")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tStart := time.Now()
+	if _, err := cnv.Convert("1001"); err == nil {
+		t.Error("expected error for the call exceeding the timeout")
+	} else if dur := time.Since(tStart); dur > time.Second {
+		t.Errorf("expected the call to be interrupted after the timeout, took %v", dur)
+	}
+	if _, err = utils.NewDataConverter(utils.MetaWasm); err == nil {
+		t.Error("expected error for missing module path")
+	}
+
+	dp := utils.MapStorage{
+		utils.MetaReq:  utils.MapStorage{"CLI": "+4986517174963"},
+		utils.MetaOpts: map[string]any{"Context": "*sessions"},
+	}
+	for value, exp := range map[string]string{
+		modPath + ";~*req.CLI":       "+4986517174963",
+		modPath + ":event;~*req.CLI": `{"*opts":{"Context":"*sessions"},"*req":{"CLI":"+4986517174963"}}`,
+	} {
+		if out, err := ParseAttribute(dp, utils.MetaWasm, utils.EmptyString,
+			config.NewRSRParsersMustCompile(value, utils.InfieldSep), 0,
+			utils.EmptyString, utils.EmptyString, utils.InfieldSep); err != nil {
+			t.Error(err)
+		} else if out != exp {
+			t.Errorf("%s expected %q, received %q", value, exp, out)
+		}
+	}
+	if _, err = ParseAttribute(dp, utils.MetaWasm, utils.EmptyString,
+		config.NewRSRParsersMustCompile(modPath, utils.InfieldSep), 0,
+		utils.EmptyString, utils.EmptyString, utils.InfieldSep); err == nil {
+		t.Error("expected error for missing field value")
+	}
+}
+
+func TestWasmModuleCache(t *testing.T) {
+	tmp := config.CgrConfig()
+	defer config.SetCgrConfig(tmp)
+	cfg := config.NewDefaultCGRConfig()
+	config.SetCgrConfig(cfg)
+	modPath := filepath.Join(t.TempDir(), "test.wasm")
+	if err := os.WriteFile(modPath, testWasmModule, 0644); err != nil {
+		t.Fatal(err)
+	}
+	wm, err := getWasmModule(modPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wm.release()
+	if rcv, err := getWasmModule(modPath); err != nil {
+		t.Fatal(err)
+	} else if rcv.release(); rcv != wm {
+		t.Error("expected the cached module")
+	}
+
+	cfg.GeneralCfg().WasmMemoryLimit++
+	rcv, err := getWasmModule(modPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rcv.release()
+	if rcv == wm {
+		t.Error("expected the module recompiled for the new memory limit")
+	}
+	wm = rcv
+
+	mTime := time.Now().Add(time.Minute)
+	if err := os.Chtimes(modPath, mTime, mTime); err != nil {
+		t.Fatal(err)
+	}
+	if rcv, err = getWasmModule(modPath); err != nil {
+		t.Fatal(err)
+	}
+	rcv.release()
+	if rcv == wm {
+		t.Error("expected the module recompiled after the file changed")
+	}
+	if out, err := callWasm(modPath, "1001", nil); err != nil {
+		t.Error(err)
+	} else if out != "1001" {
+		t.Errorf("expected %q, received %q", "1001", out)
+	}
+}
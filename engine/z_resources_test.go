@@ -6164,3 +6164,100 @@ func TestResourcesMatchingResourcesForEventFinalCacheSetErr(t *testing.T) {
 		t.Error("expected struct field \"lkID\" to be empty")
 	}
 }
+
+func TestResourcesParents(t *testing.T) {
+	Cache.Clear(nil)
+	cfg := config.NewDefaultCGRConfig()
+	data, err := NewInternalDB(nil, nil, true, nil, cfg.DataDbCfg().Items)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dm := NewDataManager(data, cfg.CacheCfg(), nil)
+	for _, rsPrf := range []*ResourceProfile{
+		{Tenant: "cgrates.org", ID: "CUSTOMER", FilterIDs: []string{"*string:~*req.Account:none"},
+			ThresholdIDs: []string{utils.MetaNone}, Limit: 3, UsageTTL: -1},
+		{Tenant: "cgrates.org", ID: "TRUNK1", FilterIDs: []string{"*string:~*req.Trunk:1"},
+			ThresholdIDs: []string{utils.MetaNone}, Limit: 2, UsageTTL: -1, ParentID: "CUSTOMER"},
+		{Tenant: "cgrates.org", ID: "TRUNK2", FilterIDs: []string{"*string:~*req.Trunk:2"},
+			ThresholdIDs: []string{utils.MetaNone}, Limit: 2, UsageTTL: -1, ParentID: "CUSTOMER"},
+	} {
+		if err = dm.SetResourceProfile(rsPrf, true); err != nil {
+			t.Fatal(err)
+		}
+	}
+	rS := NewResourceService(dm, cfg, NewFilterS(cfg, nil, dm), nil)
+	newEv := func(trunk string, i int) *utils.CGREvent {
+		return &utils.CGREvent{
+			ID:    fmt.Sprintf("Ev%s%d", trunk, i),
+			Event: map[string]any{"Trunk": trunk},
+			APIOpts: map[string]any{
+				utils.OptsResourcesUsageID: fmt.Sprintf("RU%s%d", trunk, i),
+				utils.OptsResourcesUnits:   1,
+			},
+		}
+	}
+	var rs Resources
+	if err = rS.V1GetResourcesForEvent(context.Background(), newEv("1", 0), &rs); err != nil {
+		t.Fatal(err)
+	} else if len(rs) != 2 || rs[0].ID != "TRUNK1" || rs[1].ID != "CUSTOMER" {
+		t.Errorf("expected the chain of TRUNK1, received: %s", utils.ToJSON(rs))
+	}
+	var reply string
+	for _, ev := range []*utils.CGREvent{newEv("1", 0), newEv("1", 1), newEv("2", 0)} {
+		if err = rS.V1AllocateResources(context.Background(), ev, &reply); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// TRUNK2 has capacity but the CUSTOMER is exhausted
+	if err = rS.V1AllocateResources(context.Background(), newEv("2", 1), &reply); err != utils.ErrResourceUnavailable {
+		t.Fatalf("expected %v, received %v", utils.ErrResourceUnavailable, err)
+	}
+	var r Resource
+	if err = rS.V1GetResource(context.Background(), &utils.TenantIDWithAPIOpts{
+		TenantID: &utils.TenantID{Tenant: "cgrates.org", ID: "TRUNK2"}}, &r); err != nil {
+		t.Fatal(err)
+	} else if len(r.Usages) != 1 {
+		t.Errorf("expected the usage rolled back, received: %s", utils.ToJSON(r))
+	}
+	// releasing on TRUNK1 frees the CUSTOMER too
+	if err = rS.V1ReleaseResources(context.Background(), newEv("1", 0), &reply); err != nil {
+		t.Fatal(err)
+	}
+	if err = rS.V1AllocateResources(context.Background(), newEv("2", 1), &reply); err != nil {
+		t.Error(err)
+	}
+	if err = rS.V1GetResource(context.Background(), &utils.TenantIDWithAPIOpts{
+		TenantID: &utils.TenantID{Tenant: "cgrates.org", ID: "CUSTOMER"}}, &r); err != nil {
+		t.Fatal(err)
+	} else if len(r.Usages) != 3 {
+		t.Errorf("expected 3 usages on parent, received: %s", utils.ToJSON(r))
+	}
+
+	// the parents are locked together with the candidates, in sorted order
+	if lkIDs, err := rS.resourceLockKeys("cgrates.org", []string{"TRUNK2"}); err != nil {
+		t.Fatal(err)
+	} else if exp := []string{
+		resourceProfileLockKey("cgrates.org", "CUSTOMER"),
+		resourceProfileLockKey("cgrates.org", "TRUNK2"),
+		resourceLockKey("cgrates.org", "CUSTOMER"),
+		resourceLockKey("cgrates.org", "TRUNK2"),
+	}; !reflect.DeepEqual(exp, lkIDs) {
+		t.Errorf("expected %v, received %v", exp, lkIDs)
+	}
+
+	// cyclic parents are refused
+	if err = dm.SetResourceProfile(&ResourceProfile{Tenant: "cgrates.org", ID: "CUSTOMER",
+		FilterIDs: []string{"*string:~*req.Account:none"}, ThresholdIDs: []string{utils.MetaNone},
+		Limit: 3, UsageTTL: -1, ParentID: "TRUNK1"}, true); err != nil {
+		t.Fatal(err)
+	}
+	Cache.Clear(nil)
+	if err = rS.V1AllocateResources(context.Background(), newEv("1", 5), &reply); err == nil {
+		t.Error("expected error for cyclic parents")
+	}
+	// the locks are released on errors
+	if err = rS.V1GetResource(context.Background(), &utils.TenantIDWithAPIOpts{
+		TenantID: &utils.TenantID{Tenant: "cgrates.org", ID: "TRUNK1"}}, &r); err != nil {
+		t.Error(err)
+	}
+}
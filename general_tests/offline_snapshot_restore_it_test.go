@@ -56,7 +56,7 @@ func TestOfflineInternalSnapshotAndRestore(t *testing.T) { // run with sudo
 	for i, pth := range paths {
 		dataDBDirNames := []string{"*account_action_plans", "*accounts", "*action_plans", "*action_triggers", "*actions", "*attribute_filter_indexes", "*attribute_profiles", "*charger_filter_indexes", "*charger_profiles", "*default", "*destinations", "*dispatcher_filter_indexes", "*dispatcher_hosts", "*dispatcher_profiles", "*filters", "*ip_allocations", "*ip_filter_indexes", "*ip_profiles", "*load_ids", "*ranking_profiles", "*rankings", "*rating_plans", "*rating_profiles", "*resource_filter_indexes", "*resource_profiles", "*resources", "*reverse_destinations", "*reverse_filter_indexes", "*route_filter_indexes", "*route_profiles", "*sessions_backup", "*shared_groups", "*stat_filter_indexes", "*statqueue_profiles", "*statqueues", "*threshold_filter_indexes", "*threshold_profiles", "*thresholds", "*timings", "*trend_profiles", "*trends", "*versions", "datadb"}
 		slices.Sort(dataDBDirNames)
-		storDBDirNames := []string{"*cdrs", "*default", "*session_costs", "*tp_account_actions", "*tp_action_plans", "*tp_action_triggers", "*tp_actions", "*tp_attributes", "*tp_chargers", "*tp_destination_rates", "*tp_destinations", "*tp_dispatcher_hosts", "*tp_dispatcher_profiles", "*tp_filters", "*tp_ips", "*tp_rankings", "*tp_rates", "*tp_rating_plans", "*tp_rating_profiles", "*tp_resources", "*tp_routes", "*tp_shared_groups", "*tp_stats", "*tp_thresholds", "*tp_timings", "*tp_trends", "*versions", "stordb"}
+		storDBDirNames := []string{"*balance_journal", "*cdrs", "*default", "*invoices", "*session_costs", "*tp_account_actions", "*tp_action_plans", "*tp_action_triggers", "*tp_actions", "*tp_attributes", "*tp_chargers", "*tp_destination_rates", "*tp_destinations", "*tp_dispatcher_hosts", "*tp_dispatcher_profiles", "*tp_filters", "*tp_ips", "*tp_rankings", "*tp_rates", "*tp_rating_plans", "*tp_rating_profiles", "*tp_resources", "*tp_routes", "*tp_shared_groups", "*tp_stats", "*tp_thresholds", "*tp_timings", "*tp_trends", "*versions", "stordb"}
 		slices.Sort(storDBDirNames)
 		dfltCfg := config.NewDefaultCGRConfig()
 		if err := os.MkdirAll(dfltCfg.DataDbCfg().Opts.InternalDBDumpPath, 0755); err != nil {
@@ -63,6 +63,12 @@ func (iDBMig *internalStorDBMigrator) renameV1SMCosts() (err error) {
 	return utils.ErrNotImplemented
 }
 
+// TP methods
+// the documents have no schema so there are no columns to add
+func (iDBMig *internalStorDBMigrator) addTPColumns(mdl any, fldNames ...string) (err error) {
+	return
+}
+
 func (iDBMig *internalStorDBMigrator) createV1SMCosts() (err error) {
 	return utils.ErrNotImplemented
 }
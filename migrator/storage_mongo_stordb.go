@@ -90,6 +90,12 @@ func (v1ms *mongoStorDBMigrator) renameV1SMCosts() (err error) {
 		bson.D{{Key: "create", Value: utils.SessionCostsTBL}}).Err()
 }
 
+// TP methods
+// the documents have no schema so there are no columns to add
+func (v1ms *mongoStorDBMigrator) addTPColumns(mdl any, fldNames ...string) (err error) {
+	return
+}
+
 func (v1ms *mongoStorDBMigrator) createV1SMCosts() (err error) {
 	v1ms.mgoDB.DB().Collection(utils.OldSMCosts).Drop(v1ms.mgoDB.GetContext())
 	v1ms.mgoDB.DB().Collection(utils.SessionCostsTBL).Drop(v1ms.mgoDB.GetContext())
@@ -106,6 +106,20 @@ func (mgSQL *migratorSQL) renameV1SMCosts() (err error) {
 	return
 }
 
+// addTPColumns adds the optional columns introduced after the table was created
+func (mgSQL *migratorSQL) addTPColumns(mdl any, fldNames ...string) (err error) {
+	mgr := mgSQL.sqlStorage.ExportGormDB().Migrator()
+	for _, fldName := range fldNames {
+		if mgr.HasColumn(mdl, fldName) {
+			continue
+		}
+		if err = mgr.AddColumn(mdl, fldName); err != nil {
+			return
+		}
+	}
+	return
+}
+
 func (mgSQL *migratorSQL) createV1SMCosts() (err error) {
 	qry := "CREATE TABLE sm_costs (  id int(11) NOT NULL AUTO_INCREMENT,  cgrid varchar(40) NOT NULL,  run_id  varchar(64) NOT NULL,  origin_host varchar(64) NOT NULL,  origin_id varchar(128) NOT NULL,  cost_source varchar(64) NOT NULL,  `usage` BIGINT NOT NULL,  cost_details MEDIUMTEXT,  created_at TIMESTAMP NULL,deleted_at TIMESTAMP NULL,  PRIMARY KEY (`id`),UNIQUE KEY costid (cgrid, run_id),KEY origin_idx (origin_host, origin_id),KEY run_origin_idx (run_id, origin_id),KEY deleted_at_idx (deleted_at));"
 	if mgSQL.StorDB().GetStorageType() == utils.MetaPostgres {
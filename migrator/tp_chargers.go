@@ -65,6 +65,21 @@ func (m *Migrator) migrateTPChargers() (err error) {
 		return
 	}
 	switch vrs[utils.TpChargers] {
+	case 1: // ShareType and Share columns added
+		if m.dryRun {
+			break
+		}
+		if err = m.storDBOut.addTPColumns(&engine.ChargerMdl{}, "ShareType", "Share"); err != nil {
+			return
+		}
+		if !m.sameStorDB {
+			if err = m.migrateCurrentTPChargers(); err != nil {
+				return
+			}
+		}
+		if err = m.setVersions(utils.TpChargers); err != nil {
+			return
+		}
 	case current[utils.TpChargers]:
 		if m.sameStorDB {
 			break
@@ -65,6 +65,21 @@ func (m *Migrator) migrateTPratingplans() (err error) {
 		return
 	}
 	switch vrs[utils.TpRatingPlans] {
+	case 1: // Currency column added
+		if m.dryRun {
+			break
+		}
+		if err = m.storDBOut.addTPColumns(&engine.RatingPlanMdl{}, "Currency"); err != nil {
+			return
+		}
+		if !m.sameStorDB {
+			if err = m.migrateCurrentTPratingplans(); err != nil {
+				return
+			}
+		}
+		if err = m.setVersions(utils.TpRatingPlans); err != nil {
+			return
+		}
 	case current[utils.TpRatingPlans]:
 		if m.sameStorDB {
 			break
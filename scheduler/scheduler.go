@@ -42,6 +42,9 @@ type Scheduler struct {
 	actStatsInterval                time.Duration                 // How long time to keep the stats in memory
 	aSMux, aFMux                    sync.RWMutex                  // protect schedStats
 	actSuccessStats, actFailedStats map[string]map[time.Time]bool // keep here stats regarding executed actions, map[actionType]map[execTime]bool
+	lMux                            sync.RWMutex                  // protects leader, serializes the lease renewals
+	leader                          bool                          // runs the ActionPlans, always true without leader election
+	stopLease                       chan struct{}
 }
 
 func NewScheduler(dm *engine.DataManager, cfg *config.CGRConfig,
@@ -51,6 +54,7 @@ func NewScheduler(dm *engine.DataManager, cfg *config.CGRConfig,
 		dm:          dm,
 		cfg:         cfg,
 		fltrS:       fltrS,
+		leader:      !cfg.SchedulerCfg().LeaderElection,
 	}
 	s.Reload()
 	return
@@ -88,12 +92,16 @@ func (s *Scheduler) updateActStats(act *engine.Action, isFailed bool) {
 func (s *Scheduler) Loop() {
 	s.Lock()
 	s.started = true
+	if s.cfg.SchedulerCfg().LeaderElection {
+		s.stopLease = make(chan struct{})
+		go s.leaseLoop(s.stopLease)
+	}
 	s.Unlock()
 	for {
 		if !s.isRunning() { // shutdown requested
 			break
 		}
-		for s.isQueueEmpty() { //hang here if empty
+		for s.isQueueEmpty() || !s.isLeader() { //hang here if empty or standing by
 			<-s.restartLoop
 		}
 		utils.Logger.Info(fmt.Sprintf("<Scheduler> Scheduler queue length: %v", len(s.queue)))
@@ -103,6 +111,13 @@ func (s *Scheduler) Loop() {
 		now := time.Now()
 		start := a0.GetNextStartTime(now)
 		if start.Equal(now) || start.Before(now) {
+			if s.cfg.SchedulerCfg().LeaderElection {
+				// make sure we still lead before executing and record the progress for the next leader
+				if held, _, _ := s.renewLease(start); !held {
+					s.Unlock()
+					continue
+				}
+			}
 			go a0.Execute(s.fltrS, utils.SchedulerS, nil)
 			// if after execute the next start time is in the past then
 			// do not add it to the queue
@@ -142,6 +157,97 @@ func (s *Scheduler) Reload() {
 	s.restart()
 }
 
+func (s *Scheduler) isLeader() bool {
+	s.lMux.RLock()
+	defer s.lMux.RUnlock()
+	return s.leader
+}
+
+// renewLease takes or renews the lease shared by the Schedulers on the same DataDB, recording
+// the checkpoint if not zero; returns the checkpoint of the previous leader when taking over
+func (s *Scheduler) renewLease(checkpoint time.Time) (held, gained bool, lastCheckpoint time.Time) {
+	s.lMux.Lock()
+	defer s.lMux.Unlock()
+	ls, acquired, err := s.dm.AcquireLease(&engine.Lease{
+		ID:         utils.SchedulerS,
+		Holder:     s.cfg.GeneralCfg().NodeID,
+		ExpiryTime: time.Now().Add(s.cfg.SchedulerCfg().LeaseTTL),
+		Checkpoint: checkpoint,
+	})
+	if err != nil {
+		utils.Logger.Warning(fmt.Sprintf("<%s> failed renewing the lease: %s", utils.SchedulerS, err))
+	}
+	held = err == nil && acquired
+	if held == s.leader {
+		return
+	}
+	s.leader = held
+	if !held {
+		utils.Logger.Warning(fmt.Sprintf("<%s> lost the lease, standing by", utils.SchedulerS))
+		return
+	}
+	utils.Logger.Info(fmt.Sprintf("<%s> elected as leader", utils.SchedulerS))
+	return true, true, ls.Checkpoint
+}
+
+// leaseLoop keeps the lease renewed, taking over the ActionPlans once elected
+func (s *Scheduler) leaseLoop(stop chan struct{}) {
+	ttl := s.cfg.SchedulerCfg().LeaseTTL
+	tkr := time.NewTicker(ttl / 3)
+	defer tkr.Stop()
+	for {
+		wasLeader := s.isLeader()
+		if held, gained, lastCheckpoint := s.renewLease(time.Time{}); gained {
+			s.Reload()
+			s.catchUp(lastCheckpoint)
+		} else if wasLeader && !held {
+			s.restart()
+		}
+		select {
+		case <-stop:
+			s.releaseLease()
+			return
+		case <-tkr.C:
+		}
+	}
+}
+
+// releaseLease frees the lease on shutdown so a standby can take over without waiting for its expiry
+func (s *Scheduler) releaseLease() {
+	s.lMux.Lock()
+	defer s.lMux.Unlock()
+	if !s.leader {
+		return
+	}
+	s.leader = false
+	if _, _, err := s.dm.AcquireLease(&engine.Lease{
+		ID:         utils.SchedulerS,
+		Holder:     s.cfg.GeneralCfg().NodeID,
+		ExpiryTime: time.Now(),
+	}); err != nil {
+		utils.Logger.Warning(fmt.Sprintf("<%s> failed releasing the lease: %s", utils.SchedulerS, err))
+	}
+}
+
+// catchUp executes once the ActionTimings which became due after the
+// checkpoint of the previous leader and before we took over
+func (s *Scheduler) catchUp(checkpoint time.Time) {
+	if checkpoint.IsZero() {
+		return
+	}
+	now := time.Now()
+	s.RLock()
+	defer s.RUnlock()
+	for _, at := range s.queue {
+		if due := at.GetNextStartTimes(checkpoint, 1); len(due) == 0 || due[0].After(now) {
+			continue
+		}
+		utils.Logger.Info(fmt.Sprintf("<%s> executing action <%s> of action plan <%s> missed during the leader change",
+			utils.SchedulerS, at.ActionsID, at.GetActionPlanID()))
+		go at.Execute(s.fltrS, utils.SchedulerS, nil)
+	}
+}
+
 // loadTasks loads the tasks
 // this will push the tasks that did not match
 // the filters before exiting the function
@@ -191,7 +297,9 @@ func (s *Scheduler) loadTasks() {
 func (s *Scheduler) loadActionPlans() {
 	s.Lock()
 	defer s.Unlock()
-	s.loadTasks()
+	if s.isLeader() { // the tasks are executed only by the leader
+		s.loadTasks()
+	}
 
 	actionPlans, err := s.dm.GetAllActionPlans()
 	if err != nil && err != utils.ErrNotFound {
@@ -261,12 +369,14 @@ func (s *Scheduler) isQueueEmpty() bool {
 type ArgsGetScheduledActions struct {
 	Tenant, Account    *string
 	TimeStart, TimeEnd *time.Time // Filter based on next runTime
+	NextRuns           int        // Number of upcoming run times to compute for each action
 	utils.Paginator
 }
 
 type ScheduledAction struct {
 	NextRunTime                               time.Time
-	Accounts                                  int // Number of acccounts this action will run on
+	NextRunTimes                              []time.Time `json:",omitempty"` // Upcoming run times, populated on NextRuns
+	Accounts                                  int         // Number of acccounts this action will run on
 	ActionPlanID, ActionTimingUUID, ActionsID string
 }
 
@@ -302,6 +412,9 @@ func (s *Scheduler) GetScheduledActions(fltr ArgsGetScheduledActions) (schedActi
 				continue
 			}
 		}
+		if fltr.NextRuns > 0 {
+			sas.NextRunTimes = at.GetNextStartTimes(time.Now(), fltr.NextRuns)
+		}
 		schedActions = append(schedActions, sas)
 	}
 	if fltr.Paginator.Offset != nil {
@@ -321,6 +434,10 @@ func (s *Scheduler) GetScheduledActions(fltr ArgsGetScheduledActions) (schedActi
 func (s *Scheduler) Shutdown() {
 	s.Lock()
 	s.started = false // disable loop on next run
+	if s.stopLease != nil {
+		close(s.stopLease)
+		s.stopLease = nil
+	}
 	s.Unlock()
 	s.restartLoop <- struct{}{} // cancel waiting tasks
 	if s.timer != nil {
@@ -21,6 +21,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/cgrates/cgrates/config"
 	"github.com/cgrates/cgrates/engine"
 	"github.com/cgrates/cgrates/utils"
 )
@@ -46,3 +47,85 @@ func TestSchedulerUpdateActStats(t *testing.T) {
 		t.Errorf("Wrong stats: %+v", sched.actSuccessStats)
 	}
 }
+
+func TestSchedulerGetScheduledActionsNextRuns(t *testing.T) {
+	tmpCfg := config.CgrConfig()
+	defer config.SetCgrConfig(tmpCfg)
+	cfg := config.NewDefaultCGRConfig()
+	cfg.GeneralCfg().DefaultTimezone = "UTC" // not depending on the timezone of the host
+	config.SetCgrConfig(cfg)
+	at := &engine.ActionTiming{
+		Uuid:      "uuid1",
+		ActionsID: "ACT_TOPUP",
+		Timing:    &engine.RateInterval{Timing: &engine.RITiming{ID: "*cron:0 0 * * *"}},
+	}
+	sched := &Scheduler{queue: engine.ActionTimingPriorityList{at}}
+	sas := sched.GetScheduledActions(ArgsGetScheduledActions{NextRuns: 3})
+	if len(sas) != 1 {
+		t.Fatalf("expected 1 scheduled action, received: %s", utils.ToJSON(sas))
+	}
+	if len(sas[0].NextRunTimes) != 3 {
+		t.Fatalf("expected 3 next runs, received: %v", sas[0].NextRunTimes)
+	}
+	if !sas[0].NextRunTimes[0].Equal(sas[0].NextRunTime) {
+		t.Errorf("expected first run at %v, received %v", sas[0].NextRunTime, sas[0].NextRunTimes[0])
+	}
+	for i, nextRun := range sas[0].NextRunTimes {
+		if !nextRun.Equal(nextRun.UTC().Truncate(24 * time.Hour)) {
+			t.Errorf("expected run at midnight UTC, received %v", nextRun)
+		}
+		if i != 0 && nextRun.Sub(sas[0].NextRunTimes[i-1]) != 24*time.Hour {
+			t.Errorf("unexpected interval between runs: %v", nextRun.Sub(sas[0].NextRunTimes[i-1]))
+		}
+	}
+	if sas = sched.GetScheduledActions(ArgsGetScheduledActions{}); sas[0].NextRunTimes != nil {
+		t.Errorf("expected no next runs, received: %v", sas[0].NextRunTimes)
+	}
+}
+
+func TestSchedulerLeaderElection(t *testing.T) {
+	dfltCfg := config.NewDefaultCGRConfig()
+	db, err := engine.NewInternalDB(nil, nil, true, nil, dfltCfg.DataDbCfg().Items)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dm := engine.NewDataManager(db, dfltCfg.CacheCfg(), nil)
+	newSched := func(nodeID string) *Scheduler {
+		cfg := config.NewDefaultCGRConfig()
+		cfg.GeneralCfg().NodeID = nodeID
+		cfg.SchedulerCfg().LeaderElection = true
+		cfg.SchedulerCfg().LeaseTTL = 50 * time.Millisecond
+		return &Scheduler{dm: dm, cfg: cfg, leader: !cfg.SchedulerCfg().LeaderElection}
+	}
+	s1, s2 := newSched("node1"), newSched("node2")
+	if held, gained, _ := s1.renewLease(time.Time{}); !held || !gained {
+		t.Fatalf("expected node1 to be elected, held: %v, gained: %v", held, gained)
+	}
+	if held, _, _ := s2.renewLease(time.Time{}); held || s2.isLeader() {
+		t.Fatal("expected node2 to stand by")
+	}
+	checkpoint := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	if held, gained, _ := s1.renewLease(checkpoint); !held || gained {
+		t.Fatalf("expected node1 to keep leading, held: %v, gained: %v", held, gained)
+	}
+
+	// node1 stops renewing, node2 takes over after the lease expires
+	time.Sleep(60 * time.Millisecond)
+	if held, gained, lastCheckpoint := s2.renewLease(time.Time{}); !held || !gained {
+		t.Fatalf("expected node2 to be elected, held: %v, gained: %v", held, gained)
+	} else if !lastCheckpoint.Equal(checkpoint) {
+		t.Errorf("expected checkpoint %v, received %v", checkpoint, lastCheckpoint)
+	}
+	if held, _, _ := s1.renewLease(time.Time{}); held || s1.isLeader() {
+		t.Fatal("expected node1 to stand by")
+	}
+
+	// releasing on shutdown lets node1 take over right away
+	s2.releaseLease()
+	if s2.isLeader() {
+		t.Error("expected node2 to release the lease")
+	}
+	if held, gained, _ := s1.renewLease(time.Time{}); !held || !gained {
+		t.Fatalf("expected node1 to be elected, held: %v, gained: %v", held, gained)
+	}
+}
@@ -436,6 +436,7 @@ func RunCGREngine(args []string, hooks ...func(*config.CGRConfig) error) {
 	internalRankingSChan := make(chan birpc.ClientConnector, 1)
 	internalResourceSChan := make(chan birpc.ClientConnector, 1)
 	internalIPsChan := make(chan birpc.ClientConnector, 1)
+	internalFraudSChan := make(chan birpc.ClientConnector, 1)
 	internalRouteSChan := make(chan birpc.ClientConnector, 1)
 	internalSchedulerSChan := make(chan birpc.ClientConnector, 1)
 	internalRALsChan := make(chan birpc.ClientConnector, 1)
@@ -457,6 +458,7 @@ func RunCGREngine(args []string, hooks ...func(*config.CGRConfig) error) {
 		utils.ConcatenatedKey(utils.MetaInternal, utils.MetaGuardian):       internalGuardianSChan,
 		utils.ConcatenatedKey(utils.MetaInternal, utils.MetaResources):      internalResourceSChan,
 		utils.ConcatenatedKey(utils.MetaInternal, utils.MetaIPs):            internalIPsChan,
+		utils.ConcatenatedKey(utils.MetaInternal, utils.MetaFrauds):         internalFraudSChan,
 		utils.ConcatenatedKey(utils.MetaInternal, utils.MetaResponder):      internalResponderChan,
 		utils.ConcatenatedKey(utils.MetaInternal, utils.MetaScheduler):      internalSchedulerSChan,
 		utils.ConcatenatedKey(utils.MetaInternal, utils.MetaSessionS):       internalSessionSChan,
@@ -500,6 +502,7 @@ func RunCGREngine(args []string, hooks ...func(*config.CGRConfig) error) {
 		utils.RALService:      new(sync.WaitGroup),
 		utils.ResourceS:       new(sync.WaitGroup),
 		utils.IPs:             new(sync.WaitGroup),
+		utils.FraudS:          new(sync.WaitGroup),
 		utils.ResponderS:      new(sync.WaitGroup),
 		utils.RouteS:          new(sync.WaitGroup),
 		utils.SchedulerS:      new(sync.WaitGroup),
@@ -594,6 +597,8 @@ func RunCGREngine(args []string, hooks ...func(*config.CGRConfig) error) {
 		internalResourceSChan, connManager, anz, srvDep)
 	ips := NewIPService(cfg, dmService, cacheS, filterSChan, server,
 		internalResourceSChan, connManager, anz, srvDep)
+	frS := NewFraudService(cfg, dmService, cacheS, filterSChan, server,
+		internalFraudSChan, connManager, anz, srvDep)
 	routeS := NewRouteService(cfg, dmService, cacheS, filterSChan, server,
 		internalRouteSChan, connManager, anz, srvDep)
 
@@ -614,7 +619,7 @@ func RunCGREngine(args []string, hooks ...func(*config.CGRConfig) error) {
 
 	smg := NewSessionService(cfg, dmService, server, internalSessionSChan, connManager, anz, srvDep)
 
-	srvManager.AddServices(gvService, attrS, chrS, tS, stS, trS, rnS, reS, ips, routeS, schS, rals,
+	srvManager.AddServices(gvService, attrS, chrS, tS, stS, trS, rnS, reS, ips, frS, routeS, schS, rals,
 		apiSv1, apiSv2, cdrS, smg, coreS,
 		NewDNSAgent(cfg, filterSChan, shdChan, connManager, caps, srvDep),
 		NewFreeswitchAgent(cfg, shdChan, connManager, caps, srvDep),
@@ -655,6 +660,7 @@ func RunCGREngine(args []string, hooks ...func(*config.CGRConfig) error) {
 	engine.IntRPC.AddInternalRPCClient(utils.GuardianSv1, internalGuardianSChan)
 	engine.IntRPC.AddInternalRPCClient(utils.ResourceSv1, internalResourceSChan)
 	engine.IntRPC.AddInternalRPCClient(utils.IPsV1, internalIPsChan)
+	engine.IntRPC.AddInternalRPCClient(utils.FraudSv1, internalFraudSChan)
 	engine.IntRPC.AddInternalRPCClient(utils.Responder, internalResponderChan)
 	engine.IntRPC.AddInternalRPCClient(utils.SchedulerSv1, internalSchedulerSChan)
 	engine.IntRPC.AddInternalRPCClient(utils.SessionSv1, internalSessionSChan)
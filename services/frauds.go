@@ -0,0 +1,136 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package services
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/cgrates/birpc"
+	v1 "github.com/cgrates/cgrates/apier/v1"
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/cores"
+	"github.com/cgrates/cgrates/engine"
+	"github.com/cgrates/cgrates/servmanager"
+	"github.com/cgrates/cgrates/utils"
+)
+
+// NewFraudService returns the Fraud Service.
+func NewFraudService(cfg *config.CGRConfig, dm *DataDBService,
+	cacheS *engine.CacheS, fsChan chan *engine.FilterS, server *cores.Server,
+	intFraudSChan chan birpc.ClientConnector,
+	cm *engine.ConnManager, anz *AnalyzerService,
+	srvDep map[string]*sync.WaitGroup) servmanager.Service {
+	return &FraudService{
+		connChan: intFraudSChan,
+		cfg:      cfg,
+		dbs:      dm,
+		cacheS:   cacheS,
+		fsChan:   fsChan,
+		server:   server,
+		cm:       cm,
+		anz:      anz,
+		srvDep:   srvDep,
+	}
+}
+
+// FraudService implements Service interface.
+type FraudService struct {
+	mu  sync.RWMutex
+	cfg *config.CGRConfig
+	cm  *engine.ConnManager
+
+	dbs    *DataDBService
+	cacheS *engine.CacheS
+	fsChan chan *engine.FilterS
+
+	fS       *engine.FraudService
+	server   *cores.Server
+	connChan chan birpc.ClientConnector
+	anz      *AnalyzerService
+
+	srvDep map[string]*sync.WaitGroup
+}
+
+// Start should handle the sercive start
+func (s *FraudService) Start() error {
+	if s.IsRunning() {
+		return utils.ErrServiceAlreadyRunning
+	}
+	s.srvDep[utils.DataDB].Add(1)
+
+	<-s.cacheS.GetPrecacheChannel(utils.CacheFraudProfiles)
+	<-s.cacheS.GetPrecacheChannel(utils.CacheFraudFilterIndexes)
+
+	fltrs := <-s.fsChan
+	s.fsChan <- fltrs
+	dmChan := s.dbs.GetDMChan()
+	dm := <-dmChan
+	dmChan <- dm
+
+	utils.Logger.Info(fmt.Sprintf("<%s> starting <%s> subsystem",
+		utils.CoreS, utils.FraudS))
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.fS = engine.NewFraudService(dm, s.cfg, fltrs, s.cm)
+	s.fS.StartLoop()
+	srv, err := engine.NewService(v1.NewFraudSv1(s.fS))
+	if err != nil {
+		return err
+	}
+	if !s.cfg.DispatcherSCfg().Enabled {
+		s.server.RpcRegister(srv)
+	}
+	s.connChan <- s.anz.GetInternalCodec(srv, utils.FraudS)
+	return nil
+}
+
+// Reload handles configuration changes, read by the service on each event.
+func (s *FraudService) Reload() error {
+	return nil
+}
+
+// Shutdown stops the service.
+func (s *FraudService) Shutdown() error {
+	defer s.srvDep[utils.DataDB].Done()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.fS.Shutdown()
+	s.fS = nil
+	<-s.connChan
+	return nil
+}
+
+// ServiceName returns the service name.
+func (s *FraudService) ServiceName() string {
+	return utils.FraudS
+}
+
+// ShouldRun returns if the service should be running.
+func (s *FraudService) ShouldRun() bool {
+	return s.cfg.FraudSCfg().Enabled
+}
+
+// IsRunning checks whether the service is running.
+func (s *FraudService) IsRunning() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.fS != nil
+}
@@ -228,6 +228,8 @@ func (srvMngr *ServiceManager) handleReload() {
 			go srvMngr.reloadService(utils.JanusAgent)
 		case <-srvMngr.GetConfig().GetReloadChan(config.IPsJSON):
 			// go srvMngr.reloadService(utils.IPs)
+		case <-srvMngr.GetConfig().GetReloadChan(config.FraudSJSON):
+			go srvMngr.reloadService(utils.FraudS)
 		}
 		// handle RPC server
 	}
@@ -1192,6 +1192,40 @@ func (sS *SessionS) filterSessions(sf *utils.SessionFilter, psv bool) (aSs []*Ex
 }
 
 // filterSessionsCount re
+// accountSessionsCount returns the number of active sessions started by the account
+func (sS *SessionS) accountSessionsCount(tnt, acnt string) (count int) {
+	for _, s := range sS.getSessions(utils.EmptyString, false) {
+		s.RLock()
+		if s.Tenant == tnt && s.EventStart.GetStringIgnoreErrors(utils.AccountField) == acnt {
+			count++
+		}
+		s.RUnlock()
+	}
+	return
+}
+
+// authorizeFraud queries FraudS for the risk of the event, returning ErrFraudDetected if it should be blocked
+func (sS *SessionS) authorizeFraud(cgrEv *utils.CGREvent) (err error) {
+	ev := cgrEv.Clone()
+	if ev.APIOpts == nil {
+		ev.APIOpts = make(map[string]any)
+	}
+	acnt, _ := ev.FieldAsString(utils.AccountField)
+	ev.APIOpts[utils.OptsFraudsActiveSessions] = sS.accountSessionsCount(ev.Tenant, acnt)
+	var rply engine.FraudReply
+	if err = sS.connMgr.Call(context.TODO(), sS.cgrCfg.SessionSCfg().FraudSConns,
+		utils.FraudSv1AuthorizeEvent, ev, &rply); err != nil {
+		if err.Error() == utils.ErrNotFound.Error() { // no FraudProfile matching
+			return nil
+		}
+		return
+	}
+	if rply.Blocked {
+		return utils.ErrFraudDetected
+	}
+	return
+}
+
 func (sS *SessionS) filterSessionsCount(sf *utils.SessionFilter, psv bool) (count int) {
 	count = 0
 	if len(sf.Filters) == 0 {
@@ -2209,6 +2243,11 @@ func (sS *SessionS) BiRPCv1AuthorizeEvent(ctx *context.Context,
 			return utils.NewErrAttributeS(err)
 		}
 	}
+	if len(sS.cgrCfg.SessionSCfg().FraudSConns) != 0 {
+		if err = sS.authorizeFraud(args.CGREvent); err != nil {
+			return utils.NewErrFraudS(err)
+		}
+	}
 	if args.GetMaxUsage {
 		var sRunsUsage map[string]time.Duration
 		if sRunsUsage, err = sS.authEvent(args.CGREvent, args.ForceDuration); err != nil {
@@ -438,6 +438,7 @@ type TPRatingPlan struct {
 	TPid               string                 // Tariff plan id
 	ID                 string                 // RatingPlan profile id
 	RatingPlanBindings []*TPRatingPlanBinding // Set of destinationid-rateid bindings
+	Currency           string                 // ISO 4217 currency of the rates, empty for the implicit one
 }
 
 // Clone method for TPRatingPlan
@@ -446,8 +447,9 @@ func (tprp *TPRatingPlan) Clone() *TPRatingPlan {
 		return nil
 	}
 	clone := &TPRatingPlan{
-		TPid: tprp.TPid,
-		ID:   tprp.ID,
+		TPid:     tprp.TPid,
+		ID:       tprp.ID,
+		Currency: tprp.Currency,
 	}
 	if tprp.RatingPlanBindings != nil {
 		clone.RatingPlanBindings = make([]*TPRatingPlanBinding, len(tprp.RatingPlanBindings))
@@ -1522,6 +1524,8 @@ type ArgsComputeFilterIndexIDs struct {
 	RateProfileIDs   []string
 	AccountIDs       []string
 	ActionProfileIDs []string
+	FraudIDs         []string
+	TaxIDs           []string
 }
 
 type ArgsComputeFilterIndexes struct {
@@ -1535,6 +1539,8 @@ type ArgsComputeFilterIndexes struct {
 	ThresholdS  bool
 	ChargerS    bool
 	DispatcherS bool
+	FraudS      bool
+	TaxS        bool
 }
 
 // AsActivationTime converts TPActivationInterval into ActivationInterval
@@ -2030,6 +2036,8 @@ type TPChargerProfile struct {
 	RunID              string
 	AttributeIDs       []string
 	Weight             float64
+	ShareType          string
+	Share              float64
 }
 
 // Clone clones TPChargerProfile
@@ -2038,11 +2046,13 @@ func (tpcp *TPChargerProfile) Clone() *TPChargerProfile {
 		return nil
 	}
 	clone := &TPChargerProfile{
-		TPid:   tpcp.TPid,
-		Tenant: tpcp.Tenant,
-		ID:     tpcp.ID,
-		RunID:  tpcp.RunID,
-		Weight: tpcp.Weight,
+		TPid:      tpcp.TPid,
+		Tenant:    tpcp.Tenant,
+		ID:        tpcp.ID,
+		RunID:     tpcp.RunID,
+		Weight:    tpcp.Weight,
+		ShareType: tpcp.ShareType,
+		Share:     tpcp.Share,
 	}
 	if tpcp.FilterIDs != nil {
 		clone.FilterIDs = make([]string, len(tpcp.FilterIDs))
@@ -2249,6 +2259,21 @@ type SMCostFilter struct { //id cu litere mare
 	CreatedAt      TimeInterval
 }
 
+// BalanceJournalFilter selects the balance journal entries of an account
+type BalanceJournalFilter struct {
+	Tenant  string
+	Account string
+	Time    TimeInterval
+	Paginator
+}
+
+// InvoiceFilter selects the invoices of an account
+type InvoiceFilter struct {
+	Tenant  string
+	Account string
+	IDs     []string // all the invoices of the account if empty
+}
+
 func AppendToSMCostFilter(smcFilter *SMCostFilter, fieldType, fieldName string,
 	values []string, timezone string) (smcf *SMCostFilter, err error) {
 	switch fieldName {
@@ -2398,6 +2423,10 @@ func NewAttrReloadCacheWithOpts() *AttrReloadCacheWithAPIOpts {
 		DispatcherProfileIDs:     []string{MetaAny},
 		DispatcherHostIDs:        []string{MetaAny},
 		TimingIDs:                []string{MetaAny},
+		PortedNumbers:            []string{MetaAny},
+		LookupTableIDs:           []string{MetaAny},
+		FraudProfileIDs:          []string{MetaAny},
+		TaxProfileIDs:            []string{MetaAny},
 		AttributeFilterIndexIDs:  []string{MetaAny},
 		ResourceFilterIndexIDs:   []string{MetaAny},
 		IPFilterIndexIDs:         []string{MetaAny},
@@ -2406,6 +2435,8 @@ func NewAttrReloadCacheWithOpts() *AttrReloadCacheWithAPIOpts {
 		RouteFilterIndexIDs:      []string{MetaAny},
 		ChargerFilterIndexIDs:    []string{MetaAny},
 		DispatcherFilterIndexIDs: []string{MetaAny},
+		FraudFilterIndexIDs:      []string{MetaAny},
+		TaxFilterIndexIDs:        []string{MetaAny},
 		FilterIndexIDs:           []string{MetaAny},
 		Dispatchers:              []string{MetaAny},
 	}
@@ -2445,6 +2476,10 @@ func NewAttrReloadCacheWithOptsFromMap(arg map[string][]string, tnt string, opts
 		DispatcherHostIDs:        arg[CacheDispatcherHosts],
 		Dispatchers:              arg[CacheDispatchers],
 		TimingIDs:                arg[CacheTimings],
+		PortedNumbers:            arg[CachePortedNumbers],
+		LookupTableIDs:           arg[CacheLookupTables],
+		FraudProfileIDs:          arg[CacheFraudProfiles],
+		TaxProfileIDs:            arg[CacheTaxProfiles],
 		AttributeFilterIndexIDs:  arg[CacheAttributeFilterIndexes],
 		ResourceFilterIndexIDs:   arg[CacheResourceFilterIndexes],
 		IPFilterIndexIDs:         arg[CacheIPFilterIndexes],
@@ -2453,6 +2488,8 @@ func NewAttrReloadCacheWithOptsFromMap(arg map[string][]string, tnt string, opts
 		RouteFilterIndexIDs:      arg[CacheRouteFilterIndexes],
 		ChargerFilterIndexIDs:    arg[CacheChargerFilterIndexes],
 		DispatcherFilterIndexIDs: arg[CacheDispatcherFilterIndexes],
+		FraudFilterIndexIDs:      arg[CacheFraudFilterIndexes],
+		TaxFilterIndexIDs:        arg[CacheTaxFilterIndexes],
 		FilterIndexIDs:           arg[CacheReverseFilterIndexes],
 	}
 }
@@ -2489,6 +2526,10 @@ type AttrReloadCacheWithAPIOpts struct {
 	DispatcherHostIDs        []string       `json:",omitempty"`
 	Dispatchers              []string       `json:",omitempty"`
 	TimingIDs                []string       `json:",omitempty"`
+	PortedNumbers            []string       `json:",omitempty"`
+	LookupTableIDs           []string       `json:",omitempty"`
+	FraudProfileIDs          []string       `json:",omitempty"`
+	TaxProfileIDs            []string       `json:",omitempty"`
 	AttributeFilterIndexIDs  []string       `json:",omitempty"`
 	ResourceFilterIndexIDs   []string       `json:",omitempty"`
 	IPFilterIndexIDs         []string       `json:",omitempty"`
@@ -2497,6 +2538,8 @@ type AttrReloadCacheWithAPIOpts struct {
 	RouteFilterIndexIDs      []string       `json:",omitempty"`
 	ChargerFilterIndexIDs    []string       `json:",omitempty"`
 	DispatcherFilterIndexIDs []string       `json:",omitempty"`
+	FraudFilterIndexIDs      []string       `json:",omitempty"`
+	TaxFilterIndexIDs        []string       `json:",omitempty"`
 	FilterIndexIDs           []string       `json:",omitempty"`
 }
 
@@ -2531,6 +2574,10 @@ func (a *AttrReloadCacheWithAPIOpts) Map() map[string][]string {
 		CacheDispatcherHosts:         a.DispatcherHostIDs,
 		CacheDispatchers:             a.Dispatchers,
 		CacheTimings:                 a.TimingIDs,
+		CachePortedNumbers:           a.PortedNumbers,
+		CacheLookupTables:            a.LookupTableIDs,
+		CacheFraudProfiles:           a.FraudProfileIDs,
+		CacheTaxProfiles:             a.TaxProfileIDs,
 		CacheAttributeFilterIndexes:  a.AttributeFilterIndexIDs,
 		CacheResourceFilterIndexes:   a.ResourceFilterIndexIDs,
 		CacheIPFilterIndexes:         a.IPFilterIndexIDs,
@@ -2539,6 +2586,8 @@ func (a *AttrReloadCacheWithAPIOpts) Map() map[string][]string {
 		CacheRouteFilterIndexes:      a.RouteFilterIndexIDs,
 		CacheChargerFilterIndexes:    a.ChargerFilterIndexIDs,
 		CacheDispatcherFilterIndexes: a.DispatcherFilterIndexIDs,
+		CacheFraudFilterIndexes:      a.FraudFilterIndexIDs,
+		CacheTaxFilterIndexes:        a.TaxFilterIndexIDs,
 		CacheReverseFilterIndexes:    a.FilterIndexIDs,
 	}
 }
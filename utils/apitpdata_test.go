@@ -1114,6 +1114,10 @@ func TestNewAttrReloadCacheWithOpts(t *testing.T) {
 		DispatcherHostIDs:        []string{MetaAny},
 		Dispatchers:              []string{MetaAny},
 		TimingIDs:                []string{MetaAny},
+		PortedNumbers:            []string{MetaAny},
+		LookupTableIDs:           []string{MetaAny},
+		FraudProfileIDs:          []string{MetaAny},
+		TaxProfileIDs:            []string{MetaAny},
 		AttributeFilterIndexIDs:  []string{MetaAny},
 		ResourceFilterIndexIDs:   []string{MetaAny},
 		IPFilterIndexIDs:         []string{MetaAny},
@@ -1122,6 +1126,8 @@ func TestNewAttrReloadCacheWithOpts(t *testing.T) {
 		RouteFilterIndexIDs:      []string{MetaAny},
 		ChargerFilterIndexIDs:    []string{MetaAny},
 		DispatcherFilterIndexIDs: []string{MetaAny},
+		FraudFilterIndexIDs:      []string{MetaAny},
+		TaxFilterIndexIDs:        []string{MetaAny},
 		FilterIndexIDs:           []string{MetaAny},
 		RankingIDs:               []string{MetaAny},
 		RankingProfileIDs:        []string{MetaAny},
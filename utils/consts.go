@@ -32,7 +32,7 @@ var (
 	GitCommitHash string // If set, it will be processed as part of versioning
 
 	extraDBPartition = NewStringSet([]string{CacheDispatchers,
-		CacheDispatcherRoutes, CacheDispatcherLoads, CacheDiameterMessages, CacheRadiusPackets, CacheRPCResponses, CacheClosedSessions,
+		CacheDispatcherRoutes, CacheDispatcherLoads, CacheDiameterMessages, CacheRadiusPackets, CacheRadiusEAPSessions, CacheRPCResponses, CacheClosedSessions,
 		CacheCDRIDs, CacheRPCConnections, CacheUCH, CacheSTIR, CacheEventCharges, MetaAPIBan, MetaSentryPeer,
 		CacheRatingProfilesTmp, CacheCapsEvents, CacheReplicationHosts})
 
@@ -45,7 +45,8 @@ var (
 		CacheRouteFilterIndexes, CacheAttributeFilterIndexes,
 		CacheChargerFilterIndexes, CacheDispatcherFilterIndexes, CacheLoadIDs,
 		CacheReverseFilterIndexes, CacheActionPlans, CacheAccountActionPlans,
-		CacheAccounts, CacheVersions,
+		CacheAccounts, CacheVersions, CachePortedNumbers, CacheLookupTables,
+		CacheFraudProfiles, CacheFraudFilterIndexes, CacheTaxProfiles, CacheTaxFilterIndexes,
 	})
 
 	DataDBPartitions = NewStringSet([]string{
@@ -61,6 +62,8 @@ var (
 		CacheAttributeFilterIndexes, CacheChargerFilterIndexes,
 		CacheDispatcherFilterIndexes, CacheLoadIDs, CacheReverseFilterIndexes,
 		CacheActionPlans, CacheAccountActionPlans, CacheAccounts, CacheVersions,
+		CachePortedNumbers, CacheLookupTables, CacheFraudProfiles, CacheFraudFilterIndexes,
+		CacheTaxProfiles, CacheTaxFilterIndexes,
 	})
 
 	StorDBPartitions = NewStringSet([]string{
@@ -69,9 +72,9 @@ var (
 		CacheTBLTPSharedGroups, CacheTBLTPActions, CacheTBLTPActionPlans,
 		CacheTBLTPActionTriggers, CacheTBLTPAccountActions, CacheTBLTPResources,
 		CacheTBLTPIPs, CacheTBLTPStats, CacheTBLTPThresholds, CacheTBLTPRankings,
-		CacheTBLTPFilters, CacheSessionCostsTBL, CacheCDRsTBL, CacheTBLTPRoutes,
-		CacheTBLTPAttributes, CacheTBLTPChargers, CacheTBLTPDispatchers,
-		CacheTBLTPDispatcherHosts, CacheVersions,
+		CacheTBLTPFilters, CacheSessionCostsTBL, CacheBalanceJournalTBL, CacheInvoicesTBL,
+		CacheCDRsTBL, CacheTBLTPRoutes, CacheTBLTPAttributes, CacheTBLTPChargers,
+		CacheTBLTPDispatchers, CacheTBLTPDispatcherHosts, CacheVersions,
 	})
 
 	// CachePartitions enables creation of cache partitions
@@ -114,6 +117,12 @@ var (
 		CacheAttributeFilterIndexes:  AttributeFilterIndexes,
 		CacheChargerFilterIndexes:    ChargerFilterIndexes,
 		CacheDispatcherFilterIndexes: DispatcherFilterIndexes,
+		CachePortedNumbers:           PortedNumberPrefix,
+		CacheLookupTables:            LookupTablePrefix,
+		CacheFraudProfiles:           FraudProfilesPrefix,
+		CacheFraudFilterIndexes:      FraudFilterIndexes,
+		CacheTaxProfiles:             TaxProfilesPrefix,
+		CacheTaxFilterIndexes:        TaxFilterIndexes,
 
 		CacheLoadIDs:              LoadIDPrefix,
 		CacheAccounts:             AccountPrefix,
@@ -132,6 +141,8 @@ var (
 		CacheAttributeFilterIndexes:  AttributeProfilePrefix,
 		CacheChargerFilterIndexes:    ChargerProfilePrefix,
 		CacheDispatcherFilterIndexes: DispatcherProfilePrefix,
+		CacheFraudFilterIndexes:      FraudProfilesPrefix,
+		CacheTaxFilterIndexes:        TaxProfilesPrefix,
 		CacheReverseFilterIndexes:    FilterPrefix,
 	}
 
@@ -144,6 +155,8 @@ var (
 		CacheAttributeProfiles:  CacheAttributeFilterIndexes,
 		CacheChargerProfiles:    CacheChargerFilterIndexes,
 		CacheDispatcherProfiles: CacheDispatcherFilterIndexes,
+		CacheFraudProfiles:      CacheFraudFilterIndexes,
+		CacheTaxProfiles:        CacheTaxFilterIndexes,
 		CacheFilters:            CacheReverseFilterIndexes,
 	}
 
@@ -173,6 +186,8 @@ var (
 		TBLTPThresholds:       CacheTBLTPThresholds,
 		TBLTPFilters:          CacheTBLTPFilters,
 		SessionCostsTBL:       CacheSessionCostsTBL,
+		BalanceJournalTBL:     CacheBalanceJournalTBL,
+		InvoicesTBL:           CacheInvoicesTBL,
 		CDRsTBL:               CacheCDRsTBL,
 		TBLTPRoutes:           CacheTBLTPRoutes,
 		TBLTPAttributes:       CacheTBLTPAttributes,
@@ -274,6 +289,8 @@ const (
 	Route                   = "Route"
 	RunID                   = "RunID"
 	AttributeIDs            = "AttributeIDs"
+	ShareType               = "ShareType"
+	Share                   = "Share"
 	MetaReqRunID            = "*req.RunID"
 	Cost                    = "Cost"
 	CostDetails             = "CostDetails"
@@ -349,6 +366,13 @@ const (
 	TrendsProfilePrefix       = "trp_"
 	LoadIDPrefix              = "lid_"
 	SessionsBackupPrefix      = "sbk_"
+	ExchangeRatesPrefix       = "exr_"
+	TaxProfilesPrefix         = "txp_"
+	FraudProfilesPrefix       = "frp_"
+	PortedNumberPrefix        = "pnb_"
+	LookupTablePrefix         = "lkt_"
+	LeasesPrefix              = "les_"
+	RateLimitsPrefix          = "rtl_"
 	LoadInstKey               = "load_history"
 	CreateCDRsTablesSQL       = "create_cdrs_tables.sql"
 	CreateTariffPlanTablesSQL = "create_tariffplan_tables.sql"
@@ -401,6 +425,8 @@ const (
 	MetaVariable             = "*variable"
 	MetaCCUsage              = "*cc_usage"
 	MetaSIPCID               = "*sipcid"
+	MetaLookup               = "*lookup"
+	MetaWasm                 = "*wasm"
 	MetaValueExponent        = "*value_exponent"
 	NegativePrefix           = "!"
 	MatchStartPrefix         = "^"
@@ -624,7 +650,9 @@ const (
 	MetaEventType               = "*eventType"
 	CDR                         = "CDR"
 	ThresholdHit                = "ThresholdHit"
+	ThresholdEscalation         = "ThresholdEscalation"
 	AccountUpdate               = "AccountUpdate"
+	InvoiceLine                 = "InvoiceLine"
 	RankingUpdate               = "RankingUpdate"
 	ResourceUpdate              = "ResourceUpdate"
 	StatUpdate                  = "StatUpdate"
@@ -660,6 +688,24 @@ const (
 	Increments               = "Increments"
 	BalanceField             = "Balance"
 	BalanceSummaries         = "BalanceSummaries"
+	HoldSummaries            = "HoldSummaries"
+	HoldID                   = "HoldID"
+	SubscriptionID           = "SubscriptionID"
+	InvoiceID                = "InvoiceID"
+	PeriodStart              = "PeriodStart"
+	PeriodEnd                = "PeriodEnd"
+	LineType                 = "LineType"
+	Description              = "Description"
+	Code                     = "Code"
+	Count                    = "Count"
+	Amount                   = "Amount"
+	Subtotal                 = "Subtotal"
+	TaxTotal                 = "TaxTotal"
+	TopUps                   = "TopUps"
+	Final                    = "Final"
+	Total                    = "Total"
+	Held                     = "Held"
+	Available                = "Available"
 	ExtraCharge              = "ExtraCharge"
 	Type                     = "Type"
 	Element                  = "Element"
@@ -780,6 +826,18 @@ const (
 	MetaERsStats             = "*ersStats"
 	MetaERsThresholds        = "*ersThresholds"
 	MetaDryRun               = "*dryrun"
+	MetaTax                  = "*tax"
+	MetaPercent              = "*percent"
+	MetaPerUnit              = "*per_unit"
+	MetaMatch                = "*match"
+	MetaVelocity             = "*velocity"
+	MetaConcurrent           = "*concurrent"
+	MetaCalls                = "*calls"
+	FraudAlert               = "FraudAlert"
+	RiskScore                = "RiskScore"
+	FraudRuleIDs             = "FraudRuleIDs"
+	FraudProfileID           = "FraudProfileID"
+	MetaTotal                = "*total"
 	MetaRALsDryRun           = "*ralsDryRun"
 	Event                    = "Event"
 	EmptyString              = ""
@@ -815,6 +873,10 @@ const (
 	Local                   = "local"
 	TCP                     = "tcp"
 	UDP                     = "udp"
+	TCPTLS                  = "tcp-tls"
+	WS                      = "ws"
+	WSS                     = "wss"
+	HTTPS                   = "https"
 	VersionName             = "Version"
 	MetaTenant              = "*tenant"
 	ResourceUsage           = "ResourceUsage"
@@ -832,6 +894,7 @@ const (
 	MetaSIPURIHost          = "*sipuri_host"
 	MetaSIPURIUser          = "*sipuri_user"
 	MetaConnStatus          = "*conn_status"
+	MetaRetryAfter          = "*retry_after"
 	E164DomainConverter     = "*e164Domain"
 	E164Converter           = "*e164"
 	URLDecConverter         = "*urldecode"
@@ -928,6 +991,8 @@ const (
 	MetaPAP                 = "*pap"
 	MetaCHAP                = "*chap"
 	MetaMSCHAPV2            = "*mschapv2"
+	MetaEAPMD5              = "*eap_md5"
+	MetaEAPPassthrough      = "*eap_passthrough"
 	MetaDynaprepaid         = "*dynaprepaid"
 	MetaFD                  = "*fd"
 	SortingData             = "SortingData"
@@ -953,6 +1018,8 @@ const (
 	APIKey                  = "ApiKey"
 	RouteID                 = "RouteID"
 	MetaMonthlyEstimated    = "*monthly_estimated"
+	MetaCron                = "*cron"
+	CronTZPrefix            = "CRON_TZ="
 	MetaProcessedProfileIDs = "*processedProfileIDs"
 	MetaAttrPrfTenantID     = "*apTenantID"
 	HashtagSep              = "#"
@@ -1002,6 +1069,8 @@ const (
 	UnitFactors             = "UnitFactors"
 	CostIncrements          = "CostIncrements"
 	Factors                 = "Factors"
+	Currency                = "Currency"
+	ExchangeRate            = "ExchangeRate"
 	Method                  = "Method"
 	Static                  = "Static"
 	Params                  = "Params"
@@ -1166,6 +1235,18 @@ const (
 	MetaIPs                 = "*ips"
 	MetaResources           = "*resources"
 	MetaSessionsBackup      = "*sessions_backup"
+	MetaExchangeRates       = "*exchange_rates"
+	MetaTaxProfiles         = "*tax_profiles"
+	MetaTaxes               = "*taxes"
+	MetaSplit               = "*split"
+	MetaFraudProfiles       = "*fraud_profiles"
+	MetaFrauds              = "*frauds"
+	MetaPortedNumbers       = "*ported_numbers"
+	MetaLookupTables        = "*lookup_tables"
+	MetaRoutingNumber       = "*routing_number"
+	MetaRouted              = "*routed"
+	MetaDonor               = "*donor"
+	MetaRecipient           = "*recipient"
 	MetaSy                  = "*sy"
 	MetaLoadIDs             = "*load_ids"
 	MetaNodeID              = "*node_id"
@@ -1229,6 +1310,7 @@ const (
 	RankingS    = "RankingS"
 	ThresholdS  = "ThresholdS"
 	IPs         = "IPs"
+	FraudS      = "FraudS"
 )
 
 // Lower service names
@@ -1280,6 +1362,7 @@ const (
 	TopUpZeroNegative             = "*topup_zero_negative"
 	SetExpiry                     = "*set_expiry"
 	MetaPublishAccount            = "*publish_account"
+	MetaBillSubscriptions         = "*bill_subscriptions"
 	MetaRemoveSessionCosts        = "*remove_session_costs"
 	MetaRemoveExpired             = "*remove_expired"
 	MetaPostEvent                 = "*post_event"
@@ -1405,6 +1488,7 @@ const (
 	SessionSv1         = "SessionSv1"
 	ChargerSv1         = "ChargerSv1"
 	IPsV1              = "IPsV1"
+	FraudSv1           = "FraudSv1"
 	MetaAuth           = "*auth"
 	APIMethods         = "APIMethods"
 	NestingSep         = "."
@@ -1436,6 +1520,8 @@ const (
 	MetaNumber             = "*number"
 	MetaActivationInterval = "*ai"
 	MetaRegex              = "*regex"
+	MetaPorted             = "*ported"
+	MetaExpr               = "*expr"
 	MetaContains           = "*contains"
 	MetaHTTP               = "*http"
 
@@ -1455,6 +1541,8 @@ const (
 	MetaNotSentryPeer         = "*notsentrypeer"
 	MetaNotActivationInterval = "*notai"
 	MetaNotRegex              = "*notregex"
+	MetaNotPorted             = "*notported"
+	MetaNotExpr               = "*notexpr"
 	MetaNotContains           = "*notcontains"
 
 	MetaEC = "*ec"
@@ -1532,6 +1620,16 @@ const (
 	ReplicatorSv1SetLoadIDs              = "ReplicatorSv1.SetLoadIDs"
 	ReplicatorSv1SetBackupSessions       = "ReplicatorSv1.SetBackupSessions"
 	ReplicatorSv1RemoveSessionBackup     = "ReplicatorSv1.RemoveSessionBackup"
+	ReplicatorSv1SetExchangeRate         = "ReplicatorSv1.SetExchangeRate"
+	ReplicatorSv1RemoveExchangeRate      = "ReplicatorSv1.RemoveExchangeRate"
+	ReplicatorSv1SetTaxProfile           = "ReplicatorSv1.SetTaxProfile"
+	ReplicatorSv1RemoveTaxProfile        = "ReplicatorSv1.RemoveTaxProfile"
+	ReplicatorSv1SetFraudProfile         = "ReplicatorSv1.SetFraudProfile"
+	ReplicatorSv1RemoveFraudProfile      = "ReplicatorSv1.RemoveFraudProfile"
+	ReplicatorSv1SetPortedNumber         = "ReplicatorSv1.SetPortedNumber"
+	ReplicatorSv1RemovePortedNumber      = "ReplicatorSv1.RemovePortedNumber"
+	ReplicatorSv1SetLookupTable          = "ReplicatorSv1.SetLookupTable"
+	ReplicatorSv1RemoveLookupTable       = "ReplicatorSv1.RemoveLookupTable"
 	ReplicatorSv1RemoveThreshold         = "ReplicatorSv1.RemoveThreshold"
 	ReplicatorSv1RemoveDestination       = "ReplicatorSv1.RemoveDestination"
 	ReplicatorSv1RemoveAccount           = "ReplicatorSv1.RemoveAccount"
@@ -1624,6 +1722,38 @@ const (
 	APIerSv1GetReverseDestination             = "APIerSv1.GetReverseDestination"
 	APIerSv1AddBalance                        = "APIerSv1.AddBalance"
 	APIerSv1DebitBalance                      = "APIerSv1.DebitBalance"
+	APIerSv1CreateHold                        = "APIerSv1.CreateHold"
+	APIerSv1CaptureHold                       = "APIerSv1.CaptureHold"
+	APIerSv1ReleaseHold                       = "APIerSv1.ReleaseHold"
+	APIerSv1StartSubscription                 = "APIerSv1.StartSubscription"
+	APIerSv1ChangeSubscription                = "APIerSv1.ChangeSubscription"
+	APIerSv1StopSubscription                  = "APIerSv1.StopSubscription"
+	APIerSv1GetBalanceJournal                 = "APIerSv1.GetBalanceJournal"
+	APIerSv1GenerateInvoice                   = "APIerSv1.GenerateInvoice"
+	APIerSv1FinalizeInvoice                   = "APIerSv1.FinalizeInvoice"
+	APIerSv1GetInvoices                       = "APIerSv1.GetInvoices"
+	APIerSv1ExportInvoice                     = "APIerSv1.ExportInvoice"
+	APIerSv1SetExchangeRate                   = "APIerSv1.SetExchangeRate"
+	APIerSv1GetExchangeRate                   = "APIerSv1.GetExchangeRate"
+	APIerSv1RemoveExchangeRate                = "APIerSv1.RemoveExchangeRate"
+	APIerSv1SetTaxProfile                     = "APIerSv1.SetTaxProfile"
+	APIerSv1GetTaxProfile                     = "APIerSv1.GetTaxProfile"
+	APIerSv1GetTaxProfiles                    = "APIerSv1.GetTaxProfiles"
+	APIerSv1RemoveTaxProfile                  = "APIerSv1.RemoveTaxProfile"
+	APIerSv1GetCDRTaxes                       = "APIerSv1.GetCDRTaxes"
+	APIerSv1SetFraudProfile                   = "APIerSv1.SetFraudProfile"
+	APIerSv1GetFraudProfile                   = "APIerSv1.GetFraudProfile"
+	APIerSv1GetFraudProfiles                  = "APIerSv1.GetFraudProfiles"
+	APIerSv1RemoveFraudProfile                = "APIerSv1.RemoveFraudProfile"
+	APIerSv1GetPortedNumber                   = "APIerSv1.GetPortedNumber"
+	APIerSv1SetPortedNumber                   = "APIerSv1.SetPortedNumber"
+	APIerSv1RemovePortedNumber                = "APIerSv1.RemovePortedNumber"
+	APIerSv1LoadPortedNumbers                 = "APIerSv1.LoadPortedNumbers"
+	APIerSv1GetLookupTable                    = "APIerSv1.GetLookupTable"
+	APIerSv1SetLookupTable                    = "APIerSv1.SetLookupTable"
+	APIerSv1RemoveLookupTable                 = "APIerSv1.RemoveLookupTable"
+	APIerSv1GetLookupValue                    = "APIerSv1.GetLookupValue"
+	APIerSv1LoadLookupTables                  = "APIerSv1.LoadLookupTables"
 	APIerSv1SetAccount                        = "APIerSv1.SetAccount"
 	APIerSv1GetAccountsCount                  = "APIerSv1.GetAccountsCount"
 	APIerSv1GetAccountIDs                     = "APIerSv1.GetAccountIDs"
@@ -1709,6 +1839,7 @@ const (
 	APIerSv1SetActionPlan                     = "APIerSv1.SetActionPlan"
 	APIerSv1SetActionPlanAccounts             = "APIerSv1.SetActionPlanAccounts"
 	APIerSv1ExecuteAction                     = "APIerSv1.ExecuteAction"
+	APIerSv1SimulateActions                   = "APIerSv1.SimulateActions"
 	APIerSv1SetTPRatingProfile                = "APIerSv1.SetTPRatingProfile"
 	APIerSv1GetTPRatingProfile                = "APIerSv1.GetTPRatingProfile"
 	APIerSv1RemoveTPRatingProfile             = "APIerSv1.RemoveTPRatingProfile"
@@ -1857,6 +1988,7 @@ const (
 	RouteSv1GetRoutes                = "RouteSv1.GetRoutes"
 	RouteSv1GetRoutesList            = "RouteSv1.GetRoutesList"
 	RouteSv1GetRouteProfilesForEvent = "RouteSv1.GetRouteProfilesForEvent"
+	RouteSv1ExplainEvent             = "RouteSv1.ExplainEvent"
 	RouteSv1Ping                     = "RouteSv1.Ping"
 	APIerSv1GetRouteProfile          = "APIerSv1.GetRouteProfile"
 	APIerSv1GetRouteProfileIDs       = "APIerSv1.GetRouteProfileIDs"
@@ -1872,6 +2004,7 @@ const (
 	APIerSv1RemoveAttributeProfile   = "APIerSv1.RemoveAttributeProfile"
 	APIerSv2SetAttributeProfile      = "APIerSv2.SetAttributeProfile"
 	AttributeSv1GetAttributeForEvent = "AttributeSv1.GetAttributeForEvent"
+	AttributeSv1ExplainEvent         = "AttributeSv1.ExplainEvent"
 	AttributeSv1ProcessEvent         = "AttributeSv1.ProcessEvent"
 	AttributeSv1Ping                 = "AttributeSv1.Ping"
 )
@@ -1880,6 +2013,7 @@ const (
 const (
 	ChargerSv1Ping                = "ChargerSv1.Ping"
 	ChargerSv1GetChargersForEvent = "ChargerSv1.GetChargersForEvent"
+	ChargerSv1ExplainEvent        = "ChargerSv1.ExplainEvent"
 	ChargerSv1ProcessEvent        = "ChargerSv1.ProcessEvent"
 	APIerSv1GetChargerProfile     = "APIerSv1.GetChargerProfile"
 	APIerSv1RemoveChargerProfile  = "APIerSv1.RemoveChargerProfile"
@@ -1892,9 +2026,13 @@ const (
 	ThresholdSv1ProcessEvent               = "ThresholdSv1.ProcessEvent"
 	ThresholdSv1GetThreshold               = "ThresholdSv1.GetThreshold"
 	ThresholdSv1ResetThreshold             = "ThresholdSv1.ResetThreshold"
+	ThresholdSv1AcknowledgeThreshold       = "ThresholdSv1.AcknowledgeThreshold"
+	ThresholdSv1SnoozeThreshold            = "ThresholdSv1.SnoozeThreshold"
+	ThresholdSv1ResolveThreshold           = "ThresholdSv1.ResolveThreshold"
 	ThresholdSv1GetThresholdIDs            = "ThresholdSv1.GetThresholdIDs"
 	ThresholdSv1Ping                       = "ThresholdSv1.Ping"
 	ThresholdSv1GetThresholdsForEvent      = "ThresholdSv1.GetThresholdsForEvent"
+	ThresholdSv1ExplainEvent               = "ThresholdSv1.ExplainEvent"
 	ThresholdSv1RegisterInternalBiJSONConn = "ThresholdSv1.RegisterInternalBiJSONConn"
 	ThresholdSv1StoreClientConnID          = "ThresholdSv1.StoreClientConnID"
 	ThresholdSv1RemoveClientConnID         = "ThresholdSv1.RemoveClientConnID"
@@ -1913,6 +2051,7 @@ const (
 	StatSv1GetQueueFloatMetrics    = "StatSv1.GetQueueFloatMetrics"
 	StatSv1Ping                    = "StatSv1.Ping"
 	StatSv1GetStatQueuesForEvent   = "StatSv1.GetStatQueuesForEvent"
+	StatSv1ExplainEvent            = "StatSv1.ExplainEvent"
 	StatSv1GetStatQueue            = "StatSv1.GetStatQueue"
 	StatSv1V1GetQueueIDs           = "StatSv1.GetQueueIDs"
 	StatSv1ResetStatQueue          = "StatSv1.ResetStatQueue"
@@ -1954,6 +2093,7 @@ const (
 	ResourceSv1GetResource           = "ResourceSv1.GetResource"
 	ResourceSv1GetResourceWithConfig = "ResourceSv1.GetResourceWithConfig"
 	ResourceSv1GetResourcesForEvent  = "ResourceSv1.GetResourcesForEvent"
+	ResourceSv1ExplainEvent          = "ResourceSv1.ExplainEvent"
 	ResourceSv1AuthorizeResources    = "ResourceSv1.AuthorizeResources"
 	ResourceSv1AllocateResources     = "ResourceSv1.AllocateResources"
 	ResourceSv1ReleaseResources      = "ResourceSv1.ReleaseResources"
@@ -1978,6 +2118,13 @@ const (
 	APIerSv1GetIPProfileIDs      = "APIerSv1.GetIPProfileIDs"
 )
 
+// FraudS APIs
+const (
+	FraudSv1Ping           = "FraudSv1.Ping"
+	FraudSv1AuthorizeEvent = "FraudSv1.AuthorizeEvent"
+	FraudSv1ProcessEvent   = "FraudSv1.ProcessEvent"
+)
+
 // SessionS APIs
 const (
 	SessionSv1AuthorizeEvent             = "SessionSv1.AuthorizeEvent"
@@ -2045,6 +2192,7 @@ const (
 	DispatcherSv1                    = "DispatcherSv1"
 	DispatcherSv1Ping                = "DispatcherSv1.Ping"
 	DispatcherSv1GetProfilesForEvent = "DispatcherSv1.GetProfilesForEvent"
+	DispatcherSv1ExplainEvent        = "DispatcherSv1.ExplainEvent"
 	DispatcherSv1Apier               = "DispatcherSv1.Apier"
 	DispatcherServicePing            = "DispatcherService.Ping"
 	DispatcherSv1RemoteStatus        = "DispatcherSv1.RemoteStatus"
@@ -2184,6 +2332,7 @@ const (
 	ChargersCsv           = "Chargers.csv"
 	DispatcherProfilesCsv = "DispatcherProfiles.csv"
 	DispatcherHostsCsv    = "DispatcherHosts.csv"
+	LookupTablesCsv       = "LookupTables.csv"
 )
 
 // Table Name
@@ -2207,6 +2356,8 @@ const (
 	TBLTPThresholds       = "tp_thresholds"
 	TBLTPFilters          = "tp_filters"
 	SessionCostsTBL       = "session_costs"
+	BalanceJournalTBL     = "balance_journal"
+	InvoicesTBL           = "invoices"
 	CDRsTBL               = "cdrs"
 	TBLTPRoutes           = "tp_routes"
 	TBLTPAttributes       = "tp_attributes"
@@ -2261,8 +2412,11 @@ const (
 	CacheAttributeFilterIndexes  = "*attribute_filter_indexes"
 	CacheChargerFilterIndexes    = "*charger_filter_indexes"
 	CacheDispatcherFilterIndexes = "*dispatcher_filter_indexes"
+	CacheFraudFilterIndexes      = "*fraud_filter_indexes"
+	CacheTaxFilterIndexes        = "*tax_filter_indexes"
 	CacheDiameterMessages        = "*diameter_messages"
 	CacheRadiusPackets           = "*radius_packets"
+	CacheRadiusEAPSessions       = "*radius_eap_sessions"
 	CacheRPCResponses            = "*rpc_responses"
 	CacheClosedSessions          = "*closed_sessions"
 	MetaPrecaching               = "*precaching"
@@ -2279,6 +2433,11 @@ const (
 	CacheVersions                = "*versions"
 	CacheCapsEvents              = "*caps_events"
 	CacheSessionsBackup          = "*sessions_backup"
+	CacheExchangeRates           = "*exchange_rates"
+	CacheTaxProfiles             = "*tax_profiles"
+	CacheFraudProfiles           = "*fraud_profiles"
+	CachePortedNumbers           = "*ported_numbers"
+	CacheLookupTables            = "*lookup_tables"
 	CacheReplicationHosts        = "*replication_hosts"
 
 	// storDB
@@ -2301,6 +2460,8 @@ const (
 	CacheTBLTPThresholds       = "*tp_thresholds"
 	CacheTBLTPFilters          = "*tp_filters"
 	CacheSessionCostsTBL       = "*session_costs"
+	CacheBalanceJournalTBL     = "*balance_journal"
+	CacheInvoicesTBL           = "*invoices"
 	CacheCDRsTBL               = "*cdrs"
 	CacheTBLTPRoutes           = "*tp_routes"
 	CacheTBLTPAttributes       = "*tp_attributes"
@@ -2318,6 +2479,8 @@ const (
 	AttributeFilterIndexes  = "afi_"
 	ChargerFilterIndexes    = "cfi_"
 	DispatcherFilterIndexes = "dfi_"
+	FraudFilterIndexes      = "fri_"
+	TaxFilterIndexes        = "txi_"
 	ActionPlanIndexes       = "api_"
 	RouteFilterIndexes      = "rti_"
 	FilterIndexPrfx         = "fii_"
@@ -2380,6 +2543,8 @@ const (
 	DigestEqualCfg          = "digest_equal"
 	RSRSepCfg               = "rsr_separator"
 	MaxParallelConnsCfg     = "max_parallel_conns"
+	WasmMemoryLimitCfg      = "wasm_memory_limit"
+	WasmTimeoutCfg          = "wasm_timeout"
 	EEsConnsCfg             = "ees_conns"
 )
 
@@ -2517,9 +2682,11 @@ const (
 const (
 	EnabledCfg                 = "enabled"
 	ThresholdSConnsCfg         = "thresholds_conns"
+	FraudSConnsCfg             = "frauds_conns"
 	CacheSConnsCfg             = "caches_conns"
 	RpSubjectPrefixMatchingCfg = "rp_subject_prefix_matching"
 	RemoveExpiredCfg           = "remove_expired"
+	BalanceJournalCfg          = "balance_journal"
 	MaxComputedUsageCfg        = "max_computed_usage"
 	BalanceRatingSubjectCfg    = "balance_rating_subject"
 	MaxIncrementsCfg           = "max_increments"
@@ -2537,10 +2704,15 @@ const (
 	ExtraFieldsCfg         = "extra_fields"
 	StoreCdrsCfg           = "store_cdrs"
 	CompressStoredCostCfg  = "compress_stored_cost"
+	TaxesCfg               = "taxes"
 	SMCostRetriesCfg       = "session_cost_retries"
 	ChargerSConnsCfg       = "chargers_conns"
 	AttributeSConnsCfg     = "attributes_conns"
 	RetransmissionTimerCfg = "retransmission_timer"
+	DialogTrackingCfg      = "dialog_tracking"
+	NextHopCfg             = "next_hop"
+	DialogTimeoutCfg       = "dialog_timeout"
+	WSOriginsCfg           = "ws_origins"
 	OnlineCDRExportsCfg    = "online_cdr_exports"
 	SessionCostRetires     = "session_cost_retries"
 	RateSConnsCfg          = "rates_conns"
@@ -2662,6 +2834,14 @@ const (
 	CoATemplateCfg        = "coa_template"
 	HostCfg               = "host"
 	PortCfg               = "port"
+	SecretCfg             = "secret"
+
+	RequireMessageAuthenticatorCfg = "require_message_authenticator"
+	EAPPassthroughCfg              = "eap_passthrough"
+
+	// DNSAgentCfg
+	EnumDomainsCfg = "enum_domains"
+	EnumServiceCfg = "enum_service"
 
 	// PrometheusAgentCfg
 	CoreSConnsCfg            = "cores_conns"
@@ -2681,6 +2861,7 @@ const (
 
 	// ChargerSCfg
 	StoreIntervalCfg = "store_interval"
+	AlertIntervalCfg = "alert_interval"
 
 	// StatSCfg
 	StoreUncompressedLimitCfg = "store_uncompressed_limit"
@@ -2723,6 +2904,8 @@ const (
 	TransportCfg              = "transport"
 	StrategyCfg               = "strategy"
 	DynaprepaidActionplansCfg = "dynaprepaid_actionplans"
+	LeaderElectionCfg         = "leader_election"
+	LeaseTTLCfg               = "lease_ttl"
 
 	//RateSCfg
 	RateIndexedSelectsCfg      = "rate_indexed_selects"
@@ -3012,6 +3195,12 @@ const (
 	// Thresholds
 	Hits                               = "Hits"
 	Snooze                             = "Snooze"
+	AlertState                         = "AlertState"
+	EscalationLevel                    = "EscalationLevel"
+	MetaFiring                         = "*firing"
+	MetaAcknowledged                   = "*acknowledged"
+	MetaSnoozed                        = "*snoozed"
+	MetaResolved                       = "*resolved"
 	ThresholdConfig                    = "Config"
 	OptsThresholdsProfileIDs           = "*thdProfileIDs"
 	OptsThresholdsProfileIgnoreFilters = "*thdProfileIgnoreFilters"
@@ -3023,8 +3212,13 @@ const (
 	OptsRALs       = "*ralS"
 	OptsRerate     = "*rerate"
 	OptsRefund     = "*refund"
+	OptsTaxes      = "*taxes"
+	OptsFraudS     = "*fraudS"
+	// FraudS
+	OptsFraudsActiveSessions = "*fraudsActiveSessions"
 	// Others
 	OptsContext                        = "*context"
+	OptsExplain                        = "*explain"
 	MetaSubsys                         = "*subsys"
 	MetaMethod                         = "*reqMethod"
 	OptsAttributesProfileIDs           = "*attrProfileIDs"
@@ -3305,6 +3499,8 @@ var StringTmplType = StringSet{
 	MetaPrefix:          struct{}{},
 	MetaSuffix:          struct{}{},
 	MetaSIPCID:          struct{}{},
+	MetaLookup:          struct{}{},
+	MetaWasm:            struct{}{},
 }
 
 func buildCacheInstRevPrefixes() {
@@ -55,6 +55,16 @@ type DataConverter interface {
 	Convert(any) (any, error)
 }
 
+// dataConverterFactories holds the converters registered from outside this package,
+// indexed by their prefix, for the conversions which need access to the engine data
+var dataConverterFactories = make(map[string]func(params string) (DataConverter, error))
+
+// RegisterDataConverter registers a converter factory for the prefix,
+// receiving the params following the prefix. Not safe for concurrent use, call it from init
+func RegisterDataConverter(prefix string, newConv func(params string) (DataConverter, error)) {
+	dataConverterFactories[prefix] = newConv
+}
+
 // NewDataConverter is a factory of converters
 func NewDataConverter(params string) (conv DataConverter, err error) {
 	switch {
@@ -136,11 +146,17 @@ func NewDataConverter(params string) (conv DataConverter, err error) {
 		return NewStripConverter(params)
 	case params == MetaConnStatus:
 		return ConnStatusConverter{}, nil
+	case params == MetaRetryAfter:
+		return RetryAfterConverter{}, nil
 	case strings.HasPrefix(params, MetaGigawords):
 		return new(GigawordsConverter), nil
 	case strings.HasPrefix(params, Meta3GPPULI):
 		return NewULIConverter(params)
 	default:
+		prfx, cnvParams, _ := strings.Cut(params, InInFieldSep)
+		if newConv, has := dataConverterFactories[prfx]; has {
+			return newConv(cnvParams)
+		}
 		return nil, fmt.Errorf("unsupported converter definition: <%s>", params)
 	}
 }
@@ -864,6 +880,19 @@ func (c ConnStatusConverter) Convert(in any) (any, error) {
 	return 0, fmt.Errorf("unsupported connection status: %q", status)
 }
 
+// RetryAfterConverter extracts the retry duration out of an error built by NewErrRetryAfter,
+// returning it in whole seconds, rounded up, as used by the Retry-After headers.
+type RetryAfterConverter struct{}
+
+// Convert implements DataConverter interface
+func (RetryAfterConverter) Convert(in any) (any, error) {
+	retryAfter, has := RetryAfterFromError(errors.New(IfaceAsString(in)))
+	if !has {
+		return nil, ErrNotFound
+	}
+	return int64(math.Ceil(retryAfter.Seconds())), nil
+}
+
 // ULIConverter decodes 3GPP-User-Location-Info and extracts fields by path.
 type ULIConverter struct {
 	path string
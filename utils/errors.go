@@ -22,6 +22,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 )
 
 var (
@@ -48,10 +49,12 @@ var (
 	ErrNotConvertible                   = errors.New("NOT_CONVERTIBLE")
 	ErrResourceUnavailable              = errors.New("RESOURCE_UNAVAILABLE")
 	ErrResourceUnauthorized             = errors.New("RESOURCE_UNAUTHORIZED")
+	ErrFraudDetected                    = errors.New("FRAUD_DETECTED")
 	ErrIPUnavailable                    = errors.New("IP_UNAVAILABLE")
 	ErrIPUnauthorized                   = errors.New("IP_UNAUTHORIZED")
 	ErrIPAlreadyAllocated               = errors.New("IP_ALREADY_ALLOCATED")
 	ErrNoActiveSession                  = errors.New("NO_ACTIVE_SESSION")
+	ErrNoActiveAlert                    = errors.New("NO_ACTIVE_ALERT")
 	ErrPartiallyExecuted                = errors.New("PARTIALLY_EXECUTED")
 	ErrMaxUsageExceeded                 = errors.New("MAX_USAGE_EXCEEDED")
 	ErrMaxCostExceeded                  = errors.New("MAX_COST_EXCEEDED")
@@ -71,6 +74,7 @@ var (
 	RalsErrorPrfx                       = "RALS_ERROR"
 	DispatcherErrorPrefix               = "DISPATCHER_ERROR"
 	RateSErrPrfx                        = "RATES_ERROR"
+	RetryAfterErrPrfx                   = "RETRY_AFTER"
 	ErrNotAuthorized                    = errors.New("NOT_AUTHORIZED")
 	ErrUnsupportedFormat                = errors.New("UNSUPPORTED_FORMAT")
 	ErrNoDatabaseConn                   = errors.New("NO_DATABASE_CONNECTION")
@@ -109,10 +113,12 @@ var (
 		ErrNotConvertible.Error():                   ErrNotConvertible,
 		ErrResourceUnavailable.Error():              ErrResourceUnavailable,
 		ErrResourceUnauthorized.Error():             ErrResourceUnauthorized,
+		ErrFraudDetected.Error():                    ErrFraudDetected,
 		ErrIPUnavailable.Error():                    ErrIPUnavailable,
 		ErrIPUnauthorized.Error():                   ErrIPUnauthorized,
 		ErrIPAlreadyAllocated.Error():               ErrIPAlreadyAllocated,
 		ErrNoActiveSession.Error():                  ErrNoActiveSession,
+		ErrNoActiveAlert.Error():                    ErrNoActiveAlert,
 		ErrPartiallyExecuted.Error():                ErrPartiallyExecuted,
 		ErrMaxUsageExceeded.Error():                 ErrMaxUsageExceeded,
 		ErrFilterNotPassingNoCaps.Error():           ErrFilterNotPassingNoCaps,
@@ -196,6 +202,33 @@ func NewErrResourceS(err error) error {
 	return fmt.Errorf("RESOURCES_ERROR:%s", err)
 }
 
+// NewErrRetryAfter suffixes err with the duration after which the request can be retried
+func NewErrRetryAfter(err error, retryAfter time.Duration) error {
+	return fmt.Errorf("%w:%s:%s", err, RetryAfterErrPrfx, retryAfter)
+}
+
+// RetryAfterFromError returns the duration out of the errors built by NewErrRetryAfter,
+// including the ones received over RPC or prefixed by other subsystems
+func RetryAfterFromError(err error) (retryAfter time.Duration, has bool) {
+	if err == nil {
+		return
+	}
+	errStr := err.Error()
+	idx := strings.LastIndex(errStr, RetryAfterErrPrfx+InInFieldSep)
+	if idx == -1 {
+		return
+	}
+	var errPrs error
+	if retryAfter, errPrs = time.ParseDuration(errStr[idx+len(RetryAfterErrPrfx)+1:]); errPrs != nil {
+		return 0, false
+	}
+	return retryAfter, true
+}
+
+func NewErrFraudS(err error) error {
+	return fmt.Errorf("FRAUDS_ERROR:%s", err)
+}
+
 func NewErrIPs(err error) error {
 	return fmt.Errorf("IPS_ERROR:%s", err)
 }
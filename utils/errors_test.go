@@ -21,6 +21,7 @@ import (
 	"errors"
 	"reflect"
 	"testing"
+	"time"
 )
 
 func TestNewCGRError(t *testing.T) {
@@ -257,3 +258,23 @@ func TestNewSTIRError(t *testing.T) {
 		t.Errorf("Expecting: %q, received: %q", expected, rcv.Error())
 	}
 }
+
+func TestRetryAfterFromError(t *testing.T) {
+	err := NewErrRetryAfter(ErrResourceUnavailable, 1500*time.Millisecond)
+	if !errors.Is(err, ErrResourceUnavailable) {
+		t.Errorf("expecting %v, received: %v", ErrResourceUnavailable, err)
+	}
+	// as received over RPC, prefixed by SessionS
+	err = NewErrResourceS(errors.New(err.Error()))
+	if retryAfter, has := RetryAfterFromError(err); !has || retryAfter != 1500*time.Millisecond {
+		t.Errorf("expecting 1.5s, received: %v, %v", retryAfter, has)
+	}
+	if rcv, err := (RetryAfterConverter{}).Convert(err.Error()); err != nil {
+		t.Error(err)
+	} else if rcv != int64(2) {
+		t.Errorf("expecting 2, received: %v", rcv)
+	}
+	if _, has := RetryAfterFromError(ErrResourceUnavailable); has {
+		t.Error("unexpected retry after")
+	}
+}